@@ -4,6 +4,9 @@
 package bls
 
 import (
+	"context"
+	"io"
+
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/herumi"
@@ -19,17 +22,186 @@ func SecretKeyFromBytes(privKey []byte) (SecretKey, error) {
 	return blst.SecretKeyFromBytes(privKey)
 }
 
-// PublicKeyFromBytes creates a BLS public key from a  BigEndian byte slice.
+// AggregateSecretKeys adds secret keys mod r, such as the shares of a distributed key generation.
+func AggregateSecretKeys(keys []SecretKey) (SecretKey, error) {
+	return blst.AggregateSecretKeys(keys)
+}
+
+// PublicKeyFromBytes creates a BLS public key from its compressed, big-endian encoding.
 func PublicKeyFromBytes(pubKey []byte) (PublicKey, error) {
 	return blst.PublicKeyFromBytes(pubKey)
 }
 
-// SignatureFromBytes creates a BLS signature from a LittleEndian byte slice.
+// PublicKeyFromUncompressed creates a BLS public key from its uncompressed, big-endian encoding.
+func PublicKeyFromUncompressed(pubKey []byte) (PublicKey, error) {
+	return blst.PublicKeyFromUncompressed(pubKey)
+}
+
+// PublicKeyFromHex creates a BLS public key from a hex encoded string, with or without a 0x prefix.
+func PublicKeyFromHex(s string) (PublicKey, error) {
+	return blst.PublicKeyFromHex(s)
+}
+
+// ValidatePubKeyBytes checks the length and flag bits of a compressed public key without
+// decompressing it.
+func ValidatePubKeyBytes(b []byte) error {
+	return blst.ValidatePubKeyBytes(b)
+}
+
+// ValidateSignatureBytes checks the length and flag bits of a compressed signature without
+// decompressing it.
+func ValidateSignatureBytes(b []byte) error {
+	return blst.ValidateSignatureBytes(b)
+}
+
+// PublicKeysFromBytes creates BLS public keys from a list of BigEndian byte slices in parallel.
+func PublicKeysFromBytes(pubKeys [][]byte) ([]PublicKey, error) {
+	return blst.PublicKeysFromBytes(pubKeys)
+}
+
+// MarshalPublicKeys writes the compressed encodings of keys back to back into a single buffer.
+func MarshalPublicKeys(keys []PublicKey) []byte {
+	return blst.MarshalPublicKeys(keys)
+}
+
+// UnmarshalPublicKeys parses and validates a buffer written by MarshalPublicKeys.
+func UnmarshalPublicKeys(data []byte) ([]PublicKey, error) {
+	return blst.UnmarshalPublicKeys(data)
+}
+
+// DecodePublicKeysStream reads and validates compressed public keys from r one at a time.
+func DecodePublicKeysStream(r io.Reader, fn func(int, PublicKey) error) error {
+	return blst.DecodePublicKeysStream(r, fn)
+}
+
+// SortPublicKeys sorts keys in place by their compressed encodings.
+func SortPublicKeys(keys []PublicKey) {
+	blst.SortPublicKeys(keys)
+}
+
+// PublicKeyFromBytesNoValidate creates a BLS public key without the subgroup check. Only use
+// it for keys that were validated before entering a trusted store.
+func PublicKeyFromBytesNoValidate(pubKey []byte) (PublicKey, error) {
+	return blst.PublicKeyFromBytesNoValidate(pubKey)
+}
+
+// PublicKeyFromBytesOpts creates a BLS public key, with the subgroup check only if validate is set.
+func PublicKeyFromBytesOpts(pubKey []byte, validate bool) (PublicKey, error) {
+	return blst.PublicKeyFromBytesOpts(pubKey, validate)
+}
+
+// SetPublicKeyCacheSize rebuilds the public key cache with the given capacity.
+func SetPublicKeyCacheSize(n int) error {
+	return blst.SetPublicKeyCacheSize(n)
+}
+
+// SetPublicKeyCacheEnabled turns the public key cache on or off, emptying it either way.
+func SetPublicKeyCacheEnabled(enabled bool) {
+	blst.SetPublicKeyCacheEnabled(enabled)
+}
+
+// PublicKeyCacheEnabled reports whether the public key cache is in use.
+func PublicKeyCacheEnabled() bool {
+	return blst.PublicKeyCacheEnabled()
+}
+
+// PublicKeyCacheSize returns the capacity of the public key cache.
+func PublicKeyCacheSize() int {
+	return blst.PublicKeyCacheSize()
+}
+
+// SetPublicKeyInterning makes PublicKeyFromBytes return shared, immutable keys for equal inputs.
+func SetPublicKeyInterning(enabled bool) {
+	blst.SetPublicKeyInterning(enabled)
+}
+
+// PublicKeyInterningEnabled reports whether cached public keys are interned.
+func PublicKeyInterningEnabled() bool {
+	return blst.PublicKeyInterningEnabled()
+}
+
+// WarmPublicKeyCache decompresses, validates and caches keys ahead of their first use.
+func WarmPublicKeyCache(keys [][]byte) error {
+	return blst.WarmPublicKeyCache(keys)
+}
+
+// PublicKeyFromBytesInPeriod creates a public key for a member of the sync committee of period.
+func PublicKeyFromBytesInPeriod(pubKey []byte, period uint64) (PublicKey, error) {
+	return blst.PublicKeyFromBytesInPeriod(pubKey, period)
+}
+
+// SetPublicKeyCachePeriodMode turns retention of cached public keys by sync committee period on or off.
+func SetPublicKeyCachePeriodMode(enabled bool) {
+	blst.SetPublicKeyCachePeriodMode(enabled)
+}
+
+// AdvancePublicKeyCachePeriod evicts the public keys of the periods older than current-1.
+func AdvancePublicKeyCachePeriod(current uint64) {
+	blst.AdvancePublicKeyCachePeriod(current)
+}
+
+// PublicKeyCacheKeys returns the compressed bytes of the currently cached public keys.
+func PublicKeyCacheKeys() [][]byte {
+	return blst.PublicKeyCacheKeys()
+}
+
+// SetPublicKeyHasher sets the hash function of PublicKey.Hash, once at initialization.
+func SetPublicKeyHasher(hasher func([]byte) [32]byte) {
+	blst.SetPublicKeyHasher(hasher)
+}
+
+// PublicKeyCacheStats returns a snapshot of the public key cache counters.
+func PublicKeyCacheStats() blst.CacheStats {
+	return blst.PublicKeyCacheStats()
+}
+
+// SetSignatureCacheSize rebuilds the signature cache with the given capacity.
+func SetSignatureCacheSize(n int) error {
+	return blst.SetSignatureCacheSize(n)
+}
+
+// SignatureCacheSize returns the capacity of the signature cache.
+func SignatureCacheSize() int {
+	return blst.SignatureCacheSize()
+}
+
+// SignatureCacheStats returns a snapshot of the signature cache counters.
+func SignatureCacheStats() blst.CacheStats {
+	return blst.SignatureCacheStats()
+}
+
+// SetVerificationCacheSize sets the capacity of the FastAggregateVerify result cache, 0 disabling it.
+func SetVerificationCacheSize(n int) error {
+	return blst.SetVerificationCacheSize(n)
+}
+
+// VerificationCacheSize returns the capacity of the verification cache, 0 while it is disabled.
+func VerificationCacheSize() int {
+	return blst.VerificationCacheSize()
+}
+
+// VerificationCacheStats returns a snapshot of the verification cache counters.
+func VerificationCacheStats() blst.CacheStats {
+	return blst.VerificationCacheStats()
+}
+
+// SignatureFromBytes creates a BLS signature from its compressed, big-endian encoding.
 func SignatureFromBytes(sig []byte) (Signature, error) {
 	return blst.SignatureFromBytes(sig)
 }
 
-// MultipleSignaturesFromBytes creates a slice of BLS signatures from a LittleEndian 2d-byte slice.
+// SignatureFromHex creates a BLS signature from a hex encoded string, with or without a 0x prefix.
+func SignatureFromHex(s string) (Signature, error) {
+	return blst.SignatureFromHex(s)
+}
+
+// SignatureFromBytesNoValidate creates a BLS signature without the subgroup check. Only use it
+// for signatures that were validated before they were stored.
+func SignatureFromBytesNoValidate(sig []byte) (Signature, error) {
+	return blst.SignatureFromBytesNoValidate(sig)
+}
+
+// MultipleSignaturesFromBytes creates a slice of BLS signatures from a list of compressed, big-endian encodings.
 func MultipleSignaturesFromBytes(sigs [][]byte) ([]Signature, error) {
 	return blst.MultipleSignaturesFromBytes(sigs)
 }
@@ -39,26 +211,98 @@ func AggregatePublicKeys(pubs [][]byte) (PublicKey, error) {
 	return blst.AggregatePublicKeys(pubs)
 }
 
+// AggregatePublicKeysCollectErrors aggregates raw public keys, reporting the error of every bad key.
+// A nil key comes with at least one error in the returned slice.
+func AggregatePublicKeysCollectErrors(pubs [][]byte, strict bool) (PublicKey, []error) {
+	return blst.AggregatePublicKeysCollectErrors(pubs, strict)
+}
+
 // AggregateMultiplePubkeys aggregates the provided decompressed keys into a single key.
-func AggregateMultiplePubkeys(pubs []PublicKey) PublicKey {
+func AggregateMultiplePubkeys(pubs []PublicKey) (PublicKey, error) {
 	return blst.AggregateMultiplePubkeys(pubs)
 }
 
 // AggregateSignatures converts a list of signatures into a single, aggregated sig.
-func AggregateSignatures(sigs []common.Signature) common.Signature {
+func AggregateSignatures(sigs []common.Signature) (common.Signature, error) {
 	return blst.AggregateSignatures(sigs)
 }
 
+// DeserializeAndVerify decodes a compressed public key and signature and verifies the signature over msg.
+func DeserializeAndVerify(pubKey, sig, msg []byte) error {
+	return blst.DeserializeAndVerify(pubKey, sig, msg)
+}
+
+// AggregateKeysAndSignatures aggregates the public keys and the signatures of the same signers in one pass.
+func AggregateKeysAndSignatures(pubs [][]byte, sigs [][]byte) (PublicKey, common.Signature, error) {
+	return blst.AggregateKeysAndSignatures(pubs, sigs)
+}
+
+// RecoverSignature recovers a threshold group signature from partial signatures and their participant indices.
+func RecoverSignature(partials []common.Signature, indices []uint64) (common.Signature, error) {
+	return blst.RecoverSignature(partials, indices)
+}
+
+// VerifyPartials verifies threshold partial signatures against their share public keys and returns the failing indices.
+func VerifyPartials(partials []common.Signature, sharePubs []common.PublicKey, msg []byte) ([]int, error) {
+	return blst.VerifyPartials(partials, sharePubs, msg)
+}
+
 // AggregateCompressedSignatures converts a list of compressed signatures into a single, aggregated sig.
 func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error) {
 	return blst.AggregateCompressedSignatures(multiSigs)
 }
 
+// VerifyCompressed verifies a compressed signature against a compressed public key, returning an
+// error only if either is malformed.
+func VerifyCompressed(pubKey, sig, msg []byte) (bool, error) {
+	return blst.VerifyCompressed(pubKey, sig, msg)
+}
+
+// HashToG2 hashes msg to a point of G2 under a caller supplied domain separation tag.
+func HashToG2(msg, dst []byte) (common.Signature, error) {
+	return blst.HashToG2(msg, dst)
+}
+
 // VerifyMultipleSignatures verifies multiple signatures for distinct messages securely.
 func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
 	return blst.VerifyMultipleSignatures(sigs, msgs, pubKeys)
 }
 
+// VerifyMultipleSignaturesContext verifies multiple signatures in chunks, stopping when ctx is done.
+func VerifyMultipleSignaturesContext(ctx context.Context, sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
+	return blst.VerifyMultipleSignaturesContext(ctx, sigs, msgs, pubKeys)
+}
+
+// SetBatchVerifyChunkSize sets the number of signatures verified between two context checks.
+func SetBatchVerifyChunkSize(n int) error {
+	return blst.SetBatchVerifyChunkSize(n)
+}
+
+// VerificationMetrics returns a snapshot of the signature verification counters.
+func VerificationMetrics() blst.VerificationStats {
+	return blst.VerificationMetrics()
+}
+
+// FastAggregateVerify verifies a signature over a single message against the aggregate of the provided keys.
+func FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte, sig common.Signature) bool {
+	return blst.FastAggregateVerify(pubKeys, msg, sig)
+}
+
+// AggregateVerify verifies an aggregate signature over distinct (public key, message) pairs.
+func AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte, sig common.Signature) bool {
+	return blst.AggregateVerify(pubKeys, msgs, sig)
+}
+
+// RunKnownAnswerTests checks the BLS implementation against embedded consensus spec test vectors.
+func RunKnownAnswerTests() error {
+	return blst.RunKnownAnswerTests()
+}
+
+// NewAggregator creates an aggregator for adding public keys one at a time.
+func NewAggregator() *blst.Aggregator {
+	return blst.NewAggregator()
+}
+
 // NewAggregateSignature creates a blank aggregate signature.
 func NewAggregateSignature() common.Signature {
 	return blst.NewAggregateSignature()
@@ -68,3 +312,54 @@ func NewAggregateSignature() common.Signature {
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
+
+// DeriveMasterSK derives the EIP-2333 master secret key from a seed.
+func DeriveMasterSK(seed []byte) (SecretKey, error) {
+	return blst.DeriveMasterSK(seed)
+}
+
+// SecretKeyFromSeed deterministically derives a secret key from a seed, for tests only.
+func SecretKeyFromSeed(seed []byte) (SecretKey, error) {
+	return blst.SecretKeyFromSeed(seed)
+}
+
+// DeriveChildSK derives the EIP-2333 child secret key at index from its parent.
+func DeriveChildSK(parent SecretKey, index uint32) (SecretKey, error) {
+	return blst.DeriveChildSK(parent, index)
+}
+
+// EncryptKeystore encrypts a secret key into an EIP-2335 keystore.
+func EncryptKeystore(sk SecretKey, password string) ([]byte, error) {
+	return blst.EncryptKeystore(sk, password)
+}
+
+// DecryptKeystore decrypts the secret key of an EIP-2335 keystore.
+func DecryptKeystore(data []byte, password string) (SecretKey, error) {
+	return blst.DecryptKeystore(data, password)
+}
+
+// DecryptKeystoreWithScryptLimits decrypts a keystore whose scrypt parameters may exceed the
+// EIP-2335 defaults, up to n of maxN and r*p of maxRP.
+func DecryptKeystoreWithScryptLimits(data []byte, password string, maxN, maxRP int) (SecretKey, error) {
+	return blst.DecryptKeystoreWithScryptLimits(data, password, maxN, maxRP)
+}
+
+// SignWithDomain signs the eth2 signing root of the sha256 hash of msg under domain.
+func SignWithDomain(sk SecretKey, msg []byte, domain [32]byte) Signature {
+	return blst.SignWithDomain(sk, msg, domain)
+}
+
+// VerifyWithDomain verifies a signature produced by SignWithDomain.
+func VerifyWithDomain(pubKey PublicKey, msg []byte, domain [32]byte, sig Signature) bool {
+	return blst.VerifyWithDomain(pubKey, msg, domain, sig)
+}
+
+// SignRootWithDomain signs the eth2 signing root of objectRoot under domain.
+func SignRootWithDomain(sk SecretKey, objectRoot [32]byte, domain [32]byte) Signature {
+	return blst.SignRootWithDomain(sk, objectRoot, domain)
+}
+
+// VerifyRootWithDomain verifies a signature produced by SignRootWithDomain.
+func VerifyRootWithDomain(pubKey PublicKey, objectRoot [32]byte, domain [32]byte, sig Signature) bool {
+	return blst.VerifyRootWithDomain(pubKey, objectRoot, domain, sig)
+}
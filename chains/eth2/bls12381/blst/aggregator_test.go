@@ -0,0 +1,96 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randPublicKeys(t testing.TB, n int) []common.PublicKey {
+	pubs := make([]common.PublicKey, n)
+	for i := range pubs {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		pubs[i] = priv.PublicKey()
+	}
+	return pubs
+}
+
+func TestAggregator_MatchesBatch(t *testing.T) {
+	pubs := randPublicKeys(t, 100)
+	want, err := blst.AggregateMultiplePubkeys(pubs)
+	require.NoError(t, err)
+
+	a := blst.NewAggregator()
+	for _, pub := range pubs {
+		a.Add(pub)
+	}
+	assert.Equal(t, 100, a.Len())
+	assert.Equal(t, want.Marshal(), a.Finalize().Marshal())
+	assert.Equal(t, true, want.Equals(a.Finalize()), "Finalize is not repeatable")
+}
+
+func TestAggregator_AddAfterFinalize(t *testing.T) {
+	pubs := randPublicKeys(t, 10)
+	a := blst.NewAggregator()
+	for i, pub := range pubs {
+		a.Add(pub)
+		want, err := blst.AggregateMultiplePubkeys(pubs[:i+1])
+		require.NoError(t, err)
+		assert.Equal(t, want.Marshal(), a.Finalize().Marshal(), "Mismatch after %d keys", i+1)
+	}
+}
+
+func TestAggregator_FinalizeNotAliased(t *testing.T) {
+	pubs := randPublicKeys(t, 3)
+	a := blst.NewAggregator()
+	a.Add(pubs[0])
+	a.Add(pubs[1])
+	agg := a.Finalize()
+	before := agg.Marshal()
+
+	// Neither aggregating into the result nor adding more keys affects the other.
+	agg.Aggregate(pubs[2])
+	want, err := blst.AggregateMultiplePubkeys(pubs[:2])
+	require.NoError(t, err)
+	assert.Equal(t, want.Marshal(), a.Finalize().Marshal())
+	a.Add(pubs[2])
+	assert.Equal(t, agg.Marshal(), a.Finalize().Marshal())
+	assert.NotEqual(t, before, agg.Marshal())
+}
+
+func TestAggregator_Empty(t *testing.T) {
+	a := blst.NewAggregator()
+	assert.Nil(t, a.Finalize())
+	assert.Equal(t, 0, a.Len())
+
+	var zero blst.Aggregator
+	pub := randPublicKeys(t, 1)[0]
+	zero.Add(pub)
+	assert.Equal(t, pub.Marshal(), zero.Finalize().Marshal())
+}
+
+func TestAggregator_Concurrent(t *testing.T) {
+	pubs := randPublicKeys(t, 64)
+	want, err := blst.AggregateMultiplePubkeys(pubs)
+	require.NoError(t, err)
+
+	a := blst.NewAggregator()
+	var wg sync.WaitGroup
+	for _, pub := range pubs {
+		wg.Add(1)
+		go func(pub common.PublicKey) {
+			defer wg.Done()
+			a.Add(pub)
+		}(pub)
+	}
+	wg.Wait()
+	assert.Equal(t, want.Marshal(), a.Finalize().Marshal())
+}
@@ -0,0 +1,43 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build !blst_disabled
+
+package blst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The tests are shared by both backends. These helpers reach into the points of the blst
+// backend, herumi_test.go has their herumi counterparts.
+
+// noValidateSkipsSubgroupCheck tells whether PublicKeyFromBytesNoValidate and
+// SignatureFromBytesNoValidate accept points outside the subgroup. herumi always checks.
+const noValidateSkipsSubgroupCheck = true
+
+// notInSubgroupSignatureError is the error SignatureFromBytes returns for a point on the curve
+// outside the G2 subgroup.
+const notInSubgroupSignatureError = "signature not in group"
+
+// requireNotInSubgroupPublicKey checks that the compressed b is a point on the curve outside
+// the G1 subgroup.
+func requireNotInSubgroupPublicKey(t *testing.T, b []byte) {
+	p := new(blstPublicKey).Uncompress(b)
+	require.NotNil(t, p, "Fixture should decompress to a point on the curve")
+	require.Equal(t, false, p.KeyValidate(), "Fixture should fail the subgroup check")
+}
+
+// requireNotInSubgroupSignature checks that the compressed b is a point on the curve outside
+// the G2 subgroup.
+func requireNotInSubgroupSignature(t *testing.T, b []byte) {
+	p := new(blstSignature).Uncompress(b)
+	require.NotNil(t, p, "Fixture should decompress to a point on the curve")
+	require.Equal(t, false, p.SigValidate(false), "Fixture should fail the subgroup check")
+}
+
+// rawSign signs msg with the scalar of key, bypassing the Signature wrapper.
+func rawSign(key *bls12SecretKey, msg []byte) *blstSignature {
+	return new(blstSignature).Sign(key.p, msg, dst)
+}
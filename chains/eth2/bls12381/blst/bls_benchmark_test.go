@@ -1,6 +1,5 @@
-//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
 // +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
-// +build !blst_disabled
 
 package blst_test
 
@@ -41,7 +40,8 @@ func BenchmarkSignature_AggregateVerify(b *testing.B) {
 		sigs = append(sigs, sig)
 		msgs = append(msgs, msg)
 	}
-	aggregated := blst.AggregateSignatures(sigs)
+	aggregated, err := blst.AggregateSignatures(sigs)
+	require.NoError(b, err)
 
 	b.ResetTimer()
 	b.ReportAllocs()
@@ -63,3 +63,124 @@ func BenchmarkSecretKey_Marshal(b *testing.B) {
 		_ = err
 	}
 }
+
+func BenchmarkFastAggregateVerify(b *testing.B) {
+	pks, aggregated, msg := fastAggregateFixture(b, 512)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !blst.FastAggregateVerify(pks, msg, aggregated) {
+			b.Fatal("could not verify aggregate sig")
+		}
+	}
+}
+
+func BenchmarkFastAggregateVerify_AggregateThenVerify(b *testing.B) {
+	pks, aggregated, msg := fastAggregateFixture(b, 512)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		aggKey, err := blst.AggregateMultiplePubkeys(pks)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if !aggregated.Verify(aggKey, msg[:]) {
+			b.Fatal("could not verify aggregate sig")
+		}
+	}
+}
+
+func fastAggregateFixture(b *testing.B, n int) ([]common.PublicKey, common.Signature, [32]byte) {
+	msg := [32]byte{'s', 'i', 'g', 'n', 'e', 'd'}
+	pks := make([]common.PublicKey, 0, n)
+	sigs := make([]common.Signature, 0, n)
+	for i := 0; i < n; i++ {
+		sk, err := blst.RandKey()
+		require.NoError(b, err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg[:]))
+	}
+	aggregated, err := blst.AggregateSignatures(sigs)
+	require.NoError(b, err)
+	return pks, aggregated, msg
+}
+
+func BenchmarkSignatureFromBytes(b *testing.B) {
+	sigs := make([][]byte, 512)
+	for i := range sigs {
+		sk, err := blst.RandKey()
+		require.NoError(b, err)
+		sigs[i] = sk.Sign([]byte{'s', 'i', 'g', byte(i)}).Marshal()
+	}
+
+	b.Run("Validate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, sig := range sigs {
+				if _, err := blst.SignatureFromBytes(sig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("NoValidate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, sig := range sigs {
+				if _, err := blst.SignatureFromBytesNoValidate(sig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkMarshalPublicKeys(b *testing.B) {
+	pubs := randPublicKeys(b, 1000)
+
+	b.Run("flat buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = blst.MarshalPublicKeys(pubs)
+		}
+	})
+	b.Run("append loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var buf []byte
+			for _, pub := range pubs {
+				buf = append(buf, pub.Marshal()...)
+			}
+			_ = buf
+		}
+	})
+}
+
+func BenchmarkPublicKey_MarshalInto(b *testing.B) {
+	// Keys decoded from a block, as in the block-encoding path.
+	pubs, err := blst.UnmarshalPublicKeys(blst.MarshalPublicKeys(randPublicKeys(b, 1000)))
+	require.NoError(b, err)
+
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, len(pubs)*common.BLSPubkeyLength)
+		for i := 0; i < b.N; i++ {
+			for j, pub := range pubs {
+				copy(buf[j*common.BLSPubkeyLength:], pub.Marshal())
+			}
+		}
+	})
+	b.Run("MarshalInto", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, len(pubs)*common.BLSPubkeyLength)
+		for i := 0; i < b.N; i++ {
+			for j, pub := range pubs {
+				if _, err := pub.(*blst.PublicKey).MarshalInto(buf[j*common.BLSPubkeyLength:]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
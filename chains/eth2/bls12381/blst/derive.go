@@ -0,0 +1,40 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build !blst_disabled
+
+package blst
+
+import (
+	"fmt"
+
+	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/pkg/errors"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// DeriveMasterSK derives the master secret key from a seed as defined by EIP-2333.
+//
+// In EIP-2333:
+// derive_master_SK(seed: bytes) -> SK: int
+func DeriveMasterSK(seed []byte) (common2.SecretKey, error) {
+	if len(seed) < MinSeedLength {
+		return nil, fmt.Errorf("seed must be at least %d bytes", MinSeedLength)
+	}
+	sk := blst.DeriveMasterEip2333(seed)
+	if sk == nil {
+		return nil, errors.New("could not derive master secret key")
+	}
+	return &bls12SecretKey{p: sk}, nil
+}
+
+// DeriveChildSK derives the child secret key at index from its parent as defined by EIP-2333.
+//
+// In EIP-2333:
+// derive_child_SK(parent_SK: int, index: int) -> SK: int
+func DeriveChildSK(parent common2.SecretKey, index uint32) (common2.SecretKey, error) {
+	p, ok := parent.(*bls12SecretKey)
+	if !ok || p == nil || p.p == nil {
+		return nil, errors.New("parent is not a valid blst secret key")
+	}
+	return &bls12SecretKey{p: p.p.DeriveChildEip2333(index)}, nil
+}
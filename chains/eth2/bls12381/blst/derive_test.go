@@ -0,0 +1,106 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test cases from https://eips.ethereum.org/EIPS/eip-2333#test-cases.
+var eip2333Vectors = []struct {
+	seed       string
+	masterSK   string
+	childIndex uint32
+	childSK    string
+}{
+	{
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		childIndex: 0,
+		childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+	},
+	{
+		seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+		masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		childIndex: 3141592653,
+		childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+	},
+	{
+		seed:       "0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+		masterSK:   "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+		childIndex: 4294967295,
+		childSK:    "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+	},
+	{
+		seed:       "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+		masterSK:   "19022158461524446591288038168518313374041767046816487870552872741050760015818",
+		childIndex: 42,
+		childSK:    "31372231650479070279774297061823572166496564838472787488249775572789064611981",
+	},
+}
+
+func secretKeyInt(t *testing.T, s string) []byte {
+	n, ok := new(big.Int).SetString(s, 10)
+	require.Equal(t, true, ok)
+	b := make([]byte, 32)
+	return n.FillBytes(b)
+}
+
+func TestDeriveSK_EIP2333Vectors(t *testing.T) {
+	for i, v := range eip2333Vectors {
+		seed, err := hex.DecodeString(v.seed)
+		require.NoError(t, err)
+
+		master, err := blst.DeriveMasterSK(seed)
+		require.NoError(t, err)
+		assert.Equal(t, secretKeyInt(t, v.masterSK), master.Marshal(), "Master key mismatch for vector %d", i)
+
+		child, err := blst.DeriveChildSK(master, v.childIndex)
+		require.NoError(t, err)
+		assert.Equal(t, secretKeyInt(t, v.childSK), child.Marshal(), "Child key mismatch for vector %d", i)
+	}
+}
+
+func TestDeriveMasterSK_ShortSeed(t *testing.T) {
+	_, err := blst.DeriveMasterSK(make([]byte, blst.MinSeedLength-1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "seed must be at least 32 bytes")
+
+	_, err = blst.DeriveChildSK(nil, 0)
+	require.Error(t, err)
+}
+
+func TestSecretKeyFromSeed(t *testing.T) {
+	for _, seed := range [][]byte{[]byte("alice"), make([]byte, blst.MinSeedLength+8)} {
+		a, err := blst.SecretKeyFromSeed(seed)
+		require.NoError(t, err)
+		b, err := blst.SecretKeyFromSeed(seed)
+		require.NoError(t, err)
+		assert.Equal(t, a.Marshal(), b.Marshal(), "Same seed yielded different keys")
+	}
+
+	alice, err := blst.SecretKeyFromSeed([]byte("alice"))
+	require.NoError(t, err)
+	bob, err := blst.SecretKeyFromSeed([]byte("bob"))
+	require.NoError(t, err)
+	assert.NotEqual(t, alice.Marshal(), bob.Marshal(), "Different seeds yielded the same key")
+
+	// Seeds long enough for EIP-2333 are used as is.
+	seed := make([]byte, blst.MinSeedLength)
+	seed[0] = 0x01
+	master, err := blst.DeriveMasterSK(seed)
+	require.NoError(t, err)
+	fromSeed, err := blst.SecretKeyFromSeed(seed)
+	require.NoError(t, err)
+	assert.Equal(t, master.Marshal(), fromSeed.Marshal())
+
+	_, err = blst.SecretKeyFromSeed(nil)
+	require.Error(t, err)
+}
@@ -2,6 +2,17 @@
 // the BLS12-381 curve and signature scheme. This package exposes a public API for
 // verifying and aggregating BLS signatures used by Ethereum.
 //
-// This implementation uses the library written by Supranational, blst.
+// This implementation uses the library written by Supranational, blst. Builds with the
+// blst_disabled tag fall back to the herumi library behind the same API. This is an alternative
+// backend rather than a portable one: herumi needs cgo and ships static libraries for the same
+// platforms as blst, linux and darwin on amd64 and arm64 and windows on amd64. Builds for any
+// other platform, such as linux/386, fail with either backend.
+//
+// All package level functions are safe for concurrent use, including those that configure the
+// public key, signature and verification caches. The caches guard their configuration with
+// locks and keep their statistics in atomic counters, and the eviction callback is invoked
+// outside the cache locks. Keys and signatures are safe to share between goroutines as long as
+// none of them calls a mutating method such as Aggregate; keys interned by the public key cache
+// are never mutated.
 //
 package blst
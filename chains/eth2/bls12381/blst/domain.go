@@ -0,0 +1,52 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"crypto/sha256"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// SignWithDomain signs msg bound to domain, so that the signature only verifies for the chain and
+// purpose the domain was computed for.
+//
+// The signed message is the eth2 signing root, compute_signing_root of the spec:
+//	hash_tree_root(SigningData(object_root=sha256(msg), domain=domain))
+// The object root is the sha256 hash of msg whatever its length, so a 32 byte message is hashed
+// like any other; callers that already hold the hash tree root of an SSZ object sign it with
+// SignRootWithDomain instead. The domain is not checked, an all-zero domain is accepted; callers
+// are expected to derive it with ComputeDomain.
+func SignWithDomain(sk common.SecretKey, msg []byte, domain [32]byte) common.Signature {
+	return SignRootWithDomain(sk, sha256.Sum256(msg), domain)
+}
+
+// VerifyWithDomain verifies a signature produced by SignWithDomain for the same message and
+// domain.
+func VerifyWithDomain(pubKey common.PublicKey, msg []byte, domain [32]byte, sig common.Signature) bool {
+	return VerifyRootWithDomain(pubKey, sha256.Sum256(msg), domain, sig)
+}
+
+// SignRootWithDomain signs the signing root of objectRoot under domain like SignWithDomain, but
+// takes the object root as is, such as the hash tree root of an SSZ object.
+func SignRootWithDomain(sk common.SecretKey, objectRoot [32]byte, domain [32]byte) common.Signature {
+	root := signingRoot(objectRoot, domain)
+	return sk.Sign(root[:])
+}
+
+// VerifyRootWithDomain verifies a signature produced by SignRootWithDomain for the same object
+// root and domain.
+func VerifyRootWithDomain(pubKey common.PublicKey, objectRoot [32]byte, domain [32]byte, sig common.Signature) bool {
+	if sig == nil {
+		return false
+	}
+	root := signingRoot(objectRoot, domain)
+	return sig.Verify(pubKey, root[:])
+}
+
+// signingRoot returns the hash tree root of the SigningData container. Both fields are
+// single chunks, so the root is the hash of their concatenation.
+func signingRoot(objectRoot [32]byte, domain [32]byte) [32]byte {
+	return sha256.Sum256(append(objectRoot[:], domain[:]...))
+}
@@ -0,0 +1,87 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWithDomain_SeparatesDomains(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	msg := []byte("cross-chain message")
+	domainA := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+	domainB := [32]byte{0x07, 0x00, 0x00, 0x00, 0x02}
+
+	sigA := blst.SignWithDomain(priv, msg, domainA)
+	sigB := blst.SignWithDomain(priv, msg, domainB)
+	assert.NotEqual(t, sigA.Marshal(), sigB.Marshal())
+
+	assert.Equal(t, true, blst.VerifyWithDomain(pub, msg, domainA, sigA))
+	assert.Equal(t, true, blst.VerifyWithDomain(pub, msg, domainB, sigB))
+	assert.Equal(t, false, blst.VerifyWithDomain(pub, msg, domainB, sigA), "Signature verified under another domain")
+	assert.Equal(t, false, blst.VerifyWithDomain(pub, msg, domainA, sigB), "Signature verified under another domain")
+	assert.Equal(t, false, sigA.Verify(pub, msg), "Domain signature verified as a plain signature")
+	assert.Equal(t, false, blst.VerifyWithDomain(pub, msg, domainA, nil))
+
+	// An all-zero domain is accepted but still separates from real domains.
+	sigZero := blst.SignWithDomain(priv, msg, [32]byte{})
+	assert.Equal(t, true, blst.VerifyWithDomain(pub, msg, [32]byte{}, sigZero))
+	assert.Equal(t, false, blst.VerifyWithDomain(pub, msg, domainA, sigZero))
+}
+
+func TestSignWithDomain_HashesEveryLength(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+
+	for _, msg := range [][]byte{nil, []byte("short"), []byte("a 32 byte message, or an SSZ roo"), make([]byte, 100)} {
+		hashed := sha256.Sum256(msg)
+		sig := blst.SignWithDomain(priv, msg, domain)
+		assert.Equal(t, true, blst.VerifyWithDomain(pub, msg, domain, sig))
+		assert.Equal(t, true, blst.VerifyRootWithDomain(pub, hashed, domain, sig), "Object root of %d bytes is not their hash", len(msg))
+		// A signature over msg is for msg only, not for its hash, nor the other way round.
+		assert.Equal(t, false, blst.VerifyWithDomain(pub, hashed[:], domain, sig), "Signature verified for the hash of its message")
+		sig = blst.SignWithDomain(priv, hashed[:], domain)
+		assert.Equal(t, false, blst.VerifyWithDomain(pub, msg, domain, sig), "Signature verified for the preimage of its message")
+	}
+}
+
+func TestSignRootWithDomain_RootIsNotHashed(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+	var root [32]byte
+	copy(root[:], "the hash tree root of an object")
+
+	sig := blst.SignRootWithDomain(priv, root, domain)
+	assert.Equal(t, true, blst.VerifyRootWithDomain(pub, root, domain, sig))
+	assert.Equal(t, false, blst.VerifyWithDomain(pub, root[:], domain, sig), "Root was hashed as a message")
+	assert.Equal(t, false, blst.VerifyRootWithDomain(pub, sha256.Sum256(root[:]), domain, sig))
+	assert.Equal(t, false, blst.VerifyRootWithDomain(pub, root, domain, nil))
+}
+
+func TestSignRootWithDomain_MatchesSigningData(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0xaa, 0xbb}
+	root := sha256.Sum256([]byte("cross-chain message"))
+
+	data := &eth2.SigningData{ObjectRoot: root[:], Domain: domain[:]}
+	signingRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	sig := blst.SignRootWithDomain(priv, root, domain)
+	assert.Equal(t, true, sig.Verify(priv.PublicKey(), signingRoot[:]), "Signing root differs from SigningData")
+	assert.Equal(t, sig.Marshal(), blst.SignWithDomain(priv, []byte("cross-chain message"), domain).Marshal())
+}
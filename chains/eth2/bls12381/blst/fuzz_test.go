@@ -0,0 +1,102 @@
+//go:build go1.18 && ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64))
+// +build go1.18
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// fuzzSeeds returns the seed corpus of a decoder of encodings of length n: the valid encodings
+// valid, each also with its sign flag flipped and its compression flag cleared, the compressed
+// and uncompressed points at infinity, all zeros, all ones, and inputs one byte short and long.
+func fuzzSeeds(valid [][]byte, n int) [][]byte {
+	var seeds [][]byte
+	for _, v := range valid {
+		neg := append([]byte(nil), v...)
+		neg[0] ^= 0x20
+		uncompressed := append([]byte(nil), v...)
+		uncompressed[0] &^= 0x80
+		seeds = append(seeds, v, neg, uncompressed, v[:n-1], append(append([]byte(nil), v...), 0))
+	}
+	infinity := make([]byte, n)
+	infinity[0] = 0xc0
+	uncompressedInfinity := make([]byte, n)
+	uncompressedInfinity[0] = 0x40
+	ones := bytes.Repeat([]byte{0xff}, n)
+	return append(seeds, nil, []byte{}, infinity, uncompressedInfinity, make([]byte, n), ones, infinity[:n-1], append(infinity, 0))
+}
+
+// fuzzSecretKeys returns n fixed secret keys, so that the seed corpus is the same every run.
+func fuzzSecretKeys(f *testing.F, n int) []common.SecretKey {
+	keys := make([]common.SecretKey, n)
+	for i := range keys {
+		var err error
+		if keys[i], err = blst.SecretKeyFromSeed([]byte{byte(i)}); err != nil {
+			f.Fatal(err)
+		}
+	}
+	return keys
+}
+
+func FuzzPublicKeyFromBytes(f *testing.F) {
+	var valid [][]byte
+	for _, key := range fuzzSecretKeys(f, 4) {
+		valid = append(valid, key.PublicKey().Marshal())
+	}
+	for _, seed := range fuzzSeeds(valid, common.BLSPubkeyLength) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		pub, err := blst.PublicKeyFromBytes(data)
+		if err != nil {
+			return
+		}
+		if pub.IsInfinite() {
+			t.Fatalf("Accepted the point at infinity %#x", data)
+		}
+		if enc := pub.Marshal(); !bytes.Equal(enc, data) {
+			t.Fatalf("Decoded %#x, which marshals to %#x", data, enc)
+		}
+		again, err := blst.PublicKeyFromBytes(pub.Marshal())
+		if err != nil {
+			t.Fatalf("Marshaled key %#x does not decode: %v", data, err)
+		}
+		if !again.Equals(pub) {
+			t.Fatalf("Key %#x does not round-trip", data)
+		}
+	})
+}
+
+func FuzzSignatureFromBytes(f *testing.F) {
+	var valid [][]byte
+	for i, key := range fuzzSecretKeys(f, 4) {
+		valid = append(valid, key.Sign([]byte{byte(i)}).Marshal())
+	}
+	for _, seed := range fuzzSeeds(valid, blst.BLSSignatureLength) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		sig, err := blst.SignatureFromBytes(data)
+		if err != nil {
+			return
+		}
+		if enc := sig.Marshal(); !bytes.Equal(enc, data) {
+			t.Fatalf("Decoded %#x, which marshals to %#x", data, enc)
+		}
+		again, err := blst.SignatureFromBytes(sig.Marshal())
+		if err != nil {
+			t.Fatalf("Marshaled signature %#x does not decode: %v", data, err)
+		}
+		if !bytes.Equal(again.Marshal(), data) {
+			t.Fatalf("Signature %#x does not round-trip", data)
+		}
+	})
+}
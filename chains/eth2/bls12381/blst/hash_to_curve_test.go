@@ -0,0 +1,103 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The BLS12381G2_XMD:SHA-256_SSWU_RO_ vectors of RFC 9380, appendix J.10.1. Coordinates are
+// given as "c0,c1" pairs of Fp2 elements.
+const hashToG2TestDST = "QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"
+
+var hashToG2Tests = []struct {
+	msg  string
+	x, y string
+}{
+	{
+		msg: "",
+		x:   "0x0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a,0x05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d",
+		y:   "0x0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92,0x12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6",
+	},
+	{
+		msg: "abc",
+		x:   "0x02c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6,0x139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8",
+		y:   "0x1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48,0x00aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16",
+	},
+	{
+		msg: "abcdef0123456789",
+		x:   "0x121982811d2491fde9ba7ed31ef9ca474f0e1501297f68c298e9f4c0028add35aea8bb83d53c08cfc007c1e005723cd0,0x190d119345b94fbd15497bcba94ecf7db2cbfd1e1fe7da034d26cbba169fb3968288b3fafb265f9ebd380512a71c3f2c",
+		y:   "0x05571a0f8d3c08d094576981f4a3b8eda0a8e771fcdcc8ecceaf1356a6acf17574518acb506e435b639353c2e14827c8,0x0bb5e7572275c567462d91807de765611490205a941a5a6af3b1691bfe596c31225d3aabdf15faff860cb4ef17c7c3be",
+	},
+	{
+		msg: "q128_" + strings.Repeat("q", 128),
+		x:   "0x19a84dd7248a1066f737cc34502ee5555bd3c19f2ecdb3c7d9e24dc65d4e25e50d83f0f77105e955d78f4762d33c17da,0x0934aba516a52d8ae479939a91998299c76d39cc0c035cd18813bec433f587e2d7a4fef038260eef0cef4d02aae3eb91",
+		y:   "0x14f81cd421617428bc3b9fe25afbb751d934a00493524bc4e065635b0555084dd54679df1536101b2c979c0152d09192,0x09bcccfa036b4847c9950780733633f13619994394c23ff0b32fa6b795844f4a0673e20282d07bc69641cee04f5e5662",
+	},
+	{
+		msg: "a512_" + strings.Repeat("a", 512),
+		x:   "0x01a6ba2f9a11fa5598b2d8ace0fbe0a0eacb65deceb476fbbcb64fd24557c2f4b18ecfc5663e54ae16a84f5ab7f62534,0x11fca2ff525572795a801eed17eb12785887c7b63fb77a42be46ce4a34131d71f7a73e95fee3f812aea3de78b4d01569",
+		y:   "0x0b6798718c8aed24bc19cb27f866f1c9effcdbf92397ad6448b5c9db90d2b9da6cbabf48adc1adf59a1a28344e79d57e,0x03a47f8e6d1763ba0cad63d6114c0accbef65707825a511b251a660a9b3994249ae4e63fac38b23da0c398689ee2ab52",
+	},
+}
+
+// compressG2 returns the 96 byte compressed encoding of the G2 point (x, y): x.c1 followed by
+// x.c0, flagged as compressed and with the sign bit set when y is the larger of y and -y.
+func compressG2(t *testing.T, x, y string) []byte {
+	p, ok := new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	require.True(t, ok)
+	half := new(big.Int).Rsh(p, 1)
+
+	xs, ys := strings.Split(x, ","), strings.Split(y, ",")
+	require.Len(t, xs, 2)
+	require.Len(t, ys, 2)
+	out := make([]byte, 96)
+	copy(out[:48], hexutil.MustDecode(xs[1]))
+	copy(out[48:], hexutil.MustDecode(xs[0]))
+	out[0] |= 0x80
+
+	y0, ok := new(big.Int).SetString(ys[0][2:], 16)
+	require.True(t, ok)
+	y1, ok := new(big.Int).SetString(ys[1][2:], 16)
+	require.True(t, ok)
+	if y1.Cmp(half) > 0 || (y1.Sign() == 0 && y0.Cmp(half) > 0) {
+		out[0] |= 0x20
+	}
+	return out
+}
+
+func TestHashToG2(t *testing.T) {
+	for i, test := range hashToG2Tests {
+		point, err := blst.HashToG2([]byte(test.msg), []byte(hashToG2TestDST))
+		require.NoError(t, err)
+		assert.Equal(t, compressG2(t, test.x, test.y), point.Marshal(), "Mismatch for vector %d", i)
+	}
+}
+
+func TestHashToG2_DomainSeparation(t *testing.T) {
+	_, err := blst.HashToG2([]byte("abc"), nil)
+	assert.Error(t, err)
+	_, err = blst.HashToG2([]byte("abc"), []byte{})
+	assert.Error(t, err)
+
+	a, err := blst.HashToG2([]byte("abc"), []byte(hashToG2TestDST))
+	require.NoError(t, err)
+	b, err := blst.HashToG2([]byte("abc"), []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+	require.NoError(t, err)
+	assert.NotEqual(t, a.Marshal(), b.Marshal())
+
+	// Under the eth2 tag the point is the signature of a secret key of one.
+	one := make([]byte, blst.BLSSecretKeyLength)
+	one[len(one)-1] = 1
+	sk, err := blst.SecretKeyFromBytes(one)
+	require.NoError(t, err)
+	assert.Equal(t, sk.Sign([]byte("abc")).Marshal(), b.Marshal())
+}
@@ -0,0 +1,82 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"crypto/sha256"
+
+	hbls "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/herumi"
+)
+
+// Builds with the blst_disabled tag implement the package API on top of herumi instead of
+// blst. Encodings, signatures and derived keys are identical between the two, herumi is only
+// slower.
+type herumiPublicKey = hbls.PublicKey
+type herumiSignature = hbls.Sign
+type herumiSecretKey = hbls.SecretKey
+
+func init() {
+	herumi.HerumiInit()
+}
+
+// hashToFieldLength is the number of bytes hashed to each field element, L in the hash to
+// curve specification.
+const hashToFieldLength = 64
+
+// hashToG2 hashes msg to a G2 point with the given domain separation tag, as hash_to_curve of
+// the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite. herumi only hashes under a single global tag, which
+// is dst, so this is needed for other tags such as popDst. herumi's MapToG2 also clears the
+// cofactor, which is linear, so adding the two mapped points gives the same result.
+func hashToG2(msg, tag []byte) *hbls.G2 {
+	u := expandMessageXMD(msg, tag, 4*hashToFieldLength)
+	var e [4]hbls.Fp
+	for i := range e {
+		if err := e[i].SetBigEndianMod(u[i*hashToFieldLength : (i+1)*hashToFieldLength]); err != nil {
+			panic(err)
+		}
+	}
+	var q0, q1, q hbls.G2
+	if err := hbls.MapToG2(&q0, &hbls.Fp2{D: [2]hbls.Fp{e[0], e[1]}}); err != nil {
+		panic(err)
+	}
+	if err := hbls.MapToG2(&q1, &hbls.Fp2{D: [2]hbls.Fp{e[2], e[3]}}); err != nil {
+		panic(err)
+	}
+	hbls.G2Add(&q, &q0, &q1)
+	return &q
+}
+
+// expandMessageXMD implements expand_message_xmd with SHA-256 from the hash to curve
+// specification, for n of at most 255*32 bytes. Tags longer than 255 bytes are replaced by
+// their hash as the specification requires.
+func expandMessageXMD(msg, tag []byte, n int) []byte {
+	if len(tag) > 255 {
+		oversize := sha256.Sum256(append([]byte("H2C-OVERSIZE-DST-"), tag...))
+		tag = oversize[:]
+	}
+	dstPrime := append(append([]byte{}, tag...), byte(len(tag)))
+	h := sha256.New()
+	h.Write(make([]byte, h.BlockSize()))
+	h.Write(msg)
+	h.Write([]byte{byte(n >> 8), byte(n), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	out := make([]byte, 0, n+sha256.Size)
+	bi := make([]byte, sha256.Size)
+	for i := 1; len(out) < n; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:n]
+}
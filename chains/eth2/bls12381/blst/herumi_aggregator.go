@@ -0,0 +1,57 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"sync"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// Aggregator aggregates public keys incrementally, as they arrive. It is safe for concurrent
+// use.
+type Aggregator struct {
+	mu  sync.Mutex
+	agg herumiPublicKey
+	n   int
+}
+
+// NewAggregator creates an empty public key aggregator.
+func NewAggregator() *Aggregator {
+	return &Aggregator{}
+}
+
+// Add adds a public key to the running aggregate. It may be called after Finalize to keep
+// aggregating from the finalized state.
+func (a *Aggregator) Add(pub common.PublicKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.n == 0 {
+		a.agg = *pub.(*PublicKey).p
+	} else {
+		a.agg.Add(pub.(*PublicKey).p)
+	}
+	a.n++
+}
+
+// Len returns the number of public keys added so far.
+func (a *Aggregator) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.n
+}
+
+// Finalize returns the aggregate of the public keys added so far, or nil if none were. Keys
+// that cancel each other out aggregate to the point at infinity, which callers must check for
+// with IsInfinite before verifying against it.
+func (a *Aggregator) Finalize() common.PublicKey {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.n == 0 {
+		return nil
+	}
+	agg := a.agg
+	return &PublicKey{p: &agg}
+}
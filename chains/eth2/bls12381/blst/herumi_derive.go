@@ -0,0 +1,121 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+// keyGenSalt is the initial salt of hkdf_mod_r, hashed before its first use.
+var keyGenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+
+// lamportChunks is the number of 32 byte chunks of an EIP-2333 Lamport secret key half.
+const lamportChunks = 255
+
+// DeriveMasterSK derives the master secret key from a seed as defined by EIP-2333.
+//
+// In EIP-2333:
+// derive_master_SK(seed: bytes) -> SK: int
+func DeriveMasterSK(seed []byte) (common2.SecretKey, error) {
+	if len(seed) < MinSeedLength {
+		return nil, fmt.Errorf("seed must be at least %d bytes", MinSeedLength)
+	}
+	return &bls12SecretKey{p: hkdfModR(seed)}, nil
+}
+
+// DeriveChildSK derives the child secret key at index from its parent as defined by EIP-2333.
+//
+// In EIP-2333:
+// derive_child_SK(parent_SK: int, index: int) -> SK: int
+func DeriveChildSK(parent common2.SecretKey, index uint32) (common2.SecretKey, error) {
+	p, ok := parent.(*bls12SecretKey)
+	if !ok || p == nil || p.p == nil {
+		return nil, errors.New("parent is not a valid blst secret key")
+	}
+	return &bls12SecretKey{p: hkdfModR(parentSKToLamportPK(p.p.Serialize(), index))}, nil
+}
+
+// hkdfModR is the IETF KeyGen with an empty key_info, hkdf_mod_r in EIP-2333.
+//
+// In EIP-2333:
+// def hkdf_mod_r(IKM: bytes, key_info: bytes=b'') -> int:
+//     L = 48
+//     salt = b'BLS-SIG-KEYGEN-SALT-'
+//     SK = 0
+//     while SK == 0:
+//         salt = H(salt)
+//         PRK = HKDF-Extract(salt, IKM || I2OSP(0, 1))
+//         OKM = HKDF-Expand(PRK, key_info || I2OSP(L, 2), L)
+//         SK = OS2IP(OKM) mod r
+//     return SK
+func hkdfModR(ikm []byte) *herumiSecretKey {
+	const l = 48
+	r := new(big.Int).SetBytes(curveOrder[:])
+	salt := keyGenSalt
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk := hkdf.Extract(sha256.New, append(append([]byte{}, ikm...), 0), salt)
+		okm := make([]byte, l)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte{0, l}), okm); err != nil {
+			panic(err)
+		}
+		sk.SetBytes(okm).Mod(sk, r)
+	}
+	var buf [BLSSecretKeyLength]byte
+	sk.FillBytes(buf[:])
+	secKey := new(herumiSecretKey)
+	if err := secKey.Deserialize(buf[:]); err != nil {
+		panic(err)
+	}
+	return secKey
+}
+
+// parentSKToLamportPK returns the compressed Lamport public key of EIP-2333, the input keying
+// material of the child key.
+//
+// In EIP-2333:
+// def parent_SK_to_lamport_PK(parent_SK: int, index: int) -> bytes:
+//     salt = I2OSP(index, 4)
+//     IKM = I2OSP(parent_SK, 32)
+//     lamport_0 = IKM_to_lamport_SK(IKM, salt)
+//     not_IKM = flip_bits(IKM)
+//     lamport_1 = IKM_to_lamport_SK(not_IKM, salt)
+//     lamport_PK = b''
+//     for i in range(255):
+//         lamport_PK += SHA256(lamport_0[i])
+//     for i in range(255):
+//         lamport_PK += SHA256(lamport_1[i])
+//     compressed_lamport_PK = SHA256(lamport_PK)
+//     return compressed_lamport_PK
+func parentSKToLamportPK(parentSK []byte, index uint32) []byte {
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+	notIKM := make([]byte, len(parentSK))
+	for i, b := range parentSK {
+		notIKM[i] = ^b
+	}
+	h := sha256.New()
+	for _, ikm := range [][]byte{parentSK, notIKM} {
+		lamportSK := make([]byte, lamportChunks*sha256.Size)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, hkdf.Extract(sha256.New, ikm, salt), nil), lamportSK); err != nil {
+			panic(err)
+		}
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(lamportSK[i*sha256.Size : (i+1)*sha256.Size])
+			h.Write(chunk[:])
+		}
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,277 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"fmt"
+
+	hbls "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/pkg/errors"
+)
+
+// PublicKey used in the BLS signature scheme.
+type PublicKey struct {
+	p *herumiPublicKey
+	// interned marks keys owned by the public key cache, which may be shared between callers
+	// and are never modified.
+	interned bool
+	// compressed holds the encoding the key was decoded from, so that marshalling it does not
+	// have to compress the point again. It is nil for keys computed otherwise, and shared between
+	// copies since it is never written to.
+	compressed *[common.BLSPubkeyLength]byte
+}
+
+// PublicKeyFromBytes creates a BLS public key from its 48 byte compressed, big-endian encoding.
+func PublicKeyFromBytes(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyLength {
+		return nil, fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubKey))
+	}
+	var newKey [common.BLSPubkeyLength]byte
+	copy(newKey[:], pubKey)
+	if cv, ok := cachedPublicKey(newKey); ok {
+		if !cv.validated {
+			// Inserted by PublicKeyFromBytesNoValidate, which is only missing the infinity check.
+			if cv.pub.IsInfinite() {
+				return nil, common.ErrInfinitePubKey
+			}
+			cachePublicKey(newKey, cv.pub, true)
+		}
+		return internOrCopy(cv.pub), nil
+	}
+	return decompressPublicKey(newKey)
+}
+
+// decompressPublicKey decompresses and validates a public key that is not in the cache, and
+// caches it. The returned key is the cached one while interning is on and a copy otherwise.
+func decompressPublicKey(pubKey [common.BLSPubkeyLength]byte) (*PublicKey, error) {
+	pubKeyObj, err := decodePublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	cachePublicKey(pubKey, pubKeyObj, true)
+	return internOrCopy(pubKeyObj), nil
+}
+
+// decodePublicKey decompresses and validates a public key into a key owned by the caches, without
+// caching it.
+func decodePublicKey(pubKey [common.BLSPubkeyLength]byte) (*PublicKey, error) {
+	// Subgroup check done by herumi when decompressing, see herumi.HerumiInit. herumi accepts
+	// encodings of the point at infinity with other bits set, so the flags are checked first.
+	p := new(herumiPublicKey)
+	if _, err := checkCompressedFlags(pubKey[:]); err != nil || p.Deserialize(pubKey[:]) != nil {
+		return nil, publicKeyDecodeError(pubKey[:])
+	}
+	if p.IsZero() {
+		return nil, common.ErrInfinitePubKey
+	}
+	return &PublicKey{p: p, interned: true, compressed: &pubKey}, nil
+}
+
+// PublicKeyFromBytesNoValidate creates a BLS public key from a BigEndian byte slice without the
+// infinity check performed by PublicKeyFromBytes.
+//
+// herumi always checks the subgroup while decompressing, so unlike with blst, keys outside the
+// G1 subgroup are still rejected. Only use this for keys that were already validated when they
+// were admitted to a trusted store.
+func PublicKeyFromBytesNoValidate(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyLength {
+		return nil, fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubKey))
+	}
+	var newKey [common.BLSPubkeyLength]byte
+	copy(newKey[:], pubKey)
+	if cv, ok := cachedPublicKey(newKey); ok {
+		return cv.pub.Copy(), nil
+	}
+	p := new(herumiPublicKey)
+	if _, err := checkCompressedFlags(pubKey); err != nil || p.Deserialize(pubKey) != nil {
+		return nil, publicKeyDecodeError(pubKey)
+	}
+	pubKeyObj := &PublicKey{p: p, interned: true, compressed: &newKey}
+	cachePublicKey(newKey, pubKeyObj, false)
+	return pubKeyObj.Copy(), nil
+}
+
+// PublicKeyFromUncompressed creates a BLS public key from its 96 byte uncompressed, big-endian
+// encoding, as returned by MarshalUncompressed. The key is subgroup and infinity checked like in
+// PublicKeyFromBytes, but does not go through the public key cache.
+func PublicKeyFromUncompressed(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyUncompressedLength {
+		return nil, fmt.Errorf("%w: uncompressed public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyUncompressedLength, len(pubKey))
+	}
+	p := new(herumiPublicKey)
+	if err := p.DeserializeUncompressed(pubKey); err != nil || p.IsZero() {
+		return nil, uncompressedPublicKeyDecodeError(pubKey)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// AggregatePublicKeys aggregates the provided raw public keys into a single key. Inputs of more
+// than parallelAggregateThreshold keys are decompressed on multiple goroutines, and their errors
+// are prefixed with the index of the offending key.
+func AggregatePublicKeys(pubs [][]byte) (common.PublicKey, error) {
+	if len(pubs) == 0 {
+		return nil, errors.New("nil or empty public keys")
+	}
+	var keys []common.PublicKey
+	if len(pubs) > parallelAggregateThreshold {
+		var err error
+		if keys, err = PublicKeysFromBytes(pubs); err != nil {
+			return nil, err
+		}
+	} else {
+		keys = make([]common.PublicKey, 0, len(pubs))
+		for _, pubkey := range pubs {
+			pubKeyObj, err := PublicKeyFromBytes(pubkey)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, pubKeyObj)
+		}
+	}
+	aggKey, err := AggregateMultiplePubkeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	// Canceling keys aggregate to the identity, which must never be used for verification.
+	if aggKey.IsInfinite() {
+		return nil, common.ErrInfinitePubKey
+	}
+	return aggKey, nil
+}
+
+// Marshal a public key into its 48 byte compressed, big-endian encoding as defined by the
+// ZCash serialization format used in the eth2 spec. It is the same as MarshalCompressed.
+func (p *PublicKey) Marshal() []byte {
+	out := make([]byte, common.BLSPubkeyLength)
+	p.marshalInto(out)
+	return out
+}
+
+// MarshalCompressed returns the 48 byte compressed, big-endian encoding of the public key.
+func (p *PublicKey) MarshalCompressed() []byte {
+	return p.Marshal()
+}
+
+// MarshalInto writes the 48 byte compressed encoding of the public key into dst and returns the
+// number of bytes written. It fails if dst is shorter than 48 bytes. Keys decoded from their
+// compressed encoding are written without allocating, so a buffer reused across calls keeps
+// marshalling many keys allocation free.
+func (p *PublicKey) MarshalInto(dst []byte) (int, error) {
+	if len(dst) < common.BLSPubkeyLength {
+		return 0, fmt.Errorf("public key buffer must hold %d bytes, got %d", common.BLSPubkeyLength, len(dst))
+	}
+	return p.marshalInto(dst), nil
+}
+
+func (p *PublicKey) marshalInto(dst []byte) int {
+	if p.compressed != nil {
+		return copy(dst, p.compressed[:])
+	}
+	return copy(dst, p.p.Serialize())
+}
+
+// MarshalUncompressed returns the 96 byte uncompressed, big-endian encoding of the public key,
+// the x and y coordinates in that order. It is parsed by PublicKeyFromUncompressed.
+func (p *PublicKey) MarshalUncompressed() []byte {
+	return p.p.SerializeUncompressed()
+}
+
+// Copy the public key to a new pointer reference.
+func (p *PublicKey) Copy() common.PublicKey {
+	np := *p.p
+	return &PublicKey{p: &np, compressed: p.compressed}
+}
+
+// IsInfinite checks if the public key is infinite.
+func (p *PublicKey) IsInfinite() bool {
+	return p.p.IsZero()
+}
+
+// Equals checks if the provided public key is equal to
+// the current one. Keys that are nil, unset or of another
+// implementation are never equal.
+func (p *PublicKey) Equals(p2 common.PublicKey) bool {
+	other, ok := p2.(*PublicKey)
+	if !ok || p == nil || other == nil || p.p == nil || other.p == nil {
+		return false
+	}
+	return p.p.IsEqual(other.p)
+}
+
+// VerifyProofOfPossession checks that proof is a proof of possession of the secret key behind
+// this public key, by checking e(P, H(P)) == e(G1, proof) with H hashing under popDst.
+//
+// In IETF draft BLS specification:
+// PopVerify(PK, proof) -> VALID or INVALID: an algorithm that outputs
+//      VALID if proof is valid for PK, and INVALID otherwise.
+func (p *PublicKey) VerifyProofOfPossession(proof common.Signature) bool {
+	sig, ok := proof.(*Signature)
+	if !ok || sig == nil || sig.s == nil || p == nil || p.p == nil || p.IsInfinite() {
+		return false
+	}
+	var g1 herumiPublicKey
+	hbls.BlsGetGeneratorOfPublicKey(&g1)
+	var lhs, rhs hbls.GT
+	hbls.Pairing(&lhs, hbls.CastFromPublicKey(p.p), hashToG2(p.Marshal(), popDst))
+	hbls.Pairing(&rhs, hbls.CastFromPublicKey(&g1), hbls.CastFromSign(sig.s))
+	return lhs.IsEqual(&rhs)
+}
+
+// Aggregate two public keys. The receiver is updated in place to hold the aggregate and is
+// returned. Only the receiver's point is replaced, the point it held before is never written
+// to, so keys sharing it are unaffected; use AggregateWith to leave the receiver untouched.
+// Aggregate panics on keys interned by the public key cache.
+func (p *PublicKey) Aggregate(p2 common.PublicKey) common.PublicKey {
+	if p.interned {
+		panic("blst: Aggregate on an interned public key, use AggregateWith")
+	}
+	p.p = p.AggregateWith(p2).(*PublicKey).p
+	p.compressed = nil
+	return p
+}
+
+// Neg returns the negation -P of the public key as a new key, leaving the receiver untouched.
+// Aggregating a key with its negation gives the point at infinity.
+func (p *PublicKey) Neg() common.PublicKey {
+	var neg hbls.G1
+	hbls.G1Neg(&neg, hbls.CastFromPublicKey(p.p))
+	return &PublicKey{p: hbls.CastToPublicKey(&neg)}
+}
+
+// ScalarMul returns [scalar]P as a new key, leaving the receiver untouched, such as to blind a
+// key by a known factor. scalar is a big-endian integer of any length, reduced mod r. A scalar
+// that is zero mod r gives the point at infinity, which IsInfinite reports and which has to be
+// rejected before the key is used, like PublicKeyFromBytes rejects it.
+func (p *PublicKey) ScalarMul(scalar []byte) common.PublicKey {
+	k := reduceScalar(scalar)
+	var fr hbls.Fr
+	if err := fr.SetBigEndianMod(k[:]); err != nil {
+		panic(err)
+	}
+	var out hbls.G1
+	hbls.G1Mul(&out, hbls.CastFromPublicKey(p.p), &fr)
+	return &PublicKey{p: hbls.CastToPublicKey(&out)}
+}
+
+// AggregateWith returns the aggregate of the two public keys as a new key, leaving both
+// operands untouched.
+func (p *PublicKey) AggregateWith(p2 common.PublicKey) common.PublicKey {
+	agg := *p.p
+	agg.Add(p2.(*PublicKey).p)
+	return &PublicKey{p: &agg}
+}
+
+// AggregateMultiplePubkeys aggregates the provided decompressed keys into a single key.
+func AggregateMultiplePubkeys(pubkeys []common.PublicKey) (common.PublicKey, error) {
+	if len(pubkeys) == 0 {
+		return nil, errors.New("nil or empty public keys")
+	}
+	agg := *pubkeys[0].(*PublicKey).p
+	for _, pubkey := range pubkeys[1:] {
+		agg.Add(pubkey.(*PublicKey).p)
+	}
+	return &PublicKey{p: &agg}, nil
+}
@@ -0,0 +1,117 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+
+	hbls "github.com/herumi/bls-eth-go-binary/bls"
+	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// bls12SecretKey used in the BLS signature scheme.
+type bls12SecretKey struct {
+	p         *herumiSecretKey
+	destroyed bool
+}
+
+// RandKey creates a new private key from 32 bytes of crypto/rand entropy, using it as the
+// input keying material of the IETF key generation.
+func RandKey() (common2.SecretKey, error) {
+	// Generate 32 bytes of randomness
+	var ikm [32]byte
+	if _, err := rand.Read(ikm[:]); err != nil {
+		return nil, fmt.Errorf("could not read random bytes: %w", err)
+	}
+	// Defensive check, that we have not generated a secret key,
+	secKey := &bls12SecretKey{p: hkdfModR(ikm[:])}
+	if IsZero(secKey.Marshal()) {
+		return nil, common2.ErrZeroKey
+	}
+	return secKey, nil
+}
+
+// SecretKeyFromBytes creates a BLS private key from a BigEndian byte slice. The key must be
+// non-zero and less than the curve order.
+func SecretKeyFromBytes(privKey []byte) (common2.SecretKey, error) {
+	if len(privKey) != BLSSecretKeyLength {
+		return nil, fmt.Errorf("secret key must be %d bytes", BLSSecretKeyLength)
+	}
+	if bytes.Compare(privKey, curveOrder[:]) >= 0 {
+		return nil, fmt.Errorf("%w: secret key must be less than the curve order", common2.ErrSecretUnmarshal)
+	}
+	// Zero keys fail to unmarshal, as they do in blst.
+	secKey := new(herumiSecretKey)
+	if err := secKey.Deserialize(privKey); err != nil || secKey.IsZero() {
+		return nil, common2.ErrSecretUnmarshal
+	}
+	return &bls12SecretKey{p: secKey}, nil
+}
+
+// PublicKey obtains the public key corresponding to the BLS secret key.
+func (s *bls12SecretKey) PublicKey() common2.PublicKey {
+	s.checkNotDestroyed()
+	return &PublicKey{p: s.p.GetPublicKey()}
+}
+
+// Sign a message using a secret key - in a beacon/validator client.
+//
+// In IETF draft BLS specification:
+// Sign(SK, message) -> signature: a signing algorithm that generates
+//      a deterministic signature given a secret key SK and a message.
+//
+// In Ethereum proof of stake specification:
+// def Sign(SK: int, message: Bytes) -> BLSSignature
+func (s *bls12SecretKey) Sign(msg []byte) common2.Signature {
+	s.checkNotDestroyed()
+	return &Signature{s: s.p.SignByte(msg)}
+}
+
+// SignProofOfPossession signs the compressed public key under the proof of possession domain.
+//
+// In IETF draft BLS specification:
+// PopProve(SK) -> proof: an algorithm that generates a proof of
+//      possession for the public key corresponding to secret key SK.
+func (s *bls12SecretKey) SignProofOfPossession() common2.Signature {
+	s.checkNotDestroyed()
+	var proof hbls.G2
+	hbls.G2Mul(&proof, hashToG2(s.PublicKey().Marshal(), popDst), hbls.CastFromSecretKey(s.p))
+	return &Signature{s: hbls.CastToSign(&proof)}
+}
+
+// Marshal a secret key into a big-endian byte slice. A destroyed key marshals to nil.
+func (s *bls12SecretKey) Marshal() []byte {
+	if s.destroyed {
+		return nil
+	}
+	return s.p.Serialize()
+}
+
+// Equals checks if the provided secret key is equal to the current one. The scalars are
+// compared in constant time so that comparing keys does not leak their contents through
+// timing.
+func (s *bls12SecretKey) Equals(s2 common2.SecretKey) bool {
+	other, ok := s2.(*bls12SecretKey)
+	if !ok || s == nil || other == nil || s.p == nil || other.p == nil || s.destroyed || other.destroyed {
+		return false
+	}
+	return subtle.ConstantTimeCompare(s.p.Serialize(), other.p.Serialize()) == 1
+}
+
+// Copy the secret key to a new pointer reference.
+func (s *bls12SecretKey) Copy() common2.SecretKey {
+	np := *s.p
+	return &bls12SecretKey{p: &np, destroyed: s.destroyed}
+}
+
+// Destroy overwrites the secret scalar with zeros. Any later use of the key to derive a
+// public key or sign panics, and Marshal returns nil.
+func (s *bls12SecretKey) Destroy() {
+	*s.p = herumiSecretKey{}
+	s.destroyed = true
+}
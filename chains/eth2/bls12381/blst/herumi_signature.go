@@ -0,0 +1,405 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"context"
+	"fmt"
+
+	hbls "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/pkg/errors"
+)
+
+// Signature used in the BLS signature scheme.
+type Signature struct {
+	s *herumiSignature
+}
+
+// SignatureFromBytes creates a BLS signature from its compressed, big-endian encoding.
+func SignatureFromBytes(sig []byte) (common.Signature, error) {
+	if len(sig) != BLSSignatureLength {
+		return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(sig))
+	}
+	var cacheKey [BLSSignatureLength]byte
+	copy(cacheKey[:], sig)
+	if cv, ok := cachedSignature(cacheKey); ok {
+		return cv.Copy(), nil
+	}
+	// Group checked by herumi while decompressing, see herumi.HerumiInit. Infinity is accepted
+	// since an aggregated signature could be infinite.
+	signature := new(herumiSignature)
+	if err := deserializeSignature(signature, sig); err != nil {
+		return nil, errors.New("could not unmarshal bytes into signature")
+	}
+	sigObj := &Signature{s: signature}
+	cacheSignature(cacheKey, sigObj.Copy().(*Signature))
+	return sigObj, nil
+}
+
+// deserializeSignature decompresses the signature sig into s. herumi accepts encodings of the
+// point at infinity with other bits set, which blst rejects, so the flag bits are checked first.
+func deserializeSignature(s *herumiSignature, sig []byte) error {
+	if len(sig) == 0 {
+		return errors.New("empty signature")
+	}
+	if _, err := checkCompressedFlags(sig); err != nil {
+		return err
+	}
+	return s.Deserialize(sig)
+}
+
+// SignatureFromBytesNoValidate creates a BLS signature from its compressed, big-endian
+// encoding. herumi always group checks while decompressing, so this is the same as
+// SignatureFromBytes without the signature cache.
+func SignatureFromBytesNoValidate(sig []byte) (common.Signature, error) {
+	if len(sig) != BLSSignatureLength {
+		return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(sig))
+	}
+	signature := new(herumiSignature)
+	if err := deserializeSignature(signature, sig); err != nil {
+		return nil, errors.New("could not unmarshal bytes into signature")
+	}
+	return &Signature{s: signature}, nil
+}
+
+// AggregateCompressedSignatures converts a list of compressed signatures into a single, aggregated sig.
+func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error) {
+	if len(multiSigs) == 0 {
+		return nil, errors.New("nil or empty signatures")
+	}
+	var agg herumiSignature
+	for i, sig := range multiSigs {
+		var signature herumiSignature
+		if err := deserializeSignature(&signature, sig); err != nil {
+			return nil, errors.New("provided signatures fail the group check and cannot be compressed")
+		}
+		if i == 0 {
+			agg = signature
+		} else {
+			agg.Add(&signature)
+		}
+	}
+	return &Signature{s: &agg}, nil
+}
+
+// MultipleSignaturesFromBytes creates a group of BLS signatures from a list of compressed, big-endian encodings.
+func MultipleSignaturesFromBytes(multiSigs [][]byte) ([]common.Signature, error) {
+	if len(multiSigs) == 0 {
+		return nil, fmt.Errorf("0 signatures provided to the method")
+	}
+	for _, s := range multiSigs {
+		if len(s) != BLSSignatureLength {
+			return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(s))
+		}
+	}
+	wrappedSigs := make([]common.Signature, len(multiSigs))
+	for i, s := range multiSigs {
+		signature := new(herumiSignature)
+		if err := deserializeSignature(signature, s); err != nil {
+			return nil, errors.New("could not unmarshal bytes into signature")
+		}
+		wrappedSigs[i] = &Signature{s: signature}
+	}
+	return wrappedSigs, nil
+}
+
+// Verify a bls signature given a public key, a message.
+//
+// In IETF draft BLS specification:
+// Verify(PK, message, signature) -> VALID or INVALID: a verification
+//      algorithm that outputs VALID if signature is a valid signature of
+//      message under public key PK, and INVALID otherwise.
+//
+// In the Ethereum proof of stake specification:
+// def Verify(PK: BLSPubkey, message: Bytes, signature: BLSSignature) -> bool
+//
+// An infinite public key or signature never verifies.
+func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, false) }()
+	pub, ok := pubKey.(*PublicKey)
+	if !ok || pub == nil || pub.p == nil || s == nil || s.s == nil {
+		return false
+	}
+	if pub.IsInfinite() || s.IsInfinite() {
+		return false
+	}
+	return s.s.VerifyByte(pub.p, msg)
+}
+
+// VerifyHashed verifies the signature over root, a message that has already been hashed down to
+// 32 bytes, typically an eth2 signing root. root is passed to hash-to-curve as is and is not
+// hashed again, so it must be the exact hash-to-curve input that was signed, not the plain
+// message or object it was derived from. It is equivalent to Verify(pubKey, root[:]).
+func (s *Signature) VerifyHashed(pubKey common.PublicKey, root [32]byte) bool {
+	return s.Verify(pubKey, root[:])
+}
+
+// AggregateVerify verifies each public key against its respective message. This is vulnerable to
+// rogue public-key attack. Each user must provide a proof-of-knowledge of the public key.
+//
+// Note: The msgs must be distinct. For maximum performance, this method does not ensure distinct
+// messages.
+//
+// An infinite signature never verifies.
+//
+// Deprecated: Use FastAggregateVerify or use this method in spectests only.
+func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte) bool {
+	size := len(pubKeys)
+	if size == 0 || size != len(msgs) || s.IsInfinite() {
+		return false
+	}
+	rawKeys, rawMsgs := herumiKeysAndMessages(pubKeys, msgs)
+	return s.s.AggregateVerifyNoCheck(rawKeys, rawMsgs)
+}
+
+// FastAggregateVerify verifies all the provided public keys with their aggregated signature.
+// The keys are aggregated first, and neither an aggregate at infinity nor an infinite signature
+// ever verifies. Results are cached while the verification cache is enabled, see
+// SetVerificationCacheSize.
+//
+// In the Ethereum proof of stake specification:
+// def FastAggregateVerify(PKs: Sequence[BLSPubkey], message: Bytes, signature: BLSSignature) -> bool
+func (s *Signature) FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, true) }()
+	if len(pubKeys) == 0 || s.IsInfinite() {
+		return false
+	}
+	return cachedFastAggregateVerify(pubKeys, msg, s, func() bool {
+		aggKey, err := AggregateMultiplePubkeys(pubKeys)
+		if err != nil || aggKey.IsInfinite() {
+			return false
+		}
+		return s.s.VerifyByte(aggKey.(*PublicKey).p, msg[:])
+	})
+}
+
+// FastAggregateVerifyAggregated verifies the signature over a single message against an
+// aggregate public key that was computed up front, such as a stored committee aggregate. It is
+// equivalent to FastAggregateVerify with the keys that aggregated to aggPub, and an aggregate
+// key at infinity never verifies.
+func (s *Signature) FastAggregateVerifyAggregated(aggPub common.PublicKey, msg [32]byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, true) }()
+	pub, ok := aggPub.(*PublicKey)
+	if !ok || pub == nil || pub.p == nil || s == nil || s.s == nil || pub.IsInfinite() || s.IsInfinite() {
+		return false
+	}
+	return s.s.VerifyByte(pub.p, msg[:])
+}
+
+// FastAggregateVerify verifies sig against the aggregate of the provided public keys over a
+// single message, rejecting empty key sets and keys that aggregate to the point at infinity. A nil
+// key or signature, or one of another backend, is false as well.
+func FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte, sig common.Signature) bool {
+	s, ok := sig.(*Signature)
+	if len(pubKeys) == 0 || !ok || s == nil || s.s == nil {
+		recordVerifications(1, false, true)
+		return false
+	}
+	for _, pubKey := range pubKeys {
+		if pub, ok := pubKey.(*PublicKey); !ok || pub == nil || pub.p == nil {
+			recordVerifications(1, false, true)
+			return false
+		}
+	}
+	return s.FastAggregateVerify(pubKeys, msg)
+}
+
+// AggregateVerify verifies sig as the aggregate of signatures where each public key signed its
+// respective message. Unlike the method of the same name, this rejects inputs where any
+// (public key, message) pair appears more than once. An infinite signature never verifies.
+func AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte, sig common.Signature) bool {
+	size := len(pubKeys)
+	if size == 0 || size != len(msgs) || sig == nil || sig.IsInfinite() {
+		return false
+	}
+	type pair struct {
+		pub [common.BLSPubkeyLength]byte
+		msg [32]byte
+	}
+	seen := make(map[pair]struct{}, size)
+	for i := 0; i < size; i++ {
+		key := pair{msg: msgs[i]}
+		copy(key.pub[:], pubKeys[i].Marshal())
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+	}
+	rawKeys, rawMsgs := herumiKeysAndMessages(pubKeys, msgs)
+	return sig.(*Signature).s.AggregateVerifyNoCheck(rawKeys, rawMsgs)
+}
+
+// herumiKeysAndMessages returns the herumi points of pubKeys and msgs concatenated, as taken by
+// herumi's aggregate verification.
+func herumiKeysAndMessages(pubKeys []common.PublicKey, msgs [][32]byte) ([]herumiPublicKey, []byte) {
+	rawKeys := make([]herumiPublicKey, len(pubKeys))
+	rawMsgs := make([]byte, 0, 32*len(msgs))
+	for i := range pubKeys {
+		rawKeys[i] = *pubKeys[i].(*PublicKey).p
+		rawMsgs = append(rawMsgs, msgs[i][:]...)
+	}
+	return rawKeys, rawMsgs
+}
+
+// NewAggregateSignature creates a blank aggregate signature.
+func NewAggregateSignature() common.Signature {
+	return &Signature{s: hbls.HashAndMapToSignature([]byte{'m', 'o', 'c', 'k'})}
+}
+
+// AggregateSignatures converts a list of signatures into a single, aggregated sig.
+func AggregateSignatures(sigs []common.Signature) (common.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("nil or empty signatures")
+	}
+	var agg herumiSignature
+	for i := 0; i < len(sigs); i++ {
+		sig, ok := sigs[i].(*Signature)
+		if !ok || sig == nil || sig.s == nil {
+			return nil, errors.Errorf("signature at index %d is not a valid blst signature", i)
+		}
+		if i == 0 {
+			agg = *sig.s
+		} else {
+			agg.Add(sig.s)
+		}
+	}
+	return &Signature{s: &agg}, nil
+}
+
+// HashToG2 hashes msg to a point of G2 under the caller's domain separation tag, following the
+// BLS12381G2_XMD:SHA-256_SSWU_RO_ suite of RFC 9380. G2 points are represented as signatures in
+// this package, so the point is returned as one. An empty tag is rejected.
+func HashToG2(msg, dst []byte) (common.Signature, error) {
+	if len(dst) == 0 {
+		return nil, errors.New("domain separation tag must not be empty")
+	}
+	return &Signature{s: hbls.CastToSign(hashToG2(msg, dst))}, nil
+}
+
+// VerifyMultipleSignatures verifies a non-singular set of signatures and its respective pubkeys
+// and messages as a random linear combination, see the blst implementation.
+func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
+	rawSigs, rawKeys, rawMsgs, err := prepareMultipleSignatures(sigs, msgs, pubKeys)
+	if err != nil || len(rawSigs) == 0 {
+		return false, err
+	}
+	return multipleAggregateVerify(rawSigs, rawKeys, rawMsgs), nil
+}
+
+// VerifyMultipleSignaturesContext is VerifyMultipleSignatures split into chunks of
+// BatchVerifyChunkSize signatures, each verified as its own random linear combination. The
+// context is checked before every chunk, and its error is returned as soon as it is done.
+func VerifyMultipleSignaturesContext(ctx context.Context, sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	rawSigs, rawKeys, rawMsgs, err := prepareMultipleSignatures(sigs, msgs, pubKeys)
+	if err != nil || len(rawSigs) == 0 {
+		return false, err
+	}
+	chunk := BatchVerifyChunkSize()
+	for i := 0; i < len(rawSigs); i += chunk {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		j := i + chunk
+		if j > len(rawSigs) {
+			j = len(rawSigs)
+		}
+		if !multipleAggregateVerify(rawSigs[i:j], rawKeys[i:j], rawMsgs[32*i:32*j]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// prepareMultipleSignatures checks the inputs of a batch verification and decompresses the
+// signatures. The messages are returned concatenated, and the slices are empty if there is
+// nothing to verify.
+func prepareMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) ([]herumiSignature, []herumiPublicKey, []byte, error) {
+	length := len(sigs)
+	if length != len(pubKeys) || length != len(msgs) {
+		return nil, nil, nil, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			length, len(pubKeys), len(msgs))
+	}
+	if length == 0 {
+		return nil, nil, nil, nil
+	}
+	for i := 0; i < length; i++ {
+		pub, ok := pubKeys[i].(*PublicKey)
+		if !ok || pub == nil || pub.p == nil {
+			return nil, nil, nil, errors.Errorf("public key at index %d is not a valid blst public key", i)
+		}
+	}
+	rawSigs := make([]herumiSignature, length)
+	for i, sig := range sigs {
+		if err := deserializeSignature(&rawSigs[i], sig); err != nil {
+			return nil, nil, nil, errors.New("could not unmarshal bytes into signature")
+		}
+	}
+	rawKeys, rawMsgs := herumiKeysAndMessages(pubKeys, msgs)
+	return rawSigs, rawKeys, rawMsgs, nil
+}
+
+// multipleAggregateVerify verifies the signatures against their public keys and concatenated
+// messages as a random linear combination.
+func multipleAggregateVerify(rawSigs []herumiSignature, rawKeys []herumiPublicKey, rawMsgs []byte) bool {
+	valid := hbls.MultiVerify(rawSigs, rawKeys, rawMsgs)
+	recordVerifications(len(rawSigs), valid, true)
+	return valid
+}
+
+// Marshal a signature into its 96 byte compressed, big-endian encoding.
+func (s *Signature) Marshal() []byte {
+	return s.s.Serialize()
+}
+
+// IsInfinite checks if the signature is the point at infinity, the signature of any message
+// under the infinite public key. It never verifies, except for the empty committee case of
+// Eth2FastAggregateVerify.
+func (s *Signature) IsInfinite() bool {
+	return s.s.IsZero()
+}
+
+// Copy returns a full deep copy of a signature.
+func (s *Signature) Copy() common.Signature {
+	sign := *s.s
+	return &Signature{s: &sign}
+}
+
+// AggregateWith returns the aggregate of the two signatures as a new signature, leaving both
+// operands untouched.
+func (s *Signature) AggregateWith(s2 common.Signature) common.Signature {
+	agg := *s.s
+	agg.Add(s2.(*Signature).s)
+	return &Signature{s: &agg}
+}
+
+// Subtract returns the signature minus other, the aggregate of the signature and the negation of
+// other, as a new signature, leaving both operands untouched. Subtracting a signature that was
+// aggregated before undoes the aggregation. Subtracting one that never was is well defined, the
+// group has no invalid points, but the result is not the signature of anything meaningful.
+func (s *Signature) Subtract(other common.Signature) common.Signature {
+	var diff hbls.Sign
+	hbls.G2Sub(hbls.CastFromSign(&diff), hbls.CastFromSign(s.s), hbls.CastFromSign(other.(*Signature).s))
+	return &Signature{s: &diff}
+}
+
+// combineSignatures returns the sum of scalars[i]*sigs[i], with the scalars given as big-endian
+// integers below the curve order.
+func combineSignatures(sigs []*Signature, scalars [][BLSSecretKeyLength]byte) *Signature {
+	points := make([]hbls.G2, len(sigs))
+	frs := make([]hbls.Fr, len(scalars))
+	for i := range sigs {
+		points[i] = *hbls.CastFromSign(sigs[i].s)
+		if err := frs[i].SetBigEndianMod(scalars[i][:]); err != nil {
+			panic(err)
+		}
+	}
+	var sum hbls.G2
+	hbls.G2MulVec(&sum, points, frs)
+	return &Signature{s: hbls.CastToSign(&sum)}
+}
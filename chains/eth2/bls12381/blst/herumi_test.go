@@ -0,0 +1,57 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && blst_disabled
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+// +build blst_disabled
+
+package blst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	hbls "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noValidateSkipsSubgroupCheck tells whether PublicKeyFromBytesNoValidate and
+// SignatureFromBytesNoValidate accept points outside the subgroup. herumi always checks.
+const noValidateSkipsSubgroupCheck = false
+
+// notInSubgroupSignatureError is the error SignatureFromBytes returns for a point on the curve
+// outside the G2 subgroup, which herumi does not tell from other decoding failures.
+const notInSubgroupSignatureError = "could not unmarshal bytes into signature"
+
+// requireNotInSubgroupPublicKey checks that the compressed b is a point on the curve outside
+// the G1 subgroup, like its blst counterpart in backend_test.go. herumi does not decompress
+// points outside the subgroup, so the curve equation is checked on x instead.
+func requireNotInSubgroupPublicKey(t *testing.T, b []byte) {
+	require.Equal(t, common.ErrPubKeyNotInSubgroup, publicKeyDecodeError(b), "Fixture should be a point on the curve outside the subgroup")
+	var p hbls.G1
+	require.Error(t, p.Deserialize(b), "Fixture should fail the subgroup check")
+}
+
+// requireNotInSubgroupSignature checks that the compressed b is rejected by herumi. Unlike the
+// blst counterpart it cannot tell a point outside the subgroup from one off the curve.
+func requireNotInSubgroupSignature(t *testing.T, b []byte) {
+	var p hbls.G2
+	require.Error(t, p.Deserialize(b), "Fixture should fail the subgroup check")
+}
+
+// rawSign signs msg with the scalar of key, bypassing the Signature wrapper.
+func rawSign(key *bls12SecretKey, msg []byte) *herumiSignature {
+	return key.p.SignByte(msg)
+}
+
+// The expand_message_xmd SHA-256 vectors of RFC 9380, appendix K.2, whose 256 byte tag is
+// hashed before use.
+func TestExpandMessageXMD_LongDST(t *testing.T) {
+	tag := []byte("QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208))
+	assert.Equal(t,
+		hexutil.MustDecode("0xe8dc0c8b686b7ef2074086fbdd2f30e3f8bfbd3bdf177f73f04b97ce618a3ed3"),
+		expandMessageXMD(nil, tag, 0x20))
+	assert.Equal(t,
+		hexutil.MustDecode("0x1a30a5e36fbdb87077552b9d18b9f0aee16e80181d5b951d0471d55b66684914aef87dbb3626eaabf5ded8cd0686567e503853e5c84c259ba0efc37f71c839da2129fe81afdaec7fbdc0ccd4c794727a17c0d20ff0ea55e1389d6982d1241cb8d165762dbc39fb0cee4474d2cbbd468a835ae5b2f20e4f959f56ab24cd6fe267"),
+		expandMessageXMD([]byte("abc"), tag, 0x80))
+}
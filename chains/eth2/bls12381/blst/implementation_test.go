@@ -0,0 +1,110 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The vectors below were produced by the blst implementation. Running this test both with and
+// without the blst_disabled tag checks that the herumi fallback encodes, signs and verifies
+// exactly like blst.
+const (
+	implSecretKey1        = "0x263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+	implSecretKey2        = "0x47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138"
+	implPublicKey1        = "0xa491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	implPublicKey1Uncomp  = "0x0491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a17cd7061575d3e8034fcea62adaa1a3bc38dca4b50e4c5c01d04dd78037c9cee914e17944ea99e7ad84278e5d49f36c4"
+	implPublicKey2        = "0xb301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81"
+	implSignature1        = "0xaa900c7d3200064c9bf75d95cd384de80f46da5c6ae5e744d078b6fc436b470b2e6c8c3d67705de31f65a962256b6ce91664893f05f77fad99e379cef7f7d03dba8595b5c618ab07bac4c553237a3dadb11383e457bc2bbd0cce90dcf96f8a74"
+	implProofOfPossession = "0xb803eb0ed93ea10224a73b6b9c725796be9f5fefd215ef7a5b97234cc956cf6870db6127b7e4d824ec62276078e787db05584ce1adbf076bc0808ca0f15b73d59060254b25393d95dfc7abe3cda566842aaedf50bbb062aae1bbb6ef3b1f77e1"
+	implAggregateKey      = "0xa10d7b8a1f6b4b3e7048d06478b88c0f2257f0517b12fdfe59e33ec6240c39f9fc7d4f04e8a37c33e64258ed2fa45850"
+	implAggregateSig      = "0xa2709c1428e13319c44162119db5c49dfca70592fe4cac0af1cd12f570f9e60afaf491a694c9edea38076fa2a56d2bbe0420c886d7f9e765641b63f1df248da8d91b2f894640b565092d3feecaf3b108c179364410d375ff76424e91277cdc73"
+	implBlankSignature    = "0xae09507041b2ccb9e3b3f9cda71ffae3dc8b2c83f331ebdc98cc4269c56bd4db05706bf317c8877608bc751b36d9af380c5fea6bc804d2080940b3910acc8f222fc4b59166630d8a3b31eba539325c2c60aaaa0408e986241cb462fad8652bdc"
+)
+
+var implMessage = []byte("atlas light client")
+
+func TestImplementation_MatchesBlstVectors(t *testing.T) {
+	sk1, err := blst.SecretKeyFromBytes(hexutil.MustDecode(implSecretKey1))
+	require.NoError(t, err)
+	sk2, err := blst.SecretKeyFromBytes(hexutil.MustDecode(implSecretKey2))
+	require.NoError(t, err)
+	assert.Equal(t, implSecretKey1, hexutil.Encode(sk1.Marshal()))
+
+	pub1 := sk1.PublicKey()
+	pub2 := sk2.PublicKey()
+	assert.Equal(t, implPublicKey1, hexutil.Encode(pub1.Marshal()))
+	assert.Equal(t, implPublicKey1Uncomp, hexutil.Encode(pub1.(*blst.PublicKey).MarshalUncompressed()))
+	assert.Equal(t, implPublicKey2, hexutil.Encode(pub2.Marshal()))
+
+	sig1 := sk1.Sign(implMessage)
+	sig2 := sk2.Sign(implMessage)
+	assert.Equal(t, implSignature1, hexutil.Encode(sig1.Marshal()))
+	assert.Equal(t, implProofOfPossession, hexutil.Encode(sk1.SignProofOfPossession().Marshal()))
+
+	aggKey, err := blst.AggregateMultiplePubkeys([]common.PublicKey{pub1, pub2})
+	require.NoError(t, err)
+	assert.Equal(t, implAggregateKey, hexutil.Encode(aggKey.Marshal()))
+	aggSig, err := blst.AggregateSignatures([]common.Signature{sig1, sig2})
+	require.NoError(t, err)
+	assert.Equal(t, implAggregateSig, hexutil.Encode(aggSig.Marshal()))
+	assert.Equal(t, implBlankSignature, hexutil.Encode(blst.NewAggregateSignature().Marshal()))
+}
+
+func TestImplementation_VerifiesBlstVectors(t *testing.T) {
+	pub1, err := blst.PublicKeyFromBytes(hexutil.MustDecode(implPublicKey1))
+	require.NoError(t, err)
+	pub2, err := blst.PublicKeyFromBytes(hexutil.MustDecode(implPublicKey2))
+	require.NoError(t, err)
+	uncompressed, err := blst.PublicKeyFromUncompressed(hexutil.MustDecode(implPublicKey1Uncomp))
+	require.NoError(t, err)
+	assert.True(t, pub1.Equals(uncompressed))
+
+	sig1, err := blst.SignatureFromBytes(hexutil.MustDecode(implSignature1))
+	require.NoError(t, err)
+	assert.True(t, sig1.Verify(pub1, implMessage))
+	assert.False(t, sig1.Verify(pub2, implMessage))
+	valid, err := blst.VerifyCompressed(hexutil.MustDecode(implPublicKey1), hexutil.MustDecode(implSignature1), implMessage)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	pop, err := blst.SignatureFromBytes(hexutil.MustDecode(implProofOfPossession))
+	require.NoError(t, err)
+	assert.True(t, pub1.VerifyProofOfPossession(pop))
+	assert.False(t, pub2.VerifyProofOfPossession(pop))
+	assert.False(t, pub1.VerifyProofOfPossession(sig1))
+
+	var msg [32]byte
+	copy(msg[:], implMessage)
+	sk1, err := blst.SecretKeyFromBytes(hexutil.MustDecode(implSecretKey1))
+	require.NoError(t, err)
+	sk2, err := blst.SecretKeyFromBytes(hexutil.MustDecode(implSecretKey2))
+	require.NoError(t, err)
+	aggSig, err := blst.AggregateSignatures([]common.Signature{sk1.Sign(msg[:]), sk2.Sign(msg[:])})
+	require.NoError(t, err)
+	assert.True(t, blst.FastAggregateVerify([]common.PublicKey{pub1, pub2}, msg, aggSig))
+	assert.False(t, blst.FastAggregateVerify([]common.PublicKey{pub1}, msg, aggSig))
+
+	var other [32]byte
+	copy(other[:], "another message")
+	distinct, err := blst.AggregateSignatures([]common.Signature{sk1.Sign(msg[:]), sk2.Sign(other[:])})
+	require.NoError(t, err)
+	assert.True(t, blst.AggregateVerify([]common.PublicKey{pub1, pub2}, [][32]byte{msg, other}, distinct))
+	assert.False(t, blst.AggregateVerify([]common.PublicKey{pub1, pub2}, [][32]byte{other, msg}, distinct))
+
+	sigs := [][]byte{sk1.Sign(msg[:]).Marshal(), sk2.Sign(other[:]).Marshal()}
+	ok, err := blst.VerifyMultipleSignatures(sigs, [][32]byte{msg, other}, []common.PublicKey{pub1, pub2})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = blst.VerifyMultipleSignaturesContext(context.Background(), sigs, [][32]byte{other, msg}, []common.PublicKey{pub1, pub2})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,280 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const keystoreVersion = 4
+
+// Scrypt parameters used by EncryptKeystore, the defaults recommended by EIP-2335.
+var (
+	keystoreScryptN = 1 << 18
+	keystoreScryptR = 8
+	keystoreScryptP = 1
+)
+
+// Upper bounds on the KDF parameters accepted by DecryptKeystore. The parameters are read from
+// the keystore before the password is checked, so without bounds a crafted keystore could make
+// decryption allocate gigabytes or run for minutes. The scrypt bounds are the EIP-2335 defaults,
+// which need 256MB; DecryptKeystoreWithScryptLimits accepts keystores made with larger ones.
+const (
+	maxKeystoreScryptN  = 1 << 18
+	maxKeystoreScryptRP = 8
+	maxKeystorePbkdf2C  = 1 << 22
+	maxKeystoreDKLen    = 64
+)
+
+// keystoreJSON is the EIP-2335 keystore layout.
+type keystoreJSON struct {
+	Crypto      keystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	Pubkey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     int            `json:"version"`
+}
+
+type keystoreCrypto struct {
+	KDF      keystoreModule `json:"kdf"`
+	Checksum keystoreModule `json:"checksum"`
+	Cipher   keystoreModule `json:"cipher"`
+}
+
+type keystoreModule struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// EncryptKeystore encrypts a secret key into an EIP-2335 keystore, using scrypt and
+// AES-128-CTR.
+func EncryptKeystore(sk common2.SecretKey, password string) ([]byte, error) {
+	plainText := sk.Marshal()
+	if plainText == nil {
+		return nil, errors.New("cannot encrypt a destroyed secret key")
+	}
+	var salt [32]byte
+	var iv [aes.BlockSize]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return nil, fmt.Errorf("could not read random bytes: %w", err)
+	}
+	if _, err := rand.Read(iv[:]); err != nil {
+		return nil, fmt.Errorf("could not read random bytes: %w", err)
+	}
+	kdf := scryptParams{DKLen: 32, N: keystoreScryptN, R: keystoreScryptR, P: keystoreScryptP, Salt: hex.EncodeToString(salt[:])}
+	key, err := scrypt.Key(keystorePassword(password), salt[:], kdf.N, kdf.R, kdf.P, kdf.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(key[:16], iv[:], plainText)
+	if err != nil {
+		return nil, err
+	}
+	checksum := keystoreChecksum(key, cipherText)
+
+	kdfParams, err := json.Marshal(kdf)
+	if err != nil {
+		return nil, err
+	}
+	ivParams, err := json.Marshal(cipherParams{IV: hex.EncodeToString(iv[:])})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(keystoreJSON{
+		Crypto: keystoreCrypto{
+			KDF:      keystoreModule{Function: "scrypt", Params: kdfParams, Message: ""},
+			Checksum: keystoreModule{Function: "sha256", Params: json.RawMessage("{}"), Message: hex.EncodeToString(checksum)},
+			Cipher:   keystoreModule{Function: "aes-128-ctr", Params: ivParams, Message: hex.EncodeToString(cipherText)},
+		},
+		Pubkey:  hex.EncodeToString(sk.PublicKey().Marshal()),
+		UUID:    uuid.New().String(),
+		Version: keystoreVersion,
+	})
+}
+
+// DecryptKeystore decrypts the secret key of an EIP-2335 keystore. A wrong password returns
+// common.ErrKeystorePassword, any other error means the keystore is malformed. Scrypt keystores
+// must not use a larger n or r*p than the EIP-2335 defaults.
+func DecryptKeystore(data []byte, password string) (common2.SecretKey, error) {
+	return DecryptKeystoreWithScryptLimits(data, password, maxKeystoreScryptN, maxKeystoreScryptRP)
+}
+
+// DecryptKeystoreWithScryptLimits decrypts a keystore like DecryptKeystore, but accepts scrypt
+// parameters up to n of maxN and r*p of maxRP. Decryption needs 128*n*r*p bytes of memory before
+// the password is checked, so the limits should only be raised for keystores from a trusted
+// source.
+func DecryptKeystoreWithScryptLimits(data []byte, password string, maxN, maxRP int) (common2.SecretKey, error) {
+	var ks keystoreJSON
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %w", err)
+	}
+	if ks.Version != keystoreVersion {
+		return nil, fmt.Errorf("invalid keystore: unsupported version %d", ks.Version)
+	}
+	key, err := keystoreDecryptionKey(ks.Crypto.KDF, keystorePassword(password), maxN, maxRP)
+	if err != nil {
+		return nil, err
+	}
+
+	if ks.Crypto.Checksum.Function != "sha256" {
+		return nil, fmt.Errorf("invalid keystore: unsupported checksum function %q", ks.Crypto.Checksum.Function)
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore: bad checksum: %w", err)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore: bad cipher message: %w", err)
+	}
+	if subtle.ConstantTimeCompare(keystoreChecksum(key, cipherText), checksum) != 1 {
+		return nil, common2.ErrKeystorePassword
+	}
+
+	if ks.Crypto.Cipher.Function != "aes-128-ctr" {
+		return nil, fmt.Errorf("invalid keystore: unsupported cipher function %q", ks.Crypto.Cipher.Function)
+	}
+	var params cipherParams
+	if err := json.Unmarshal(ks.Crypto.Cipher.Params, &params); err != nil {
+		return nil, fmt.Errorf("invalid keystore: bad cipher params: %w", err)
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid keystore: iv must be %d hex encoded bytes", aes.BlockSize)
+	}
+	plainText, err := aes128CTR(key[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := SecretKeyFromBytes(plainText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore: %w", err)
+	}
+	if ks.Pubkey != "" && !strings.EqualFold(ks.Pubkey, hex.EncodeToString(sk.PublicKey().Marshal())) {
+		return nil, fmt.Errorf("invalid keystore: secret key does not match pubkey %s", ks.Pubkey)
+	}
+	return sk, nil
+}
+
+// keystoreDecryptionKey runs the keystore KDF over the processed password, with the scrypt
+// parameters bounded by maxN and maxRP.
+func keystoreDecryptionKey(kdf keystoreModule, password []byte, maxN, maxRP int) ([]byte, error) {
+	switch kdf.Function {
+	case "scrypt":
+		var params scryptParams
+		if err := json.Unmarshal(kdf.Params, &params); err != nil {
+			return nil, fmt.Errorf("invalid keystore: bad kdf params: %w", err)
+		}
+		if params.N > maxN {
+			return nil, fmt.Errorf("invalid keystore: scrypt n must be at most %d, got %d", maxN, params.N)
+		}
+		if params.R <= 0 || params.P <= 0 || params.R > maxRP || params.P > maxRP || params.R*params.P > maxRP {
+			return nil, fmt.Errorf("invalid keystore: scrypt r and p must be positive with r*p at most %d, got r=%d, p=%d", maxRP, params.R, params.P)
+		}
+		salt, err := keystoreSalt(params.Salt, params.DKLen)
+		if err != nil {
+			return nil, err
+		}
+		key, err := scrypt.Key(password, salt, params.N, params.R, params.P, params.DKLen)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keystore: %w", err)
+		}
+		return key, nil
+	case "pbkdf2":
+		var params pbkdf2Params
+		if err := json.Unmarshal(kdf.Params, &params); err != nil {
+			return nil, fmt.Errorf("invalid keystore: bad kdf params: %w", err)
+		}
+		if params.PRF != "hmac-sha256" {
+			return nil, fmt.Errorf("invalid keystore: unsupported prf %q", params.PRF)
+		}
+		if params.C <= 0 || params.C > maxKeystorePbkdf2C {
+			return nil, fmt.Errorf("invalid keystore: pbkdf2 count must be in [1, %d], got %d", maxKeystorePbkdf2C, params.C)
+		}
+		salt, err := keystoreSalt(params.Salt, params.DKLen)
+		if err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(password, salt, params.C, params.DKLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("invalid keystore: unsupported kdf function %q", kdf.Function)
+	}
+}
+
+func keystoreSalt(salt string, dkLen int) ([]byte, error) {
+	if dkLen < 32 || dkLen > maxKeystoreDKLen {
+		return nil, fmt.Errorf("invalid keystore: dklen must be in [32, %d], got %d", maxKeystoreDKLen, dkLen)
+	}
+	b, err := hex.DecodeString(salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore: bad salt: %w", err)
+	}
+	return b, nil
+}
+
+// keystorePassword normalizes the password to NFKD and strips control codes, as required by
+// EIP-2335.
+func keystorePassword(password string) []byte {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(password) {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return []byte(b.String())
+}
+
+func keystoreChecksum(key, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(key[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
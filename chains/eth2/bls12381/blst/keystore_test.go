@@ -0,0 +1,213 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://eips.ethereum.org/EIPS/eip-2335#test-cases.
+const (
+	eip2335Password = "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511"
+	eip2335Secret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	eip2335Scrypt = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	eip2335Pbkdf2 = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+// lightScrypt lowers the scrypt cost of EncryptKeystore for the duration of a test.
+func lightScrypt(t *testing.T) {
+	n := keystoreScryptN
+	keystoreScryptN = 1 << 4
+	t.Cleanup(func() { keystoreScryptN = n })
+}
+
+func TestDecryptKeystore_EIP2335Vectors(t *testing.T) {
+	for name, vector := range map[string]string{"pbkdf2": eip2335Pbkdf2, "scrypt": eip2335Scrypt} {
+		t.Run(name, func(t *testing.T) {
+			if name == "scrypt" && testing.Short() {
+				t.Skip("scrypt vector needs 256MB of memory")
+			}
+			sk, err := DecryptKeystore([]byte(vector), eip2335Password)
+			require.NoError(t, err)
+			assert.Equal(t, eip2335Secret, hex.EncodeToString(sk.Marshal()))
+
+			_, err = DecryptKeystore([]byte(vector), "testpassword")
+			assert.Equal(t, common.ErrKeystorePassword, err)
+		})
+	}
+}
+
+func TestKeystorePassword(t *testing.T) {
+	// NFKD maps the mathematical fraktur letters onto ASCII and control codes are stripped.
+	assert.Equal(t, "testpassword\U0001f511", string(keystorePassword(eip2335Password)))
+	assert.Equal(t, "password", string(keystorePassword("pass\x00\x7f\u0085word\n")))
+}
+
+func TestEncryptKeystore_RoundTrip(t *testing.T) {
+	lightScrypt(t)
+	sk, err := RandKey()
+	require.NoError(t, err)
+
+	data, err := EncryptKeystore(sk, "secret")
+	require.NoError(t, err)
+	var ks keystoreJSON
+	require.NoError(t, json.Unmarshal(data, &ks))
+	assert.Equal(t, 4, ks.Version)
+	assert.Equal(t, "scrypt", ks.Crypto.KDF.Function)
+	assert.Equal(t, hex.EncodeToString(sk.PublicKey().Marshal()), ks.Pubkey)
+
+	dec, err := DecryptKeystore(data, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, sk.Marshal(), dec.Marshal())
+
+	_, err = DecryptKeystore(data, "wrong")
+	assert.Equal(t, common.ErrKeystorePassword, err)
+}
+
+// kdfParams returns the KDF params of ks with the field name set to value.
+func kdfParams(t *testing.T, ks *keystoreJSON, name string, value int) json.RawMessage {
+	var params map[string]interface{}
+	require.NoError(t, json.Unmarshal(ks.Crypto.KDF.Params, &params))
+	params[name] = value
+	out, err := json.Marshal(params)
+	require.NoError(t, err)
+	return out
+}
+
+func TestDecryptKeystore_Malformed(t *testing.T) {
+	lightScrypt(t)
+	sk, err := RandKey()
+	require.NoError(t, err)
+	data, err := EncryptKeystore(sk, "secret")
+	require.NoError(t, err)
+
+	mutate := func(f func(ks *keystoreJSON)) []byte {
+		var ks keystoreJSON
+		require.NoError(t, json.Unmarshal(data, &ks))
+		f(&ks)
+		out, err := json.Marshal(ks)
+		require.NoError(t, err)
+		return out
+	}
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{name: "NotJSON", input: []byte("{"), err: "invalid keystore"},
+		{name: "Version", input: mutate(func(ks *keystoreJSON) { ks.Version = 3 }), err: "unsupported version 3"},
+		{name: "KDF", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Function = "argon2" }), err: "unsupported kdf function"},
+		{name: "Checksum", input: mutate(func(ks *keystoreJSON) { ks.Crypto.Checksum.Message = "zz" }), err: "bad checksum"},
+		{name: "Cipher", input: mutate(func(ks *keystoreJSON) { ks.Crypto.Cipher.Function = "aes-256-gcm" }), err: "unsupported cipher function"},
+		{name: "Pubkey", input: mutate(func(ks *keystoreJSON) { ks.Pubkey = hex.EncodeToString(common.InfinitePublicKey[:]) }), err: "does not match pubkey"},
+		{name: "ScryptN", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "n", 1<<30) }), err: "scrypt n must be at most"},
+		{name: "ScryptNAboveDefault", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "n", 1<<19) }), err: "scrypt n must be at most 262144"},
+		{name: "ScryptRPAboveDefault", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "r", 16) }), err: "r*p at most 8"},
+		{name: "ScryptRP", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "p", 1<<29) }), err: "r*p at most"},
+		{name: "ScryptNegativeR", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "r", -8) }), err: "must be positive"},
+		{name: "DKLen", input: mutate(func(ks *keystoreJSON) { ks.Crypto.KDF.Params = kdfParams(t, ks, "dklen", 1<<30) }), err: "dklen must be in"},
+		{name: "Pbkdf2C", input: mutate(func(ks *keystoreJSON) {
+			ks.Crypto.KDF.Function = "pbkdf2"
+			ks.Crypto.KDF.Params = json.RawMessage(`{"dklen": 32, "c": 1073741824, "prf": "hmac-sha256", "salt": "00"}`)
+		}), err: "pbkdf2 count must be in"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := DecryptKeystore(test.input, "secret")
+			require.Error(t, err)
+			assert.NotEqual(t, common.ErrKeystorePassword, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestDecryptKeystoreWithScryptLimits(t *testing.T) {
+	lightScrypt(t)
+	r := keystoreScryptR
+	keystoreScryptR = 16
+	t.Cleanup(func() { keystoreScryptR = r })
+	sk, err := RandKey()
+	require.NoError(t, err)
+	data, err := EncryptKeystore(sk, "secret")
+	require.NoError(t, err)
+
+	_, err = DecryptKeystore(data, "secret")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "r*p at most 8")
+
+	dec, err := DecryptKeystoreWithScryptLimits(data, "secret", 1<<18, 16)
+	require.NoError(t, err)
+	assert.Equal(t, sk.Marshal(), dec.Marshal())
+	_, err = DecryptKeystoreWithScryptLimits(data, "wrong", 1<<18, 16)
+	assert.Equal(t, common.ErrKeystorePassword, err)
+}
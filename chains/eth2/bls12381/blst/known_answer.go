@@ -0,0 +1,143 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// knownAnswerKeys are the secret keys of the BLS test vectors of the consensus specs, with
+// the compressed public keys they derive.
+var knownAnswerKeys = []struct {
+	secret string
+	public string
+}{
+	{
+		secret: "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+		public: "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+	},
+	{
+		secret: "47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+		public: "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+	},
+	{
+		secret: "328388aff0d4a5b7dc9205abd374e7e98f3cd9f3418edb4eafda5fb16473d216",
+		public: "b53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f",
+	},
+}
+
+// knownAnswerVectors are the signatures of the sign test vectors of the consensus specs, by
+// each of knownAnswerKeys, over a 32 byte message repeating a single byte, and their aggregate
+// from the aggregate test vectors.
+var knownAnswerVectors = []struct {
+	message    byte
+	signatures []string
+	aggregate  string
+}{
+	{
+		message: 0x00,
+		signatures: []string{
+			"b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55",
+			"b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9",
+			"948a7cb99f76d616c2c564ce9bf4a519f1bea6b0a624a02276443c245854219fabb8d4ce061d255af5330b078d5380681751aa7053da2c98bae898edc218c75f07e24d8802a17cd1f6833b71e58f5eb5b94208b4d0bb3848cecb075ea21be115",
+		},
+		aggregate: "9683b3e6701f9a4b706709577963110043af78a5b41991b998475a3d3fd62abf35ce03b33908418efc95a058494a8ae504354b9f626231f6b3f3c849dfdeaf5017c4780e2aee1850ceaf4b4d9ce70971a3d2cfcd97b7e5ecf6759f8da5f76d31",
+	},
+	{
+		message: 0x56,
+		signatures: []string{
+			"882730e5d03f6b42c3abc26d3372625034e1d871b65a8a6b900a56dae22da98abbe1b68f85e49fe7652a55ec3d0591c20767677e33e5cbb1207315c41a9ac03be39c2e7668edc043d6cb1d9fd93033caa8a1c5b0e84bedaeb6c64972503a43eb",
+			"af1390c3c47acdb37131a51216da683c509fce0e954328a59f93aebda7e4ff974ba208d9a4a2a2389f892a9d418d618418dd7f7a6bc7aa0da999a9d3a5b815bc085e14fd001f6a1948768a3f4afefc8b8240dda329f984cb345c6363272ba4fe",
+			"a4efa926610b8bd1c8330c918b7a5e9bf374e53435ef8b7ec186abf62e1b1f65aeaaeb365677ac1d1172a1f5b44b4e6d022c252c58486c0a759fbdc7de15a756acc4d343064035667a594b4c2a6f0b0b421975977f297dba63ee2f63ffe47bb6",
+		},
+		aggregate: "ad38fc73846583b08d110d16ab1d026c6ea77ac2071e8ae832f56ac0cbcdeb9f5678ba5ce42bd8dce334cc47b5abcba40a58f7f1f80ab304193eb98836cc14d8183ec14cc77de0f80c4ffd49e168927a968b5cdaa4cf46b9805be84ad7efa77b",
+	},
+	{
+		message: 0xab,
+		signatures: []string{
+			"91347bccf740d859038fcdcaf233eeceb2a436bcaaee9b2aa3bfb70efe29dfb2677562ccbea1c8e061fb9971b0753c240622fab78489ce96768259fc01360346da5b9f579e5da0d941e4c6ba18a0e64906082375394f337fa1af2b7127b0d121",
+			"9674e2228034527f4c083206032b020310face156d4a4685e2fcaec2f6f3665aa635d90347b6ce124eb879266b1e801d185de36a0a289b85e9039662634f2eea1e02e670bc7ab849d006a70b2f93b84597558a05b879c8d445f387a5d5b653df",
+			"ae82747ddeefe4fd64cf9cedb9b04ae3e8a43420cd255e3c7cd06a8d88b7c7f8638543719981c5d16fa3527c468c25f0026704a6951bde891360c7e8d12ddee0559004ccdbe6046b55bae1b257ee97f7cdb955773d7cf29adf3ccbb9975e4eb9",
+		},
+		aggregate: "9712c3edd73a209c742b8250759db12549b3eaf43b5ca61376d9f30e2747dbcf842d8b2ac0901d2a093713e20284a7670fcf6954e9ab93de991bb9b313e664785a075fc285806fa5224c82bde146561b446ccfc706a64b8579513cfc4ff1d930",
+	},
+}
+
+// RunKnownAnswerTests checks the BLS implementation against embedded test vectors of the
+// consensus specs: it derives the public keys, signs, verifies, aggregates the signatures and
+// fast aggregate verifies them, and returns an error describing the first mismatch. Verification
+// is also checked to fail for a wrong key and an incomplete key set. It is never run
+// automatically; callers that need a self-test before trusting the implementation should run it
+// during their startup.
+func RunKnownAnswerTests() error {
+	secretKeys := make([]common.SecretKey, len(knownAnswerKeys))
+	pubKeys := make([]common.PublicKey, len(knownAnswerKeys))
+	for i, k := range knownAnswerKeys {
+		sk, err := SecretKeyFromBytes(decodeKnownAnswer(k.secret))
+		if err != nil {
+			return fmt.Errorf("bls known answer test: secret key %d: %w", i, err)
+		}
+		pub, err := PublicKeyFromBytes(decodeKnownAnswer(k.public))
+		if err != nil {
+			return fmt.Errorf("bls known answer test: public key %d: %w", i, err)
+		}
+		if !sk.PublicKey().Equals(pub) {
+			return fmt.Errorf("bls known answer test: public key %d does not match its secret key", i)
+		}
+		secretKeys[i], pubKeys[i] = sk, pub
+	}
+
+	for _, v := range knownAnswerVectors {
+		var msg [32]byte
+		for i := range msg {
+			msg[i] = v.message
+		}
+		sigs := make([]common.Signature, len(v.signatures))
+		for i, s := range v.signatures {
+			want := decodeKnownAnswer(s)
+			if got := secretKeys[i].Sign(msg[:]).Marshal(); !bytes.Equal(got, want) {
+				return fmt.Errorf("bls known answer test: signature of key %d over message %#x does not match", i, msg)
+			}
+			sig, err := SignatureFromBytes(want)
+			if err != nil {
+				return fmt.Errorf("bls known answer test: signature of key %d over message %#x: %w", i, msg, err)
+			}
+			if !sig.Verify(pubKeys[i], msg[:]) {
+				return fmt.Errorf("bls known answer test: signature of key %d over message %#x does not verify", i, msg)
+			}
+			if sig.Verify(pubKeys[(i+1)%len(pubKeys)], msg[:]) {
+				return fmt.Errorf("bls known answer test: signature of key %d over message %#x verifies under another key", i, msg)
+			}
+			sigs[i] = sig
+		}
+
+		agg, err := AggregateSignatures(sigs)
+		if err != nil {
+			return fmt.Errorf("bls known answer test: aggregate over message %#x: %w", msg, err)
+		}
+		if !bytes.Equal(agg.Marshal(), decodeKnownAnswer(v.aggregate)) {
+			return fmt.Errorf("bls known answer test: aggregate over message %#x does not match", msg)
+		}
+		if !FastAggregateVerify(pubKeys, msg, agg) {
+			return fmt.Errorf("bls known answer test: aggregate over message %#x does not fast aggregate verify", msg)
+		}
+		if FastAggregateVerify(pubKeys[1:], msg, agg) {
+			return fmt.Errorf("bls known answer test: aggregate over message %#x verifies without all of its keys", msg)
+		}
+	}
+	return nil
+}
+
+// decodeKnownAnswer decodes an embedded hex vector, which is always valid.
+func decodeKnownAnswer(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,27 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunKnownAnswerTests(t *testing.T) {
+	require.NoError(t, RunKnownAnswerTests())
+}
+
+func TestRunKnownAnswerTests_DetectsMismatch(t *testing.T) {
+	vector := &knownAnswerVectors[1]
+	sig := vector.signatures[2]
+	t.Cleanup(func() { vector.signatures[2] = sig })
+
+	// A valid signature, but over another message.
+	vector.signatures[2] = knownAnswerVectors[0].signatures[2]
+	err := RunKnownAnswerTests()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature of key 2 over message 0x5656")
+}
@@ -0,0 +1,50 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import "sync/atomic"
+
+// Counters backing VerificationMetrics, updated atomically.
+var verificationsTotal, verificationFailures, aggregateVerifications uint64
+
+// VerificationStats reports the signature verifications done since the last reset.
+//
+// TotalVerifications counts signatures checked by Verify, FastAggregateVerify and the batch
+// verifications, and Failures the ones that were rejected. Batches are verified as a whole, so
+// a rejected batch counts all of its signatures as failures. AggregationCount counts the
+// verifications against an aggregate: every FastAggregateVerify, and every batch, or chunk of
+// VerifyMultipleSignaturesContext.
+type VerificationStats struct {
+	TotalVerifications uint64
+	Failures           uint64
+	AggregationCount   uint64
+}
+
+// VerificationMetrics returns a snapshot of the verification counters.
+func VerificationMetrics() VerificationStats {
+	return VerificationStats{
+		TotalVerifications: atomic.LoadUint64(&verificationsTotal),
+		Failures:           atomic.LoadUint64(&verificationFailures),
+		AggregationCount:   atomic.LoadUint64(&aggregateVerifications),
+	}
+}
+
+// ResetVerificationMetrics zeroes the verification counters.
+func ResetVerificationMetrics() {
+	atomic.StoreUint64(&verificationsTotal, 0)
+	atomic.StoreUint64(&verificationFailures, 0)
+	atomic.StoreUint64(&aggregateVerifications, 0)
+}
+
+// recordVerifications counts n signatures verified together with the given result. aggregate
+// marks a verification against an aggregate.
+func recordVerifications(n int, valid, aggregate bool) {
+	atomic.AddUint64(&verificationsTotal, uint64(n))
+	if !valid {
+		atomic.AddUint64(&verificationFailures, uint64(n))
+	}
+	if aggregate {
+		atomic.AddUint64(&aggregateVerifications, 1)
+	}
+}
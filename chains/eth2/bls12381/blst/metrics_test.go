@@ -0,0 +1,55 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationMetrics(t *testing.T) {
+	blst.ResetVerificationMetrics()
+	t.Cleanup(blst.ResetVerificationMetrics)
+
+	var msg [32]byte
+	copy(msg[:], "metrics")
+	sks := make([]common.SecretKey, 3)
+	pubs := make([]common.PublicKey, 3)
+	sigs := make([]common.Signature, 3)
+	rawSigs := make([][]byte, 3)
+	for i := range sks {
+		sk, err := blst.RandKey()
+		require.NoError(t, err)
+		sks[i], pubs[i], sigs[i] = sk, sk.PublicKey(), sk.Sign(msg[:])
+		rawSigs[i] = sigs[i].Marshal()
+	}
+	assert.Equal(t, blst.VerificationStats{}, blst.VerificationMetrics())
+
+	assert.True(t, sigs[0].Verify(pubs[0], msg[:]))
+	assert.False(t, sigs[0].Verify(pubs[1], msg[:]))
+	assert.Equal(t, blst.VerificationStats{TotalVerifications: 2, Failures: 1}, blst.VerificationMetrics())
+
+	agg, err := blst.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.True(t, blst.FastAggregateVerify(pubs, msg, agg))
+	assert.False(t, agg.FastAggregateVerify(pubs[1:], msg))
+	assert.Equal(t, blst.VerificationStats{TotalVerifications: 4, Failures: 2, AggregationCount: 2}, blst.VerificationMetrics())
+
+	msgs := [][32]byte{msg, msg, msg}
+	ok, err := blst.VerifyMultipleSignatures(rawSigs, msgs, pubs)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	// A rejected batch counts all of its signatures as failures.
+	ok, err = blst.VerifyMultipleSignatures(rawSigs, msgs, []common.PublicKey{pubs[1], pubs[0], pubs[2]})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, blst.VerificationStats{TotalVerifications: 10, Failures: 5, AggregationCount: 4}, blst.VerificationMetrics())
+
+	blst.ResetVerificationMetrics()
+	assert.Equal(t, blst.VerificationStats{}, blst.VerificationMetrics())
+}
@@ -0,0 +1,314 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+var maxKeys = 1000000
+var pubkeyCache *lru.Cache
+
+// pubkeyCacheLock guards swapping the pubkeyCache instance, its size, whether it is enabled
+// and whether cached keys are interned. The cache itself is thread-safe.
+var pubkeyCacheLock sync.RWMutex
+var pubkeyCacheEnabled = true
+var pubkeyInterning bool
+
+// pubkeyEvictionCallback is set under pubkeyCacheLock and only read while holding it, since
+// onPubkeyEvicted runs with the lock held.
+var pubkeyEvictionCallback func(key [common.BLSPubkeyLength]byte)
+
+// pubkeyEvictedKeys queues evicted keys until they are handed to pubkeyEvictionCallback
+// outside of pubkeyCacheLock.
+var pubkeyEvictedKeys [][common.BLSPubkeyLength]byte
+var pubkeyEvictedLock sync.Mutex
+
+// Counters backing PublicKeyCacheStats, updated atomically.
+var pubkeyCacheHits, pubkeyCacheMisses, pubkeyCacheEvictions uint64
+
+// CacheStats reports how a key or signature cache has been used since the last reset.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+func init() {
+	pubkeyCache = newPubkeyCache(maxKeys)
+}
+
+func newPubkeyCache(n int) *lru.Cache {
+	cache, err := lru.NewWithEvict(n, onPubkeyEvicted)
+	if err != nil {
+		panic(fmt.Errorf("lru new failed: %w", err))
+	}
+	return cache
+}
+
+// SetPublicKeyCacheSize rebuilds the public key cache with a capacity of n entries. The most
+// recently used entries of the current cache are carried over, as many as fit.
+func SetPublicKeyCacheSize(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("public key cache size must be positive, got %d", n)
+	}
+	pubkeyCacheLock.Lock()
+	cache, err := resizeCache(pubkeyCache, n, &pubkeyCacheEvictions, onPubkeyEvicted)
+	if err == nil {
+		pubkeyCache = cache
+		maxKeys = n
+	}
+	pubkeyCacheLock.Unlock()
+	notifyPubkeyEvictions()
+	return err
+}
+
+// resizeCache returns a new LRU of capacity n holding the most recently used entries of old,
+// counting the entries that did not fit as evictions. onEvicted, if not nil, is registered with
+// the new LRU and called for every entry that did not fit.
+func resizeCache(old *lru.Cache, n int, evictions *uint64, onEvicted func(key, value interface{})) (*lru.Cache, error) {
+	cache, err := lru.NewWithEvict(n, onEvicted)
+	if err != nil {
+		return nil, fmt.Errorf("lru new failed: %w", err)
+	}
+	if old == nil {
+		return cache, nil
+	}
+	// Keys are ordered from oldest to newest.
+	keys := old.Keys()
+	if len(keys) > n {
+		atomic.AddUint64(evictions, uint64(len(keys)-n))
+		if onEvicted != nil {
+			for _, k := range keys[:len(keys)-n] {
+				if v, ok := old.Peek(k); ok {
+					onEvicted(k, v)
+				}
+			}
+		}
+		keys = keys[len(keys)-n:]
+	}
+	for _, k := range keys {
+		if v, ok := old.Peek(k); ok {
+			cache.Add(k, v)
+		}
+	}
+	return cache, nil
+}
+
+// SetPublicKeyCacheEnabled turns the public key cache on or off. While it is off every public
+// key is decompressed and validated from scratch and nothing is cached. Either way the cache is
+// emptied and keys pinned by the period mode are released, so re-enabling it starts from an
+// empty cache.
+func SetPublicKeyCacheEnabled(enabled bool) {
+	pubkeyPeriodLock.Lock()
+	pubkeyPeriods = nil
+	pubkeyPeriodLock.Unlock()
+
+	pubkeyCacheLock.Lock()
+	defer pubkeyCacheLock.Unlock()
+	// Swap in a fresh cache rather than purging, so dropped entries are not reported as evictions.
+	pubkeyCache = newPubkeyCache(maxKeys)
+	pubkeyCacheEnabled = enabled
+}
+
+// SetPublicKeyInterning turns interning of cached public keys on or off. While it is on,
+// PublicKeyFromBytes returns the cached key itself instead of a copy, so equal inputs share a
+// single *PublicKey and its decompressed point. Interned keys are immutable: Aggregate panics on
+// them and AggregateWith has to be used instead. Interning is content addressed through the
+// cache, so while the cache is disabled every call still returns a new key.
+func SetPublicKeyInterning(enabled bool) {
+	pubkeyCacheLock.Lock()
+	defer pubkeyCacheLock.Unlock()
+	pubkeyInterning = enabled
+}
+
+// PublicKeyInterningEnabled reports whether cached public keys are interned.
+func PublicKeyInterningEnabled() bool {
+	pubkeyCacheLock.RLock()
+	defer pubkeyCacheLock.RUnlock()
+	return pubkeyInterning
+}
+
+// internOrCopy hands out a key owned by the cache, the key itself while interning is on and a
+// copy the caller is free to modify otherwise.
+func internOrCopy(pub *PublicKey) *PublicKey {
+	pubkeyCacheLock.RLock()
+	intern := pubkeyInterning && pubkeyCacheEnabled
+	pubkeyCacheLock.RUnlock()
+	if intern {
+		return pub
+	}
+	return pub.Copy().(*PublicKey)
+}
+
+// SetPublicKeyEvictionCallback registers a function called with the compressed bytes of every
+// public key evicted from the cache, including those dropped by SetPublicKeyCacheSize. It is
+// called outside the cache locks, so it may use the cache itself. A nil callback unregisters it.
+func SetPublicKeyEvictionCallback(cb func(key [common.BLSPubkeyLength]byte)) {
+	pubkeyCacheLock.Lock()
+	defer pubkeyCacheLock.Unlock()
+	pubkeyEvictionCallback = cb
+}
+
+// PublicKeyCacheEnabled reports whether the public key cache is in use.
+func PublicKeyCacheEnabled() bool {
+	pubkeyCacheLock.RLock()
+	defer pubkeyCacheLock.RUnlock()
+	return pubkeyCacheEnabled
+}
+
+// PublicKeyCacheSize returns the capacity of the public key cache.
+func PublicKeyCacheSize() int {
+	pubkeyCacheLock.RLock()
+	defer pubkeyCacheLock.RUnlock()
+	return maxKeys
+}
+
+// WarmPublicKeyCache decompresses and validates keys like PublicKeysFromBytes and caches them,
+// so that known keys are not decompressed on their first use. The keys must fit in the cache:
+// larger sets are rejected rather than evicting each other. Otherwise the error of the first
+// invalid key is returned with its index.
+func WarmPublicKeyCache(keys [][]byte) error {
+	pubkeyCacheLock.RLock()
+	enabled, size := pubkeyCacheEnabled, maxKeys
+	pubkeyCacheLock.RUnlock()
+	if !enabled {
+		return fmt.Errorf("public key cache is disabled")
+	}
+	if len(keys) > size {
+		return fmt.Errorf("cannot warm %d public keys into a cache of %d", len(keys), size)
+	}
+	_, err := PublicKeysFromBytes(keys)
+	return err
+}
+
+// PublicKeyCacheKeys returns the compressed bytes of the keys currently in the public key cache,
+// including those pinned by the period mode, in no particular order. It is a snapshot for
+// debugging: keys inserted or evicted meanwhile may or may not be included. Listing does not
+// count as cache use, so it neither touches the recency of the entries nor the statistics.
+func PublicKeyCacheKeys() [][]byte {
+	pubkeyCacheLock.RLock()
+	defer pubkeyCacheLock.RUnlock()
+	if !pubkeyCacheEnabled {
+		return nil
+	}
+	pinned := pinnedPublicKeys()
+	cached := pubkeyCache.Keys()
+	keys := make([][]byte, 0, len(cached)+len(pinned))
+	for _, k := range cached {
+		key := k.([common.BLSPubkeyLength]byte)
+		if _, ok := pinned[key]; !ok {
+			keys = append(keys, key[:])
+		}
+	}
+	for key := range pinned {
+		key := key
+		keys = append(keys, key[:])
+	}
+	return keys
+}
+
+// PublicKeyCacheStats returns a snapshot of the public key cache counters.
+func PublicKeyCacheStats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&pubkeyCacheHits),
+		Misses:    atomic.LoadUint64(&pubkeyCacheMisses),
+		Evictions: atomic.LoadUint64(&pubkeyCacheEvictions),
+	}
+}
+
+// ResetPublicKeyCacheStats zeroes the public key cache counters.
+func ResetPublicKeyCacheStats() {
+	atomic.StoreUint64(&pubkeyCacheHits, 0)
+	atomic.StoreUint64(&pubkeyCacheMisses, 0)
+	atomic.StoreUint64(&pubkeyCacheEvictions, 0)
+}
+
+// pubkeyCacheEntry is the value stored in the public key cache. Keys inserted through
+// PublicKeyFromBytesNoValidate are not subgroup checked, so validated records whether
+// the entry may be handed out by PublicKeyFromBytes as is.
+//
+// The cache owns pub and its point, and pub is marked as interned. Constructors return copies of
+// it unless interning is on, so callers are free to mutate the keys they get, for instance with
+// Aggregate. Internal readers such as AggregatePublicKeys may use the cached point directly as
+// long as they never write to it.
+type pubkeyCacheEntry struct {
+	pub       *PublicKey
+	validated bool
+}
+
+// cachedPublicKey looks up a decompressed public key by its compressed bytes, in the LRU and then
+// among the keys pinned by the period mode. Lookups while the cache is disabled always miss and
+// are not counted.
+func cachedPublicKey(key [common.BLSPubkeyLength]byte) (pubkeyCacheEntry, bool) {
+	pubkeyCacheLock.RLock()
+	defer pubkeyCacheLock.RUnlock()
+	if !pubkeyCacheEnabled {
+		return pubkeyCacheEntry{}, false
+	}
+	cv, ok := pubkeyCache.Get(key)
+	if !ok {
+		if pub := pinnedPublicKey(key); pub != nil {
+			atomic.AddUint64(&pubkeyCacheHits, 1)
+			return pubkeyCacheEntry{pub: pub, validated: true}, true
+		}
+		atomic.AddUint64(&pubkeyCacheMisses, 1)
+		return pubkeyCacheEntry{}, false
+	}
+	atomic.AddUint64(&pubkeyCacheHits, 1)
+	return cv.(pubkeyCacheEntry), true
+}
+
+// cachePublicKey stores a decompressed public key under its compressed bytes.
+func cachePublicKey(key [common.BLSPubkeyLength]byte, pub *PublicKey, validated bool) {
+	pubkeyCacheLock.RLock()
+	if !pubkeyCacheEnabled {
+		pubkeyCacheLock.RUnlock()
+		return
+	}
+	evicted := pubkeyCache.Add(key, pubkeyCacheEntry{pub: pub, validated: validated})
+	pubkeyCacheLock.RUnlock()
+	if evicted {
+		atomic.AddUint64(&pubkeyCacheEvictions, 1)
+		notifyPubkeyEvictions()
+	}
+}
+
+// onPubkeyEvicted is the eviction hook of the LRU. The LRU calls it while pubkeyCacheLock is
+// held, so it only queues the key for notifyPubkeyEvictions.
+func onPubkeyEvicted(key, _ interface{}) {
+	if pubkeyEvictionCallback == nil {
+		return
+	}
+	pubkeyEvictedLock.Lock()
+	pubkeyEvictedKeys = append(pubkeyEvictedKeys, key.([common.BLSPubkeyLength]byte))
+	pubkeyEvictedLock.Unlock()
+}
+
+// notifyPubkeyEvictions hands the queued evicted keys to the eviction callback. It must be
+// called without holding pubkeyCacheLock.
+func notifyPubkeyEvictions() {
+	pubkeyEvictedLock.Lock()
+	keys := pubkeyEvictedKeys
+	pubkeyEvictedKeys = nil
+	pubkeyEvictedLock.Unlock()
+	if len(keys) == 0 {
+		return
+	}
+
+	pubkeyCacheLock.RLock()
+	cb := pubkeyEvictionCallback
+	pubkeyCacheLock.RUnlock()
+	if cb == nil {
+		return
+	}
+	for _, k := range keys {
+		cb(k)
+	}
+}
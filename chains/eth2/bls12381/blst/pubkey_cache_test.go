@@ -0,0 +1,709 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resetPublicKeyCache empties the cache and restores its original size once the test ends.
+func resetPublicKeyCache(t *testing.T) {
+	size := PublicKeyCacheSize()
+	pubkeyCache.Purge()
+	ResetPublicKeyCacheStats()
+	t.Cleanup(func() {
+		SetPublicKeyEvictionCallback(nil)
+		SetPublicKeyCachePeriodMode(false)
+		SetPublicKeyInterning(false)
+		SetPublicKeyCacheEnabled(true)
+		require.NoError(t, SetPublicKeyCacheSize(size))
+		pubkeyCache.Purge()
+		ResetPublicKeyCacheStats()
+	})
+}
+
+func randPublicKeyBytes(t testing.TB, n int) [][]byte {
+	keys := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		keys[i] = priv.PublicKey().Marshal()
+	}
+	return keys
+}
+
+func toCacheKey(key []byte) [common.BLSPubkeyLength]byte {
+	var k [common.BLSPubkeyLength]byte
+	copy(k[:], key)
+	return k
+}
+
+func cacheContains(key []byte) bool {
+	return pubkeyCache.Contains(toCacheKey(key))
+}
+
+func TestSetPublicKeyCacheSize_Invalid(t *testing.T) {
+	resetPublicKeyCache(t)
+	size := PublicKeyCacheSize()
+	assert.Error(t, SetPublicKeyCacheSize(0))
+	assert.Error(t, SetPublicKeyCacheSize(-1))
+	assert.Equal(t, size, PublicKeyCacheSize())
+}
+
+func TestWarmPublicKeyCache(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 100)
+	require.NoError(t, WarmPublicKeyCache(keys))
+	assert.Equal(t, 100, pubkeyCache.Len())
+
+	ResetPublicKeyCacheStats()
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, CacheStats{Hits: 100}, PublicKeyCacheStats())
+}
+
+func TestWarmPublicKeyCache_Invalid(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 4)
+
+	require.NoError(t, SetPublicKeyCacheSize(3))
+	err := WarmPublicKeyCache(keys)
+	require.Error(t, err)
+	assert.Equal(t, 0, pubkeyCache.Len(), "Keys that do not fit must not be cached")
+
+	require.NoError(t, SetPublicKeyCacheSize(4))
+	keys[2] = keys[2][:10]
+	err = WarmPublicKeyCache(keys)
+	assert.True(t, errors.Is(err, common.ErrPubKeyLength))
+	assert.Contains(t, err.Error(), "index 2")
+
+	SetPublicKeyCacheEnabled(false)
+	assert.Error(t, WarmPublicKeyCache(keys[:2]))
+}
+
+func TestSetPublicKeyCacheSize_EvictsAtNewBound(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(2))
+	assert.Equal(t, 2, PublicKeyCacheSize())
+
+	keys := randPublicKeyBytes(t, 3)
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, pubkeyCache.Len())
+	assert.Equal(t, false, cacheContains(keys[0]), "Oldest key should have been evicted")
+	assert.Equal(t, true, cacheContains(keys[1]))
+	assert.Equal(t, true, cacheContains(keys[2]))
+}
+
+func TestSetPublicKeyCacheSize_PreservesNewestEntries(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(10))
+
+	keys := randPublicKeyBytes(t, 5)
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	require.NoError(t, SetPublicKeyCacheSize(3))
+	assert.Equal(t, 3, pubkeyCache.Len())
+	for i, k := range keys {
+		assert.Equal(t, i >= 2, cacheContains(k), "Unexpected cache membership for key %d", i)
+	}
+
+	require.NoError(t, SetPublicKeyCacheSize(20))
+	assert.Equal(t, 3, pubkeyCache.Len(), "Growing the cache should keep every entry")
+}
+
+func TestPublicKeyCacheStats(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(2))
+	assert.Equal(t, CacheStats{}, PublicKeyCacheStats())
+
+	keys := randPublicKeyBytes(t, 3)
+	// miss, hit, miss, hit, miss (evicts keys[0]), miss (evicts keys[1])
+	for _, k := range [][]byte{keys[0], keys[0], keys[1], keys[1], keys[2], keys[0]} {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Evictions: 2}, PublicKeyCacheStats())
+
+	// Malformed input never reaches the cache.
+	_, err := PublicKeyFromBytes([]byte{0x01})
+	require.Error(t, err)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Evictions: 2}, PublicKeyCacheStats())
+
+	ResetPublicKeyCacheStats()
+	assert.Equal(t, CacheStats{}, PublicKeyCacheStats())
+}
+
+// notInSubgroupKey is the compressed G1 point with x = 4, which lies on the curve but not
+// in the prime-order subgroup.
+var notInSubgroupKey = []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04}
+
+func TestPublicKeyCacheKeys(t *testing.T) {
+	resetPublicKeyCache(t)
+	assert.Equal(t, 0, len(PublicKeyCacheKeys()))
+
+	keys := randPublicKeyBytes(t, 10)
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	ResetPublicKeyCacheStats()
+	assert.ElementsMatch(t, keys, PublicKeyCacheKeys())
+	assert.Equal(t, CacheStats{}, PublicKeyCacheStats(), "Listing the keys counted as cache use")
+
+	// Only the keys still cached are listed.
+	require.NoError(t, SetPublicKeyCacheSize(4))
+	assert.ElementsMatch(t, keys[6:], PublicKeyCacheKeys())
+
+	SetPublicKeyCacheEnabled(false)
+	assert.Equal(t, 0, len(PublicKeyCacheKeys()))
+}
+
+func TestPublicKeyFromBytesNoValidate_AcceptsKeyOutsideSubgroup(t *testing.T) {
+	resetPublicKeyCache(t)
+	requireNotInSubgroupPublicKey(t, notInSubgroupKey)
+	if !noValidateSkipsSubgroupCheck {
+		_, err := PublicKeyFromBytesNoValidate(notInSubgroupKey)
+		assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+		assert.Equal(t, false, cacheContains(notInSubgroupKey))
+		return
+	}
+
+	pub, err := PublicKeyFromBytesNoValidate(notInSubgroupKey)
+	require.NoError(t, err)
+	assert.Equal(t, notInSubgroupKey, pub.Marshal())
+	assert.Equal(t, true, cacheContains(notInSubgroupKey))
+
+	// A cached unvalidated key must not leak through the validating constructor.
+	_, err = PublicKeyFromBytes(notInSubgroupKey)
+	assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+}
+
+func TestPublicKeyFromBytesOpts(t *testing.T) {
+	resetPublicKeyCache(t)
+	valid := randPublicKeyBytes(t, 1)[0]
+	for _, validate := range []bool{true, false} {
+		pub, err := PublicKeyFromBytesOpts(valid, validate)
+		require.NoError(t, err)
+		assert.Equal(t, valid, pub.Marshal())
+
+		_, err = PublicKeyFromBytesOpts(valid[:10], validate)
+		assert.True(t, errors.Is(err, common.ErrPubKeyLength))
+	}
+
+	// Only the validating mode rejects keys outside the subgroup, even once they are cached.
+	_, err := PublicKeyFromBytesOpts(notInSubgroupKey, true)
+	assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+	pub, err := PublicKeyFromBytesOpts(notInSubgroupKey, false)
+	if noValidateSkipsSubgroupCheck {
+		require.NoError(t, err)
+		assert.Equal(t, notInSubgroupKey, pub.Marshal())
+	} else {
+		assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+	}
+	_, err = PublicKeyFromBytesOpts(notInSubgroupKey, true)
+	assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+}
+
+func TestPublicKeyFromBytesNoValidate_SharesCache(t *testing.T) {
+	resetPublicKeyCache(t)
+	key := randPublicKeyBytes(t, 1)[0]
+
+	pub, err := PublicKeyFromBytesNoValidate(key)
+	require.NoError(t, err)
+	assert.Equal(t, key, pub.Marshal())
+	assert.Equal(t, CacheStats{Misses: 1}, PublicKeyCacheStats())
+
+	pub, err = PublicKeyFromBytes(key)
+	require.NoError(t, err)
+	assert.Equal(t, key, pub.Marshal())
+	pub, err = PublicKeyFromBytesNoValidate(key)
+	require.NoError(t, err)
+	assert.Equal(t, key, pub.Marshal())
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1}, PublicKeyCacheStats())
+
+	_, err = PublicKeyFromBytesNoValidate(key[:10])
+	assert.Error(t, err)
+	_, err = PublicKeyFromBytesNoValidate(make([]byte, common.BLSPubkeyLength))
+	assert.Error(t, err)
+}
+
+func TestSetPublicKeyCacheEnabled(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2)
+	_, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	ResetPublicKeyCacheStats()
+
+	SetPublicKeyCacheEnabled(false)
+	assert.Equal(t, false, PublicKeyCacheEnabled())
+	assert.Equal(t, 0, pubkeyCache.Len(), "Disabling should empty the cache")
+	for i := 0; i < 3; i++ {
+		for _, k := range keys {
+			pub, err := PublicKeyFromBytes(k)
+			require.NoError(t, err)
+			assert.Equal(t, k, pub.Marshal())
+		}
+	}
+	assert.Equal(t, CacheStats{}, PublicKeyCacheStats())
+	assert.Equal(t, 0, pubkeyCache.Len())
+
+	// Validation is unchanged while the cache is off.
+	_, err = PublicKeyFromBytes(notInSubgroupKey)
+	assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+	if _, err = PublicKeyFromBytesNoValidate(notInSubgroupKey); noValidateSkipsSubgroupCheck {
+		require.NoError(t, err)
+	}
+	_, err = PublicKeyFromBytes(notInSubgroupKey)
+	assert.Equal(t, common.ErrPubKeyNotInSubgroup, err)
+
+	SetPublicKeyCacheEnabled(true)
+	assert.Equal(t, true, PublicKeyCacheEnabled())
+	assert.Equal(t, 0, pubkeyCache.Len())
+	for _, k := range [][]byte{keys[0], keys[0]} {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, PublicKeyCacheStats())
+}
+
+// evictionRecorder collects the keys passed to the eviction callback.
+type evictionRecorder struct {
+	mu   sync.Mutex
+	keys [][]byte
+}
+
+func (r *evictionRecorder) record(key [common.BLSPubkeyLength]byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = append(r.keys, append([]byte(nil), key[:]...))
+}
+
+func (r *evictionRecorder) evicted() [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.keys
+}
+
+func TestSetPublicKeyEvictionCallback(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(2))
+	r := &evictionRecorder{}
+	SetPublicKeyEvictionCallback(r.record)
+
+	keys := randPublicKeyBytes(t, 5)
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, keys[:3], r.evicted())
+	assert.Equal(t, uint64(3), PublicKeyCacheStats().Evictions)
+
+	// Entries dropped by shrinking the cache are evictions too.
+	require.NoError(t, SetPublicKeyCacheSize(1))
+	assert.Equal(t, keys[:4], r.evicted())
+
+	// Dropping the cache when toggling it is not.
+	SetPublicKeyCacheEnabled(false)
+	SetPublicKeyCacheEnabled(true)
+	assert.Equal(t, keys[:4], r.evicted())
+
+	SetPublicKeyEvictionCallback(nil)
+	for _, k := range keys {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, keys[:4], r.evicted())
+}
+
+func TestSetPublicKeyEvictionCallback_UsesCache(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(2))
+	keys := randPublicKeyBytes(t, 4)
+
+	// The callback runs outside the cache locks, so it can use the cache, even re-inserting
+	// the evicted key.
+	var calls int
+	SetPublicKeyEvictionCallback(func(key [common.BLSPubkeyLength]byte) {
+		calls++
+		if calls > 1 {
+			return
+		}
+		_ = PublicKeyCacheSize()
+		_, err := PublicKeyFromBytes(key[:])
+		require.NoError(t, err)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, k := range keys {
+			_, err := PublicKeyFromBytes(k)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, SetPublicKeyCacheSize(3))
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Eviction callback deadlocked")
+	}
+	assert.Equal(t, true, calls > 1)
+}
+
+func TestAggregatePublicKeys_Parallel(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2*parallelAggregateThreshold)
+	pubs, err := PublicKeysFromBytes(keys)
+	require.NoError(t, err)
+	want, err := AggregateMultiplePubkeys(pubs)
+	require.NoError(t, err)
+
+	// Uncached keys take the worker path.
+	pubkeyCache.Purge()
+	ResetPublicKeyCacheStats()
+	agg, err := AggregatePublicKeys(keys)
+	require.NoError(t, err)
+	assert.Equal(t, want.Marshal(), agg.Marshal())
+	assert.Equal(t, uint64(len(keys)), PublicKeyCacheStats().Misses)
+
+	// Cached keys do not.
+	ResetPublicKeyCacheStats()
+	pubkeyCache.Remove(toCacheKey(keys[7]))
+	agg, err = AggregatePublicKeys(keys)
+	require.NoError(t, err)
+	assert.Equal(t, want.Marshal(), agg.Marshal())
+	assert.Equal(t, CacheStats{Hits: uint64(len(keys) - 1), Misses: 1}, PublicKeyCacheStats())
+}
+
+func TestAggregatePublicKeys_ParallelBadKey(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2*parallelAggregateThreshold)
+
+	bad := append([][]byte(nil), keys...)
+	bad[100] = notInSubgroupKey
+	_, err := AggregatePublicKeys(bad)
+	require.Error(t, err)
+	assert.Equal(t, true, errors.Is(err, common.ErrPubKeyNotInSubgroup))
+	assert.Contains(t, err.Error(), "public key at index 100")
+
+	// The lowest offending index is reported, whatever the failure.
+	bad[90] = keys[90][:10]
+	_, err = AggregatePublicKeys(bad)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public key at index 90")
+
+	// Also when the keys before it are cached.
+	_, err = PublicKeysFromBytes(keys)
+	require.NoError(t, err)
+	bad[90] = keys[90]
+	_, err = AggregatePublicKeys(bad)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public key at index 100")
+}
+
+func TestPublicKey_AggregateWith(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2)
+	p1, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	p2, err := PublicKeyFromBytes(keys[1])
+	require.NoError(t, err)
+	want, err := AggregateMultiplePubkeys([]common.PublicKey{p1, p2})
+	require.NoError(t, err)
+
+	agg := p1.AggregateWith(p2)
+	assert.Equal(t, want.Marshal(), agg.Marshal())
+	assert.Equal(t, keys[0], p1.Marshal(), "AggregateWith mutated the receiver")
+	assert.Equal(t, keys[1], p2.Marshal(), "AggregateWith mutated the argument")
+}
+
+func TestPublicKey_AggregateCachedKey(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2)
+	other, err := PublicKeyFromBytes(keys[1])
+	require.NoError(t, err)
+
+	cached, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	cached.AggregateWith(other)
+	cached, err = PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	assert.Equal(t, keys[0], cached.Marshal())
+
+	// Aggregating in place changes the returned key only, never the cache entry.
+	cached.Aggregate(other)
+	assert.NotEqual(t, keys[0], cached.Marshal())
+	refetched, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	assert.Equal(t, keys[0], refetched.Marshal())
+	entry, ok := cachedPublicKey(toCacheKey(keys[0]))
+	require.Equal(t, true, ok)
+	assert.Equal(t, keys[0], entry.pub.Marshal())
+}
+
+func TestPublicKeyFromBytes_ReturnsCopy(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2)
+	other, err := PublicKeyFromBytes(keys[1])
+	require.NoError(t, err)
+
+	for _, fromBytes := range []func([]byte) (common.PublicKey, error){PublicKeyFromBytes, PublicKeyFromBytesNoValidate} {
+		pubkeyCache.Remove(toCacheKey(keys[0]))
+		miss, err := fromBytes(keys[0])
+		require.NoError(t, err)
+		hit, err := fromBytes(keys[0])
+		require.NoError(t, err)
+
+		entry, ok := cachedPublicKey(toCacheKey(keys[0]))
+		require.Equal(t, true, ok)
+		for _, pub := range []common.PublicKey{miss, hit} {
+			assert.NotSame(t, entry.pub, pub)
+			assert.NotSame(t, entry.pub.p, pub.(*PublicKey).p)
+		}
+
+		// Mutating either returned key leaves later hits untouched.
+		miss.Aggregate(other)
+		hit.Aggregate(other)
+		again, err := fromBytes(keys[0])
+		require.NoError(t, err)
+		assert.Equal(t, keys[0], again.Marshal())
+		assert.Equal(t, keys[0], entry.pub.Marshal())
+	}
+}
+
+func BenchmarkAggregatePublicKeys(b *testing.B) {
+	keys := randPublicKeyBytes(b, 512)
+	for _, procs := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("procs=%d", procs), func(b *testing.B) {
+			defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				pubkeyCache.Purge()
+				b.StartTimer()
+				if _, err := AggregatePublicKeys(keys); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	pubkeyCache.Purge()
+	ResetPublicKeyCacheStats()
+}
+
+func BenchmarkPublicKeysFromBytes(b *testing.B) {
+	keys := randPublicKeyBytes(b, 512)
+	for _, procs := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("procs=%d", procs), func(b *testing.B) {
+			defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				pubkeyCache.Purge()
+				b.StartTimer()
+				if _, err := PublicKeysFromBytes(keys); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	pubkeyCache.Purge()
+	ResetPublicKeyCacheStats()
+}
+
+func TestSetPublicKeyInterning(t *testing.T) {
+	resetPublicKeyCache(t)
+	keys := randPublicKeyBytes(t, 2)
+	SetPublicKeyInterning(true)
+	assert.Equal(t, true, PublicKeyInterningEnabled())
+
+	// Both the miss and the hit return the cached key.
+	first, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	second, err := PublicKeyFromBytes(append([]byte(nil), keys[0]...))
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	batch, err := PublicKeysFromBytes(keys)
+	require.NoError(t, err)
+	assert.Same(t, first, batch[0])
+
+	// Interned keys cannot be aggregated in place.
+	assert.Panics(t, func() { first.Aggregate(batch[1]) })
+	agg := first.AggregateWith(batch[1])
+	assert.Equal(t, keys[0], first.Marshal())
+	assert.NotEqual(t, keys[0], agg.Marshal())
+	agg.Aggregate(batch[1])
+
+	SetPublicKeyInterning(false)
+	copied, err := PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	assert.NotSame(t, first, copied)
+	copied.Aggregate(batch[1])
+
+	// Without the cache there is nothing to share.
+	SetPublicKeyInterning(true)
+	SetPublicKeyCacheEnabled(false)
+	first, err = PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	second, err = PublicKeyFromBytes(keys[0])
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	first.Aggregate(second)
+}
+
+// TestPublicKeyFromBytes_Concurrent is meant to be run with -race: it hammers the cache from
+// many goroutines with overlapping keys while it evicts, reports evictions and is resized.
+func TestPublicKeyFromBytes_Concurrent(t *testing.T) {
+	resetPublicKeyCache(t)
+	require.NoError(t, SetPublicKeyCacheSize(16))
+	r := &evictionRecorder{}
+	SetPublicKeyEvictionCallback(r.record)
+	keys := randPublicKeyBytes(t, 32)
+
+	const goroutines, rounds = 100, 20
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				k := keys[(g+i)%len(keys)]
+				pub, err := PublicKeyFromBytes(k)
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, k, pub.Marshal())
+				switch i % 5 {
+				case 0:
+					_ = PublicKeyCacheStats()
+				case 1:
+					SetPublicKeyInterning(g%2 == 0)
+				case 2:
+					assert.NoError(t, SetPublicKeyCacheSize(16+g%8))
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats := PublicKeyCacheStats()
+	assert.Equal(t, uint64(goroutines*rounds), stats.Hits+stats.Misses)
+	assert.Equal(t, true, stats.Evictions > 0)
+	assert.Equal(t, stats.Evictions, uint64(len(r.evicted())))
+}
+
+func pinnedToPeriod(key []byte, period uint64) bool {
+	pubkeyPeriodLock.Lock()
+	defer pubkeyPeriodLock.Unlock()
+	_, ok := pubkeyPeriods[period][toCacheKey(key)]
+	return ok
+}
+
+func TestAdvancePublicKeyCachePeriod(t *testing.T) {
+	resetPublicKeyCache(t)
+	SetPublicKeyCachePeriodMode(true)
+	var lock sync.Mutex
+	var evicted [][common.BLSPubkeyLength]byte
+	SetPublicKeyEvictionCallback(func(key [common.BLSPubkeyLength]byte) {
+		lock.Lock()
+		evicted = append(evicted, key)
+		lock.Unlock()
+	})
+
+	// The first key of the committee of period 10 is also a member of the committee of period 11.
+	AdvancePublicKeyCachePeriod(10)
+	committee10 := randPublicKeyBytes(t, 4)
+	committee11 := append(randPublicKeyBytes(t, 3), committee10[0])
+	for _, k := range committee10 {
+		_, err := PublicKeyFromBytesInPeriod(k, 10)
+		require.NoError(t, err)
+	}
+	for _, k := range committee11 {
+		_, err := PublicKeyFromBytesInPeriod(k, 11)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, true, pinnedToPeriod(committee10[0], 11))
+	assert.Equal(t, 0, pubkeyCache.Len(), "Pinned keys should be kept apart from the LRU")
+	assert.Len(t, PublicKeyCacheKeys(), 7)
+
+	// Period 10 is retained as the previous period.
+	AdvancePublicKeyCachePeriod(11)
+	assert.Equal(t, uint64(11), PublicKeyCachePeriod())
+	for _, k := range committee10 {
+		assert.Equal(t, true, pinnedToPeriod(k, 10))
+	}
+	assert.Equal(t, uint64(0), PublicKeyCacheStats().Evictions)
+
+	// Advancing to period 12 evicts period 10, except the key that is still in period 11.
+	AdvancePublicKeyCachePeriod(12)
+	for i, k := range committee10 {
+		assert.Equal(t, i == 0, pinnedPublicKey(toCacheKey(k)) != nil, "Unexpected pinning of key %d", i)
+		assert.Equal(t, false, pinnedToPeriod(k, 10))
+	}
+	for _, k := range committee11 {
+		assert.Equal(t, true, pinnedToPeriod(k, 11))
+	}
+	assert.Len(t, PublicKeyCacheKeys(), 4)
+	assert.Equal(t, uint64(3), PublicKeyCacheStats().Evictions)
+	assert.ElementsMatch(t, [][common.BLSPubkeyLength]byte{
+		toCacheKey(committee10[1]), toCacheKey(committee10[2]), toCacheKey(committee10[3]),
+	}, evicted)
+
+	// Periods do not move back, and keys of an expired period are decoded without being pinned.
+	AdvancePublicKeyCachePeriod(3)
+	assert.Equal(t, uint64(12), PublicKeyCachePeriod())
+	pub, err := PublicKeyFromBytesInPeriod(committee10[1], 10)
+	require.NoError(t, err)
+	assert.Equal(t, committee10[1], pub.Marshal())
+	assert.Equal(t, false, pinnedToPeriod(committee10[1], 10))
+}
+
+func TestPublicKeyFromBytesInPeriod_SurvivesChurn(t *testing.T) {
+	resetPublicKeyCache(t)
+	SetPublicKeyCachePeriodMode(true)
+	require.NoError(t, SetPublicKeyCacheSize(4))
+
+	committee := randPublicKeyBytes(t, 4)
+	for _, k := range committee {
+		_, err := PublicKeyFromBytesInPeriod(k, 1)
+		require.NoError(t, err)
+	}
+	// Other keys churn through the LRU without evicting the committee.
+	for _, k := range randPublicKeyBytes(t, 8) {
+		_, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(4), PublicKeyCacheStats().Evictions)
+
+	ResetPublicKeyCacheStats()
+	for _, k := range committee {
+		pub, err := PublicKeyFromBytes(k)
+		require.NoError(t, err)
+		assert.Equal(t, k, pub.Marshal())
+	}
+	assert.Equal(t, CacheStats{Hits: 4}, PublicKeyCacheStats())
+
+	// Turning the mode off releases the pinned keys.
+	SetPublicKeyCachePeriodMode(false)
+	assert.Equal(t, false, pinnedToPeriod(committee[0], 1))
+	_, err := PublicKeyFromBytesInPeriod(committee[0], 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), PublicKeyCacheStats().Misses)
+}
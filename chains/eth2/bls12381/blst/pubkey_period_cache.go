@@ -0,0 +1,168 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// pubkeyPeriodLock guards the period mode of the public key cache. It may be acquired while
+// holding pubkeyCacheLock, but not the other way around.
+var pubkeyPeriodLock sync.Mutex
+var pubkeyPeriodMode bool
+var pubkeyCurrentPeriod uint64
+
+// pubkeyPeriods holds the keys pinned by PublicKeyFromBytesInPeriod, by the sync committee
+// periods they were used in. Pinned keys are kept apart from the LRU, so that they are not
+// evicted by recency but only once their periods expire. Like the LRU, the map owns its keys.
+var pubkeyPeriods map[uint64]map[[common.BLSPubkeyLength]byte]*PublicKey
+
+// SetPublicKeyCachePeriodMode turns the sync committee period mode of the public key cache on or
+// off. A light client only verifies against the current and the next sync committee, so while
+// the mode is on the keys decoded by PublicKeyFromBytesInPeriod are kept for as long as their
+// period is the current one or later, or the one before, no matter how many other keys pass
+// through the LRU. They are evicted a period at a time by AdvancePublicKeyCachePeriod. Either way
+// the pinned keys are dropped without being counted as evictions, and the current period is
+// reset to 0.
+func SetPublicKeyCachePeriodMode(enabled bool) {
+	pubkeyPeriodLock.Lock()
+	defer pubkeyPeriodLock.Unlock()
+	pubkeyPeriodMode = enabled
+	pubkeyCurrentPeriod = 0
+	pubkeyPeriods = nil
+}
+
+// PublicKeyCachePeriod returns the current sync committee period of the public key cache.
+func PublicKeyCachePeriod() uint64 {
+	pubkeyPeriodLock.Lock()
+	defer pubkeyPeriodLock.Unlock()
+	return pubkeyCurrentPeriod
+}
+
+// PublicKeyFromBytesInPeriod creates a BLS public key like PublicKeyFromBytes, for a member of
+// the sync committee of period. While the period mode of the cache is on, the key is pinned to
+// period unless that period has already expired, and is then found by every lookup of the cache
+// until it expires. Otherwise, or while the cache is disabled, it is the same as
+// PublicKeyFromBytes.
+func PublicKeyFromBytesInPeriod(pubKey []byte, period uint64) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyLength {
+		return nil, fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubKey))
+	}
+	pubkeyPeriodLock.Lock()
+	retained := pubkeyPeriodMode && !periodExpired(period)
+	pubkeyPeriodLock.Unlock()
+	if !retained || !PublicKeyCacheEnabled() {
+		return PublicKeyFromBytes(pubKey)
+	}
+
+	var key [common.BLSPubkeyLength]byte
+	copy(key[:], pubKey)
+	// A key used in several retained periods, such as a member of both the current and the next
+	// committee, is decoded once and pinned to each of them.
+	var pub *PublicKey
+	if cv, ok := cachedPublicKey(key); ok && cv.validated {
+		pub = cv.pub
+	} else {
+		var err error
+		if pub, err = decodePublicKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	pubkeyPeriodLock.Lock()
+	if pubkeyPeriodMode && !periodExpired(period) {
+		pinPublicKey(key, pub, period)
+	}
+	pubkeyPeriodLock.Unlock()
+	return internOrCopy(pub), nil
+}
+
+// AdvancePublicKeyCachePeriod moves the period mode of the public key cache to the sync
+// committee period current and evicts the keys of the periods older than current-1, unless they
+// are still pinned to a retained period. Evicted keys are counted and handed to the eviction
+// callback like those evicted by the LRU. Periods only move forward, an older period is ignored,
+// as is any period while the mode is off.
+func AdvancePublicKeyCachePeriod(current uint64) {
+	pubkeyPeriodLock.Lock()
+	if !pubkeyPeriodMode || current <= pubkeyCurrentPeriod {
+		pubkeyPeriodLock.Unlock()
+		return
+	}
+	pubkeyCurrentPeriod = current
+	expired := make(map[[common.BLSPubkeyLength]byte]struct{})
+	for period, keys := range pubkeyPeriods {
+		if !periodExpired(period) {
+			continue
+		}
+		for key := range keys {
+			expired[key] = struct{}{}
+		}
+		delete(pubkeyPeriods, period)
+	}
+	for _, keys := range pubkeyPeriods {
+		for key := range keys {
+			delete(expired, key)
+		}
+	}
+	pubkeyPeriodLock.Unlock()
+	if len(expired) == 0 {
+		return
+	}
+
+	atomic.AddUint64(&pubkeyCacheEvictions, uint64(len(expired)))
+	pubkeyCacheLock.RLock()
+	for key := range expired {
+		onPubkeyEvicted(key, nil)
+	}
+	pubkeyCacheLock.RUnlock()
+	notifyPubkeyEvictions()
+}
+
+// pinnedPublicKey returns the key pinned under key to any period, or nil if there is none.
+func pinnedPublicKey(key [common.BLSPubkeyLength]byte) *PublicKey {
+	pubkeyPeriodLock.Lock()
+	defer pubkeyPeriodLock.Unlock()
+	for _, keys := range pubkeyPeriods {
+		if pub, ok := keys[key]; ok {
+			return pub
+		}
+	}
+	return nil
+}
+
+// pinnedPublicKeys returns the set of keys pinned to any period.
+func pinnedPublicKeys() map[[common.BLSPubkeyLength]byte]struct{} {
+	pubkeyPeriodLock.Lock()
+	defer pubkeyPeriodLock.Unlock()
+	pinned := make(map[[common.BLSPubkeyLength]byte]struct{})
+	for _, keys := range pubkeyPeriods {
+		for key := range keys {
+			pinned[key] = struct{}{}
+		}
+	}
+	return pinned
+}
+
+// periodExpired reports whether the keys of period are no longer retained. It must be called
+// with pubkeyPeriodLock held.
+func periodExpired(period uint64) bool {
+	return period+1 < pubkeyCurrentPeriod
+}
+
+// pinPublicKey pins pub to period. It must be called with pubkeyPeriodLock held.
+func pinPublicKey(key [common.BLSPubkeyLength]byte, pub *PublicKey, period uint64) {
+	if pubkeyPeriods == nil {
+		pubkeyPeriods = make(map[uint64]map[[common.BLSPubkeyLength]byte]*PublicKey)
+	}
+	keys, ok := pubkeyPeriods[period]
+	if !ok {
+		keys = make(map[[common.BLSPubkeyLength]byte]*PublicKey)
+		pubkeyPeriods[period] = keys
+	}
+	keys[key] = pub
+}
@@ -5,114 +5,322 @@
 package blst
 
 import (
+	"bytes"
 	"fmt"
-	lru "github.com/hashicorp/golang-lru"
+	"math/big"
+
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
 	"github.com/pkg/errors"
+	blst "github.com/supranational/blst/bindings/go"
 )
 
-var maxKeys = 1000000
-var pubkeyCache *lru.Cache
-
 // PublicKey used in the BLS signature scheme.
 type PublicKey struct {
 	p *blstPublicKey
+	// interned marks keys owned by the public key cache, which may be shared between callers
+	// and are never modified.
+	interned bool
+	// compressed holds the encoding the key was decoded from, so that marshalling it does not
+	// have to compress the point again. It is nil for keys computed otherwise, and shared between
+	// copies since it is never written to.
+	compressed *[common.BLSPubkeyLength]byte
 }
 
-func init() {
-	cache, err := lru.New(maxKeys)
-	if err != nil {
-		panic(fmt.Errorf("lru new failed: %w", err))
-	}
-	pubkeyCache = cache
-}
-
-// PublicKeyFromBytes creates a BLS public key from a  BigEndian byte slice.
+// PublicKeyFromBytes creates a BLS public key from its 48 byte compressed, big-endian encoding.
 func PublicKeyFromBytes(pubKey []byte) (common.PublicKey, error) {
 	if len(pubKey) != common.BLSPubkeyLength {
-		return nil, fmt.Errorf("public key must be %d bytes", common.BLSPubkeyLength)
+		return nil, fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubKey))
 	}
 	var newKey [common.BLSPubkeyLength]byte
 	copy(newKey[:], pubKey)
 	//newKey := (*[common.BLSPubkeyLength]byte)(pubKey)
-	if cv, ok := pubkeyCache.Get(newKey); ok {
-		return cv.(*PublicKey).Copy(), nil
+	if cv, ok := cachedPublicKey(newKey); ok {
+		if !cv.validated {
+			// Inserted by PublicKeyFromBytesNoValidate, check it before handing it out.
+			if !cv.pub.p.KeyValidate() {
+				return nil, publicKeyDecodeError(newKey[:])
+			}
+			cachePublicKey(newKey, cv.pub, true)
+		}
+		return internOrCopy(cv.pub), nil
+	}
+	return decompressPublicKey(newKey)
+}
+
+// decompressPublicKey decompresses and validates a public key that is not in the cache, and
+// caches it. The returned key is the cached one while interning is on and a copy otherwise.
+func decompressPublicKey(pubKey [common.BLSPubkeyLength]byte) (*PublicKey, error) {
+	pubKeyObj, err := decodePublicKey(pubKey)
+	if err != nil {
+		return nil, err
 	}
+	cachePublicKey(pubKey, pubKeyObj, true)
+	return internOrCopy(pubKeyObj), nil
+}
+
+// decodePublicKey decompresses and validates a public key into a key owned by the caches, without
+// caching it.
+func decodePublicKey(pubKey [common.BLSPubkeyLength]byte) (*PublicKey, error) {
 	// Subgroup check NOT done when decompressing pubkey.
-	p := new(blstPublicKey).Uncompress(pubKey)
+	p := new(blstPublicKey).Uncompress(pubKey[:])
 	if p == nil {
-		return nil, errors.New("could not unmarshal bytes into public key")
+		return nil, publicKeyDecodeError(pubKey[:])
 	}
 	// Subgroup and infinity check
 	if !p.KeyValidate() {
-		// NOTE: the error is not quite accurate since it includes group check
-		return nil, common.ErrInfinitePubKey
+		return nil, publicKeyDecodeError(pubKey[:])
 	}
-	pubKeyObj := &PublicKey{p: p}
-	copiedKey := pubKeyObj.Copy()
-	cacheKey := newKey
-	pubkeyCache.Add(cacheKey, copiedKey)
-	return pubKeyObj, nil
+	return &PublicKey{p: p, interned: true, compressed: &pubKey}, nil
 }
 
-// AggregatePublicKeys aggregates the provided raw public keys into a single key.
+// PublicKeyFromBytesNoValidate creates a BLS public key from a BigEndian byte slice without
+// the subgroup and infinity checks performed by PublicKeyFromBytes. Only the length and the
+// on-curve decompression are checked.
+//
+// The returned key may lie outside the G1 subgroup or be the point at infinity, and
+// verifying signatures against such a key is unsafe. Only use this for keys that were
+// already validated when they were admitted to a trusted store. Keys cached through this
+// function are validated again before PublicKeyFromBytes returns them.
+func PublicKeyFromBytesNoValidate(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyLength {
+		return nil, fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubKey))
+	}
+	var newKey [common.BLSPubkeyLength]byte
+	copy(newKey[:], pubKey)
+	if cv, ok := cachedPublicKey(newKey); ok {
+		return cv.pub.Copy(), nil
+	}
+	p := new(blstPublicKey).Uncompress(pubKey)
+	if p == nil {
+		return nil, publicKeyDecodeError(pubKey)
+	}
+	pubKeyObj := &PublicKey{p: p, interned: true, compressed: &newKey}
+	cachePublicKey(newKey, pubKeyObj, false)
+	return pubKeyObj.Copy(), nil
+}
+
+// PublicKeyFromUncompressed creates a BLS public key from its 96 byte uncompressed, big-endian
+// encoding, as returned by MarshalUncompressed. The key is subgroup and infinity checked like in
+// PublicKeyFromBytes, but does not go through the public key cache.
+func PublicKeyFromUncompressed(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != common.BLSPubkeyUncompressedLength {
+		return nil, fmt.Errorf("%w: uncompressed public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyUncompressedLength, len(pubKey))
+	}
+	// Deserialize decodes an input with the compression flag set as a compressed key, ignoring the
+	// trailing bytes.
+	if pubKey[0]&compressionFlag != 0 {
+		return nil, uncompressedPublicKeyDecodeError(pubKey)
+	}
+	p := new(blstPublicKey).Deserialize(pubKey)
+	if p == nil || !p.KeyValidate() {
+		return nil, uncompressedPublicKeyDecodeError(pubKey)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// AggregatePublicKeys aggregates the provided raw public keys into a single key. Inputs of more
+// than parallelAggregateThreshold keys are decompressed on multiple goroutines, and their errors
+// are prefixed with the index of the offending key.
 func AggregatePublicKeys(pubs [][]byte) (common.PublicKey, error) {
 	if len(pubs) == 0 {
 		return nil, errors.New("nil or empty public keys")
 	}
 	agg := new(blstAggregatePublicKey)
-	mulP1 := make([]*blstPublicKey, 0, len(pubs))
-	for _, pubkey := range pubs {
-		pubKeyObj, err := PublicKeyFromBytes(pubkey)
-		if err != nil {
+	var mulP1 []*blstPublicKey
+	if len(pubs) > parallelAggregateThreshold {
+		var err error
+		if mulP1, err = decompressPublicKeysParallel(pubs); err != nil {
 			return nil, err
 		}
-		mulP1 = append(mulP1, pubKeyObj.(*PublicKey).p)
+	} else {
+		mulP1 = make([]*blstPublicKey, 0, len(pubs))
+		for _, pubkey := range pubs {
+			pubKeyObj, err := PublicKeyFromBytes(pubkey)
+			if err != nil {
+				return nil, err
+			}
+			mulP1 = append(mulP1, pubKeyObj.(*PublicKey).p)
+		}
 	}
 	// No group check needed here since it is done in PublicKeyFromBytes
-	// Note the checks could be moved from PublicKeyFromBytes into Aggregate
-	// and take advantage of multi-threading.
+	// or decompressPublicKeysParallel.
 	agg.Aggregate(mulP1, false)
-	return &PublicKey{p: agg.ToAffine()}, nil
+	aggKey := &PublicKey{p: agg.ToAffine()}
+	// Canceling keys aggregate to the identity, which must never be used for verification.
+	if aggKey.IsInfinite() {
+		return nil, common.ErrInfinitePubKey
+	}
+	return aggKey, nil
+}
+
+// decompressPublicKeysParallel returns the decompressed and validated points of pubs. Keys found
+// validated in the cache are used as is, the others are decompressed in a worker pool.
+func decompressPublicKeysParallel(pubs [][]byte) ([]*blstPublicKey, error) {
+	points := make([]*blstPublicKey, len(pubs))
+	var pending []int
+	for i, pubkey := range pubs {
+		if len(pubkey) == common.BLSPubkeyLength {
+			var key [common.BLSPubkeyLength]byte
+			copy(key[:], pubkey)
+			if cv, ok := cachedPublicKey(key); ok && cv.validated {
+				// Only read by the aggregation, so the cached point is not copied.
+				points[i] = cv.pub.p
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+
+	j, err := forEachParallel(len(pending), func(j int) error {
+		pubkey := pubs[pending[j]]
+		if len(pubkey) != common.BLSPubkeyLength {
+			return fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(pubkey))
+		}
+		var key [common.BLSPubkeyLength]byte
+		copy(key[:], pubkey)
+		pub, err := decompressPublicKey(key)
+		if err != nil {
+			return err
+		}
+		points[pending[j]] = pub.p
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("public key at index %d: %w", pending[j], err)
+	}
+	return points, nil
 }
 
-// Marshal a public key into a LittleEndian byte slice.
+// Marshal a public key into its 48 byte compressed, big-endian encoding as defined by the
+// ZCash serialization format used in the eth2 spec. It is the same as MarshalCompressed.
 func (p *PublicKey) Marshal() []byte {
-	return p.p.Compress()
+	out := make([]byte, common.BLSPubkeyLength)
+	p.marshalInto(out)
+	return out
+}
+
+// MarshalCompressed returns the 48 byte compressed, big-endian encoding of the public key.
+func (p *PublicKey) MarshalCompressed() []byte {
+	return p.Marshal()
+}
+
+// MarshalInto writes the 48 byte compressed encoding of the public key into dst and returns the
+// number of bytes written. It fails if dst is shorter than 48 bytes. Keys decoded from their
+// compressed encoding are written without allocating, so a buffer reused across calls keeps
+// marshalling many keys allocation free.
+func (p *PublicKey) MarshalInto(dst []byte) (int, error) {
+	if len(dst) < common.BLSPubkeyLength {
+		return 0, fmt.Errorf("public key buffer must hold %d bytes, got %d", common.BLSPubkeyLength, len(dst))
+	}
+	return p.marshalInto(dst), nil
+}
+
+func (p *PublicKey) marshalInto(dst []byte) int {
+	if p.compressed != nil {
+		return copy(dst, p.compressed[:])
+	}
+	return copy(dst, p.p.Compress())
+}
+
+// MarshalUncompressed returns the 96 byte uncompressed, big-endian encoding of the public key,
+// the x and y coordinates in that order. It is parsed by PublicKeyFromUncompressed.
+func (p *PublicKey) MarshalUncompressed() []byte {
+	return p.p.Serialize()
 }
 
 // Copy the public key to a new pointer reference.
 func (p *PublicKey) Copy() common.PublicKey {
 	np := *p.p
-	return &PublicKey{p: &np}
+	return &PublicKey{p: &np, compressed: p.compressed}
 }
 
 // IsInfinite checks if the public key is infinite.
 func (p *PublicKey) IsInfinite() bool {
-	zeroKey := new(blstPublicKey)
-	return p.p.Equals(zeroKey)
+	return bytes.Equal(p.p.Compress(), common.InfinitePublicKey[:])
 }
 
 // Equals checks if the provided public key is equal to
-// the current one.
+// the current one. Keys that are nil, unset or of another
+// implementation are never equal.
 func (p *PublicKey) Equals(p2 common.PublicKey) bool {
-	return p.p.Equals(p2.(*PublicKey).p)
+	other, ok := p2.(*PublicKey)
+	if !ok || p == nil || other == nil || p.p == nil || other.p == nil {
+		return false
+	}
+	return p.p.Equals(other.p)
 }
 
-// Aggregate two public keys.
+// VerifyProofOfPossession checks that proof is a proof of possession of the secret key behind
+// this public key.
+//
+// In IETF draft BLS specification:
+// PopVerify(PK, proof) -> VALID or INVALID: an algorithm that outputs
+//      VALID if proof is valid for PK, and INVALID otherwise.
+func (p *PublicKey) VerifyProofOfPossession(proof common.Signature) bool {
+	sig, ok := proof.(*Signature)
+	if !ok || sig == nil || sig.s == nil || p == nil || p.p == nil || p.IsInfinite() {
+		return false
+	}
+	return sig.s.Verify(true, p.p, false, p.Marshal(), popDst)
+}
+
+// Aggregate two public keys. The receiver is updated in place to hold the aggregate and is
+// returned. Only the receiver's point is replaced, the point it held before is never written
+// to, so keys sharing it are unaffected; use AggregateWith to leave the receiver untouched.
+// Aggregate panics on keys interned by the public key cache.
 func (p *PublicKey) Aggregate(p2 common.PublicKey) common.PublicKey {
+	if p.interned {
+		panic("blst: Aggregate on an interned public key, use AggregateWith")
+	}
 
 	agg := new(blstAggregatePublicKey)
 	// No group check here since it is checked at decompression time
 	agg.Add(p.p, false)
 	agg.Add(p2.(*PublicKey).p, false)
 	p.p = agg.ToAffine()
+	p.compressed = nil
 
 	return p
 }
 
+// AggregateWith returns the aggregate of the two public keys as a new key, leaving both
+// operands untouched.
+func (p *PublicKey) AggregateWith(p2 common.PublicKey) common.PublicKey {
+	agg := new(blstAggregatePublicKey)
+	// No group check here since it is checked at decompression time
+	agg.Add(p.p, false)
+	agg.Add(p2.(*PublicKey).p, false)
+	return &PublicKey{p: agg.ToAffine()}
+}
+
+// Neg returns the negation -P of the public key as a new key, leaving the receiver untouched.
+// Aggregating a key with its negation gives the point at infinity.
+func (p *PublicKey) Neg() common.PublicKey {
+	if p.IsInfinite() {
+		return p.Copy()
+	}
+	// blst does not expose point negation, so negate y in the uncompressed x || y encoding.
+	raw := p.p.Serialize()
+	y := new(big.Int).SetBytes(raw[common.BLSPubkeyLength:])
+	new(big.Int).Sub(fieldModulus, y).FillBytes(raw[common.BLSPubkeyLength:])
+	return &PublicKey{p: new(blstPublicKey).Deserialize(raw)}
+}
+
+// ScalarMul returns [scalar]P as a new key, leaving the receiver untouched, such as to blind a
+// key by a known factor. scalar is a big-endian integer of any length, reduced mod r. A scalar
+// that is zero mod r gives the point at infinity, which IsInfinite reports and which has to be
+// rejected before the key is used, like PublicKeyFromBytes rejects it.
+func (p *PublicKey) ScalarMul(scalar []byte) common.PublicKey {
+	var point blst.P1
+	point.FromAffine(p.p)
+	return &PublicKey{p: point.MultAssign(littleEndianScalar(reduceScalar(scalar)), 255).ToAffine()}
+}
+
 // AggregateMultiplePubkeys aggregates the provided decompressed keys into a single key.
-func AggregateMultiplePubkeys(pubkeys []common.PublicKey) common.PublicKey {
+func AggregateMultiplePubkeys(pubkeys []common.PublicKey) (common.PublicKey, error) {
+	if len(pubkeys) == 0 {
+		return nil, errors.New("nil or empty public keys")
+	}
 	mulP1 := make([]*blstPublicKey, 0, len(pubkeys))
 	for _, pubkey := range pubkeys {
 		mulP1 = append(mulP1, pubkey.(*PublicKey).p)
@@ -122,5 +330,5 @@ func AggregateMultiplePubkeys(pubkeys []common.PublicKey) common.PublicKey {
 	// Note the checks could be moved from PublicKeyFromBytes into Aggregate
 	// and take advantage of multi-threading.
 	agg.Aggregate(mulP1, false)
-	return &PublicKey{p: agg.ToAffine()}
+	return &PublicKey{p: agg.ToAffine()}, nil
 }
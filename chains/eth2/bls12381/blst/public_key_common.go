@@ -0,0 +1,466 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"golang.org/x/crypto/sha3"
+)
+
+// PublicKeyFromBytesOpts creates a BLS public key like PublicKeyFromBytes when validate is set,
+// and like PublicKeyFromBytesNoValidate otherwise, for callers that share one code path between
+// trusted and untrusted inputs.
+func PublicKeyFromBytesOpts(pubKey []byte, validate bool) (common.PublicKey, error) {
+	if validate {
+		return PublicKeyFromBytes(pubKey)
+	}
+	return PublicKeyFromBytesNoValidate(pubKey)
+}
+
+// PublicKeyFromHex creates a BLS public key from a hex encoded string, with or without a 0x prefix.
+func PublicKeyFromHex(s string) (common.PublicKey, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	if len(s)%2 != 0 {
+		return nil, fmt.Errorf("public key hex must have an even length, got %d characters", len(s))
+	}
+	pubKey, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("public key is not valid hex: %w", err)
+	}
+	return PublicKeyFromBytes(pubKey)
+}
+
+// Flag bits in the first byte of a point encoding in the ZCash serialization format.
+const (
+	compressionFlag = 0x80
+	infinityFlag    = 0x40
+	signFlag        = 0x20
+)
+
+// ValidatePubKeyBytes cheaply rejects inputs that cannot be a valid compressed public key, by
+// checking the length and the flag bits without decompressing the point. Passing it does not
+// mean the key is valid, PublicKeyFromBytes still has to be called. The point at infinity is
+// rejected with ErrInfinitePubKey.
+func ValidatePubKeyBytes(b []byte) error {
+	if len(b) != common.BLSPubkeyLength {
+		return fmt.Errorf("%w: public key must be %d bytes, got %d", common.ErrPubKeyLength, common.BLSPubkeyLength, len(b))
+	}
+	infinite, err := checkCompressedFlags(b)
+	if err != nil {
+		return fmt.Errorf("%w: %v", common.ErrPubKeyCompression, err)
+	}
+	if infinite {
+		return common.ErrInfinitePubKey
+	}
+	return nil
+}
+
+// fieldModulus is the modulus p of the base field of BLS12-381.
+var fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+// publicKeyDecodeError tells why the backend rejected the 48 byte compressed public key b, so
+// that keys encoded by mistake can be told apart from keys that are invalid: the flag bits are
+// checked first, then whether the point is at infinity, then whether x is the coordinate of a
+// point of y^2 = x^3 + 4. Keys passing all of these are outside the G1 subgroup. It is only
+// called on the error path.
+func publicKeyDecodeError(b []byte) error {
+	infinite, err := checkCompressedFlags(b)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w: %v", common.ErrPubKeyCompression, err)
+	}
+	if infinite {
+		return common.ErrInfinitePubKey
+	}
+	raw := append([]byte(nil), b...)
+	raw[0] &^= compressionFlag | infinityFlag | signFlag
+	x := new(big.Int).SetBytes(raw)
+	if x.Cmp(fieldModulus) >= 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w: x coordinate is not below the field modulus", common.ErrPubKeyNotOnCurve)
+	}
+	rhs := new(big.Int).Exp(x, big.NewInt(3), fieldModulus)
+	rhs.Add(rhs, big.NewInt(4)).Mod(rhs, fieldModulus)
+	if big.Jacobi(rhs, fieldModulus) < 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w", common.ErrPubKeyNotOnCurve)
+	}
+	return common.ErrPubKeyNotInSubgroup
+}
+
+// uncompressedPublicKeyDecodeError tells why the backend rejected the 96 byte uncompressed public
+// key b, like publicKeyDecodeError: the flag bits are checked first, then whether the point is at
+// infinity, then whether (x, y) is a point of y^2 = x^3 + 4. Keys passing all of these are
+// outside the G1 subgroup. It is only called on the error path.
+func uncompressedPublicKeyDecodeError(b []byte) error {
+	if b[0]&compressionFlag != 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w: compression flag is set", common.ErrPubKeyCompression)
+	}
+	if b[0]&signFlag != 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w: uncompressed point must not have the sign flag set", common.ErrPubKeyCompression)
+	}
+	raw := append([]byte(nil), b...)
+	raw[0] &^= infinityFlag
+	if b[0]&infinityFlag != 0 {
+		if !IsZero(raw) {
+			return fmt.Errorf("could not unmarshal bytes into public key: %w: point at infinity must have all other bits cleared", common.ErrPubKeyCompression)
+		}
+		return common.ErrInfinitePubKey
+	}
+	x := new(big.Int).SetBytes(raw[:common.BLSPubkeyLength])
+	y := new(big.Int).SetBytes(raw[common.BLSPubkeyLength:])
+	if x.Cmp(fieldModulus) >= 0 || y.Cmp(fieldModulus) >= 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w: coordinates are not below the field modulus", common.ErrPubKeyNotOnCurve)
+	}
+	rhs := new(big.Int).Exp(x, big.NewInt(3), fieldModulus)
+	rhs.Add(rhs, big.NewInt(4)).Mod(rhs, fieldModulus)
+	if new(big.Int).Exp(y, big.NewInt(2), fieldModulus).Cmp(rhs) != 0 {
+		return fmt.Errorf("could not unmarshal bytes into public key: %w", common.ErrPubKeyNotOnCurve)
+	}
+	return common.ErrPubKeyNotInSubgroup
+}
+
+// checkCompressedFlags checks the flag bits of a compressed point encoding and reports whether it
+// encodes the point at infinity, in which case every other bit must be zero. The whole encoding is
+// read either way, so the time taken does not depend on where a non-zero byte is.
+func checkCompressedFlags(b []byte) (bool, error) {
+	if b[0]&compressionFlag == 0 {
+		return false, fmt.Errorf("compression flag is not set")
+	}
+	var rest byte
+	for _, c := range b[1:] {
+		rest |= c
+	}
+	if b[0]&infinityFlag == 0 {
+		return false, nil
+	}
+	if b[0]&signFlag != 0 {
+		return true, fmt.Errorf("point at infinity must not have the sign flag set")
+	}
+	if rest|b[0]&^(compressionFlag|infinityFlag|signFlag) != 0 {
+		return true, fmt.Errorf("point at infinity must have all other bits cleared")
+	}
+	return true, nil
+}
+
+// PublicKeysFromBytes creates BLS public keys from a list of BigEndian byte slices, running the
+// decompression and subgroup checks of PublicKeyFromBytes on up to GOMAXPROCS goroutines. Keys are
+// returned in input order. Once a key fails no further keys are started, and the error of the
+// lowest failing index is returned.
+func PublicKeysFromBytes(pubKeys [][]byte) ([]common.PublicKey, error) {
+	keys := make([]common.PublicKey, len(pubKeys))
+	i, err := forEachParallel(len(pubKeys), func(i int) (err error) {
+		keys[i], err = PublicKeyFromBytes(pubKeys[i])
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("public key at index %d: %w", i, err)
+	}
+	return keys, nil
+}
+
+// AggregatePublicKeysCollectErrors aggregates raw public keys like AggregatePublicKeys, but
+// decodes every key instead of stopping at the first bad one, for diagnosing an input with
+// several. errs has an entry for each key, nil for the valid ones and prefixed with the index
+// otherwise, or is nil if every key is valid. With strict set no key is returned once any key is
+// invalid, like AggregatePublicKeys; otherwise the valid keys are aggregated on their own.
+//
+// A nil key always comes with at least one error in errs: the invalid keys, common.ErrNoPublicKeys
+// as the only entry for empty input, or common.ErrInfinitePubKey appended after the entries of the
+// keys when the valid keys aggregate to the identity.
+func AggregatePublicKeysCollectErrors(pubs [][]byte, strict bool) (common.PublicKey, []error) {
+	if len(pubs) == 0 {
+		return nil, []error{common.ErrNoPublicKeys}
+	}
+	keys := make([]common.PublicKey, len(pubs))
+	errs := make([]error, len(pubs))
+	forEachParallel(len(pubs), func(i int) error {
+		var err error
+		if keys[i], err = PublicKeyFromBytes(pubs[i]); err != nil {
+			errs[i] = fmt.Errorf("public key at index %d: %w", i, err)
+		}
+		return nil
+	})
+
+	valid := keys[:0]
+	for i, key := range keys {
+		if errs[i] == nil {
+			valid = append(valid, key)
+		}
+	}
+	switch {
+	case len(valid) == 0 || strict && len(valid) < len(pubs):
+		return nil, errs
+	case len(valid) == len(pubs):
+		errs = nil
+	}
+	aggKey, err := AggregateMultiplePubkeys(valid)
+	if err == nil && aggKey.IsInfinite() {
+		// Canceling keys aggregate to the identity, which must never be used for verification.
+		err = common.ErrInfinitePubKey
+	}
+	if err != nil {
+		if errs == nil {
+			errs = make([]error, len(pubs))
+		}
+		return nil, append(errs, err)
+	}
+	return aggKey, errs
+}
+
+// MarshalPublicKeys writes the compressed encodings of keys back to back into a single buffer
+// of len(keys)*48 bytes. It is parsed by UnmarshalPublicKeys.
+func MarshalPublicKeys(keys []common.PublicKey) []byte {
+	buf := make([]byte, len(keys)*common.BLSPubkeyLength)
+	for i, key := range keys {
+		key.(*PublicKey).marshalInto(buf[i*common.BLSPubkeyLength:])
+	}
+	return buf
+}
+
+// UnmarshalPublicKeys parses a buffer of back to back compressed public keys, as written by
+// MarshalPublicKeys. Every key is validated like in PublicKeysFromBytes.
+func UnmarshalPublicKeys(data []byte) ([]common.PublicKey, error) {
+	if len(data)%common.BLSPubkeyLength != 0 {
+		return nil, fmt.Errorf("%w: buffer of %d bytes is not a multiple of %d", common.ErrPubKeyLength, len(data), common.BLSPubkeyLength)
+	}
+	pubKeys := make([][]byte, len(data)/common.BLSPubkeyLength)
+	for i := range pubKeys {
+		pubKeys[i] = data[i*common.BLSPubkeyLength : (i+1)*common.BLSPubkeyLength]
+	}
+	return PublicKeysFromBytes(pubKeys)
+}
+
+// DecodePublicKeysStream reads back to back compressed public keys from r, as written by
+// MarshalPublicKeys, and calls fn with the index and key of each one in order. Only one key is
+// held at a time, so large lists such as the validators of a beacon state can be processed
+// without allocating the whole set. Every key is validated like in PublicKeyFromBytes.
+//
+// Decoding stops at the first error returned by fn, which is returned unchanged. A stream whose
+// length is not a multiple of 48 bytes fails with ErrPubKeyLength once the short chunk is read.
+func DecodePublicKeysStream(r io.Reader, fn func(int, common.PublicKey) error) error {
+	buf := make([]byte, common.BLSPubkeyLength)
+	for i := 0; ; i++ {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			return nil
+		}
+		if err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("%w: stream ends with a chunk of %d bytes after %d keys", common.ErrPubKeyLength, n, i)
+		}
+		if err != nil {
+			return fmt.Errorf("read public key at index %d: %v", i, err)
+		}
+		pub, err := PublicKeyFromBytes(buf)
+		if err != nil {
+			return fmt.Errorf("public key at index %d: %w", i, err)
+		}
+		if err := fn(i, pub); err != nil {
+			return err
+		}
+	}
+}
+
+// SortPublicKeys sorts keys in place by the lexicographic order of their compressed encodings,
+// so that keys gathered in arbitrary order can be canonicalized before they are aggregated or
+// hashed. The sort is stable, equal keys keep their relative order.
+func SortPublicKeys(keys []common.PublicKey) {
+	encoded := make([][]byte, len(keys))
+	for i, key := range keys {
+		encoded[i] = key.Marshal()
+	}
+	sort.Stable(publicKeysByEncoding{keys: keys, encoded: encoded})
+}
+
+// publicKeysByEncoding sorts keys by their precomputed encodings.
+type publicKeysByEncoding struct {
+	keys    []common.PublicKey
+	encoded [][]byte
+}
+
+func (s publicKeysByEncoding) Len() int { return len(s.keys) }
+
+func (s publicKeysByEncoding) Less(i, j int) bool {
+	return bytes.Compare(s.encoded[i], s.encoded[j]) < 0
+}
+
+func (s publicKeysByEncoding) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.encoded[i], s.encoded[j] = s.encoded[j], s.encoded[i]
+}
+
+// parallelAggregateThreshold is the number of keys above which AggregatePublicKeys decompresses
+// and validates them in parallel.
+const parallelAggregateThreshold = 64
+
+// forEachParallel calls fn for every index below n on GOMAXPROCS workers, and stops handing
+// out indices after the first failure. It returns the lowest failing index and its error, or
+// -1 and nil if every call succeeded.
+func forEachParallel(n int, fn func(i int) error) (int, error) {
+	errs := make([]error, n)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+
+	// Indices are claimed in increasing order, so every index below a failing one has been
+	// processed by the time the workers return.
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if errs[i] = fn(i); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return i, err
+		}
+	}
+	return -1, nil
+}
+
+// VerifyAggregateConsistency reports whether the receiver is the aggregate of parts, by
+// aggregating them again. It is meant for assertions and tests that guard code building
+// aggregate keys. The aggregate of no parts is the point at infinity. Parts that are nil or of
+// another implementation are never consistent.
+func (p *PublicKey) VerifyAggregateConsistency(parts []common.PublicKey) bool {
+	if len(parts) == 0 {
+		return p.IsInfinite()
+	}
+	for _, part := range parts {
+		if k, ok := part.(*PublicKey); !ok || k == nil || k.p == nil {
+			return false
+		}
+	}
+	agg, err := AggregateMultiplePubkeys(parts)
+	if err != nil {
+		return false
+	}
+	return p.Equals(agg)
+}
+
+// publicKeyHasher holds the func([]byte) [32]byte used by PublicKey.Hash.
+var publicKeyHasher atomic.Value
+
+func init() {
+	publicKeyHasher.Store(keccak256)
+}
+
+func keccak256(b []byte) [32]byte {
+	var h [32]byte
+	d := sha3.NewLegacyKeccak256()
+	d.Write(b)
+	d.Sum(h[:0])
+	return h
+}
+
+// SetPublicKeyHasher sets the hash function of PublicKey.Hash, keccak256 by default like in the
+// rest of Atlas. A nil hasher restores the default. It must only be called once during
+// initialization, before any key is hashed, since hashes computed before and after the change
+// do not match.
+func SetPublicKeyHasher(hasher func([]byte) [32]byte) {
+	if hasher == nil {
+		hasher = keccak256
+	}
+	publicKeyHasher.Store(hasher)
+}
+
+// Hash returns the digest of the compressed public key under the hasher set with
+// SetPublicKeyHasher, for use as a map key. Keys that are Equals have the same Hash.
+func (p *PublicKey) Hash() [32]byte {
+	return publicKeyHasher.Load().(func([]byte) [32]byte)(p.Marshal())
+}
+
+// String returns a shortened 0x prefixed hex form of the compressed public key for logs, the
+// first and last 4 bytes joined by "...". Use Hex for the full form.
+func (p *PublicKey) String() string {
+	if p == nil || p.p == nil {
+		return "<nil pubkey>"
+	}
+	b := p.Marshal()
+	return "0x" + hex.EncodeToString(b[:4]) + "..." + hex.EncodeToString(b[len(b)-4:])
+}
+
+// Hex returns the compressed public key as a 0x prefixed hex string.
+func (p *PublicKey) Hex() string {
+	return "0x" + hex.EncodeToString(p.Marshal())
+}
+
+// MarshalJSON encodes the public key as a 0x prefixed compressed hex string.
+func (p *PublicKey) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.Hex())
+}
+
+// UnmarshalJSON decodes a hex encoded public key, validating it like PublicKeyFromBytes. A JSON
+// null leaves the key unset.
+func (p *PublicKey) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return fmt.Errorf("public key must be a JSON string: %w", err)
+	}
+	pub, err := PublicKeyFromHex(s)
+	if err != nil {
+		return err
+	}
+	q := pub.(*PublicKey)
+	p.p, p.compressed = q.p, q.compressed
+	return nil
+}
+
+// MarshalSSZ encodes the public key as an SSZ BLSPubkey, the 48 byte compressed point.
+func (p *PublicKey) MarshalSSZ() ([]byte, error) {
+	return p.Marshal(), nil
+}
+
+// MarshalSSZTo appends the SSZ encoding of the public key to dst.
+func (p *PublicKey) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, p.Marshal()...), nil
+}
+
+// UnmarshalSSZ decodes an SSZ BLSPubkey, validating it like PublicKeyFromBytes.
+func (p *PublicKey) UnmarshalSSZ(buf []byte) error {
+	pub, err := PublicKeyFromBytes(buf)
+	if err != nil {
+		return err
+	}
+	q := pub.(*PublicKey)
+	p.p, p.compressed = q.p, q.compressed
+	return nil
+}
+
+// SizeSSZ returns the size of the SSZ encoded public key.
+func (p *PublicKey) SizeSSZ() int {
+	return common.BLSPubkeyLength
+}
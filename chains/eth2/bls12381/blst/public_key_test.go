@@ -1,16 +1,23 @@
-//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
 // +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
-// +build !blst_disabled
 
 package blst_test
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	fssz "github.com/prysmaticlabs/fastssz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -55,7 +62,7 @@ func TestPublicKeyFromBytes(t *testing.T) {
 			res, err := blst.PublicKeyFromBytes(test.input)
 			if test.err != nil {
 				assert.NotEqual(t, nil, err, "No error returned")
-				assert.ErrorContains(t, err, test.err.Error(), "Unexpected error returned")
+				assert.Contains(t, err.Error(), test.err.Error(), "Unexpected error returned")
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, 0, bytes.Compare(res.Marshal(), test.input))
@@ -88,7 +95,8 @@ func TestPublicKey_Aggregate(t *testing.T) {
 	require.NoError(t, err)
 	resKey := pubkeyB.Aggregate(priv2.PublicKey())
 
-	aggKey := blst.AggregateMultiplePubkeys([]common.PublicKey{priv.PublicKey(), priv2.PublicKey()})
+	aggKey, err := blst.AggregateMultiplePubkeys([]common.PublicKey{priv.PublicKey(), priv2.PublicKey()})
+	require.NoError(t, err)
 
 	require.Equal(t, resKey.Marshal(), aggKey.Marshal(), "Pubkey does not match up")
 }
@@ -96,5 +104,817 @@ func TestPublicKey_Aggregate(t *testing.T) {
 func TestPublicKeysEmpty(t *testing.T) {
 	var pubs [][]byte
 	_, err := blst.AggregatePublicKeys(pubs)
-	require.ErrorContains(t, err, "nil or empty public keys", err)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil or empty public keys")
+}
+
+func TestAggregateMultiplePubkeys_Empty(t *testing.T) {
+	_, err := blst.AggregateMultiplePubkeys(nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil or empty public keys")
+
+	_, err = blst.AggregateMultiplePubkeys([]common.PublicKey{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil or empty public keys")
+}
+
+func TestAggregateMultiplePubkeys_Single(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+
+	aggKey, err := blst.AggregateMultiplePubkeys([]common.PublicKey{pub})
+	require.NoError(t, err)
+	assert.Equal(t, pub.Marshal(), aggKey.Marshal())
+}
+
+func TestPublicKeysFromBytes(t *testing.T) {
+	raw := make([][]byte, 64)
+	for i := range raw {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		raw[i] = priv.PublicKey().Marshal()
+	}
+	keys, err := blst.PublicKeysFromBytes(raw)
+	require.NoError(t, err)
+	require.Equal(t, len(raw), len(keys))
+	for i, k := range keys {
+		assert.Equal(t, raw[i], k.Marshal(), "Key %d out of order", i)
+	}
+
+	keys, err = blst.PublicKeysFromBytes(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
+func TestPublicKeysFromBytes_ReportsFirstFailingIndex(t *testing.T) {
+	raw := make([][]byte, 32)
+	for i := range raw {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		raw[i] = priv.PublicKey().Marshal()
+	}
+	raw[9] = raw[9][:10]
+	raw[20] = make([]byte, common.BLSPubkeyLength)
+
+	_, err := blst.PublicKeysFromBytes(raw)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public key at index 9")
+	assert.Contains(t, err.Error(), "public key must be 48 bytes")
+}
+
+func TestPublicKey_IsInfinite(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+	assert.Equal(t, false, priv.PublicKey().IsInfinite())
+
+	// Flipping the sign flag of a compressed point yields its negation.
+	neg := make([]byte, len(pub))
+	copy(neg, pub)
+	neg[0] ^= 0x20
+	pubKeys, err := blst.PublicKeysFromBytes([][]byte{pub, neg})
+	require.NoError(t, err)
+	aggKey, err := blst.AggregateMultiplePubkeys(pubKeys)
+	require.NoError(t, err)
+	assert.Equal(t, true, aggKey.IsInfinite())
+	assert.Equal(t, common.InfinitePublicKey[:], aggKey.Marshal())
+}
+
+func TestAggregatePublicKeys_RejectsCancelingKeys(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+	neg := make([]byte, len(pub))
+	copy(neg, pub)
+	neg[0] ^= 0x20
+
+	_, err = blst.AggregatePublicKeys([][]byte{pub, neg})
+	assert.Equal(t, common.ErrInfinitePubKey, err)
+
+	priv2, err := blst.RandKey()
+	require.NoError(t, err)
+	_, err = blst.AggregatePublicKeys([][]byte{pub, neg, priv2.PublicKey().Marshal()})
+	assert.NoError(t, err, "Partially canceling keys are a valid aggregate")
+}
+
+func TestAggregatePublicKeysCollectErrors(t *testing.T) {
+	pubs := randPublicKeys(t, 8)
+	raw := make([][]byte, len(pubs))
+	for i, pub := range pubs {
+		raw[i] = pub.Marshal()
+	}
+	allValid, errs := blst.AggregatePublicKeysCollectErrors(raw, true)
+	assert.Nil(t, errs)
+	expected, err := blst.AggregatePublicKeys(raw)
+	require.NoError(t, err)
+	require.NotNil(t, allValid)
+	assert.Equal(t, expected.Marshal(), allValid.Marshal())
+
+	raw[1] = raw[1][:47]
+	raw[4] = append([]byte{raw[4][0] &^ 0x80}, raw[4][1:]...)
+	raw[6] = append([]byte{0xc0}, make([]byte, 47)...)
+	bad := map[int]error{1: common.ErrPubKeyLength, 4: nil, 6: common.ErrInfinitePubKey}
+
+	for _, strict := range []bool{true, false} {
+		aggKey, errs := blst.AggregatePublicKeysCollectErrors(raw, strict)
+		require.Len(t, errs, len(raw))
+		for i, err := range errs {
+			want, ok := bad[i]
+			if !ok {
+				assert.NoError(t, err, "Key %d reported", i)
+				continue
+			}
+			require.Error(t, err, "Key %d not reported", i)
+			assert.Contains(t, err.Error(), fmt.Sprintf("public key at index %d", i))
+			if want != nil {
+				assert.True(t, errors.Is(err, want), "Key %d: %v", i, err)
+			}
+		}
+
+		if strict {
+			assert.Nil(t, aggKey, "Strict aggregation with invalid keys")
+			continue
+		}
+		expected, err := blst.AggregateMultiplePubkeys([]common.PublicKey{pubs[0], pubs[2], pubs[3], pubs[5], pubs[7]})
+		require.NoError(t, err)
+		require.NotNil(t, aggKey)
+		assert.Equal(t, expected.Marshal(), aggKey.Marshal())
+	}
+
+	aggKey, errs := blst.AggregatePublicKeysCollectErrors([][]byte{raw[1], raw[4]}, false)
+	assert.Nil(t, aggKey, "No valid keys")
+	require.Len(t, errs, 2)
+	assert.Error(t, errs[0])
+	assert.Error(t, errs[1])
+
+	aggKey, errs = blst.AggregatePublicKeysCollectErrors(nil, false)
+	assert.Nil(t, aggKey)
+	assert.Equal(t, []error{common.ErrNoPublicKeys}, errs)
+
+	neg := pubs[0].(*blst.PublicKey).Neg().Marshal()
+	aggKey, errs = blst.AggregatePublicKeysCollectErrors([][]byte{raw[0], neg}, true)
+	assert.Nil(t, aggKey, "Canceling keys aggregate to the identity")
+	assert.Equal(t, []error{nil, nil, common.ErrInfinitePubKey}, errs)
+
+	aggKey, errs = blst.AggregatePublicKeysCollectErrors([][]byte{raw[0], neg, raw[1]}, false)
+	assert.Nil(t, aggKey, "Canceling valid keys next to an invalid one")
+	require.Len(t, errs, 4)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.True(t, errors.Is(errs[2], common.ErrPubKeyLength), "Got %v", errs[2])
+	assert.Equal(t, common.ErrInfinitePubKey, errs[3])
+}
+
+func TestPublicKey_Neg(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+	before := pub.Marshal()
+
+	neg := pub.Neg()
+	assert.Equal(t, before, pub.Marshal(), "Neg modified the receiver")
+	assert.Equal(t, true, pub.Aggregate(pub.Neg()).IsInfinite())
+
+	// The negation only differs in the sign flag of the compressed encoding.
+	flipped := append([]byte(nil), before...)
+	flipped[0] ^= 0x20
+	assert.Equal(t, flipped, neg.Marshal())
+	_, err = blst.AggregatePublicKeys([][]byte{before, neg.Marshal()})
+	assert.Equal(t, common.ErrInfinitePubKey, err)
+
+	orig, err := blst.PublicKeyFromBytes(before)
+	require.NoError(t, err)
+	assert.Equal(t, true, orig.Equals(neg.(*blst.PublicKey).Neg()))
+}
+
+func TestPublicKey_ScalarMul(t *testing.T) {
+	r, ok := new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	require.Equal(t, true, ok)
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+	before := pub.Marshal()
+	msg := []byte("vote")
+
+	// Blinding the key and the signature by the same scalar preserves verifiability. [k]sig is the
+	// signature of the secret key k*sk.
+	scalar := make([]byte, 32)
+	_, err = rand.Read(scalar)
+	require.NoError(t, err)
+	blindedScalar := new(big.Int).Mul(new(big.Int).SetBytes(scalar), new(big.Int).SetBytes(priv.Marshal()))
+	var buf [32]byte
+	blindedScalar.Mod(blindedScalar, r).FillBytes(buf[:])
+	blindedPriv, err := blst.SecretKeyFromBytes(buf[:])
+	require.NoError(t, err)
+	blinded := pub.ScalarMul(scalar)
+	assert.Equal(t, before, pub.Marshal(), "ScalarMul modified the receiver")
+	assert.Equal(t, true, blinded.Equals(blindedPriv.PublicKey()))
+	assert.Equal(t, true, blindedPriv.Sign(msg).Verify(blinded, msg))
+	assert.Equal(t, false, priv.Sign(msg).Verify(blinded, msg))
+
+	// Scalars are reduced mod r.
+	assert.Equal(t, true, pub.ScalarMul([]byte{1}).Equals(pub))
+	assert.Equal(t, true, pub.ScalarMul(new(big.Int).Add(r, big.NewInt(2)).Bytes()).Equals(pub.AggregateWith(pub)))
+
+	// A zero scalar gives the infinity key, which is rejected on decoding.
+	for _, zero := range [][]byte{nil, make([]byte, 32), r.Bytes()} {
+		inf := pub.ScalarMul(zero)
+		assert.Equal(t, true, inf.IsInfinite())
+		_, err = blst.PublicKeyFromBytes(inf.Marshal())
+		assert.Equal(t, true, errors.Is(err, common.ErrInfinitePubKey))
+	}
+}
+
+func TestValidatePubKeyBytes(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+	require.NoError(t, blst.ValidatePubKeyBytes(pub))
+
+	withFirst := func(b byte) []byte {
+		out := append([]byte(nil), pub...)
+		out[0] = b
+		return out
+	}
+	infinityWithTail := append([]byte(nil), common.InfinitePublicKey[:]...)
+	infinityWithTail[47] = 0x01
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{name: "Short", input: pub[:47], err: "public key must be 48 bytes"},
+		{name: "Uncompressed", input: withFirst(pub[0] &^ 0x80), err: "compression flag is not set"},
+		{name: "ZeroFlags", input: make([]byte, 48), err: "compression flag is not set"},
+		{name: "InfinityWithSign", input: withFirst(0xe0), err: "sign flag"},
+		{name: "InfinityWithBits", input: withFirst(0xc1), err: "all other bits cleared"},
+		{name: "InfinityWithTail", input: infinityWithTail, err: "all other bits cleared"},
+		{name: "Infinity", input: common.InfinitePublicKey[:], err: common.ErrInfinitePubKey.Error()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := blst.ValidatePubKeyBytes(test.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+			_, err = blst.PublicKeyFromBytes(test.input)
+			assert.Error(t, err, "Input rejected by the pre-check must not decompress")
+		})
+	}
+}
+
+func TestPublicKeyFromHex(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+	h := pub.Hex()
+	require.Equal(t, "0x", h[:2])
+	require.Equal(t, 2+2*common.BLSPubkeyLength, len(h))
+
+	for _, s := range []string{h, h[2:], "0X" + h[2:]} {
+		res, err := blst.PublicKeyFromHex(s)
+		require.NoError(t, err)
+		assert.Equal(t, true, res.Equals(pub))
+		assert.Equal(t, h, res.(*blst.PublicKey).Hex())
+	}
+}
+
+func TestPublicKeyFromHex_Invalid(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	h := priv.PublicKey().(*blst.PublicKey).Hex()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "Empty", input: "", err: "public key must be 48 bytes"},
+		{name: "PrefixOnly", input: "0x", err: "public key must be 48 bytes"},
+		{name: "OddLength", input: h[:len(h)-1], err: "public key hex must have an even length"},
+		{name: "NonHex", input: h[:len(h)-2] + "zz", err: "public key is not valid hex"},
+		{name: "Short", input: h[:len(h)-2], err: "public key must be 48 bytes"},
+		{name: "Long", input: h + "00", err: "public key must be 48 bytes"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := blst.PublicKeyFromHex(test.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestPublicKey_JSON(t *testing.T) {
+	type committee struct {
+		Pubkeys []*blst.PublicKey `json:"pubkeys"`
+	}
+	var c committee
+	for i := 0; i < 3; i++ {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		c.Pubkeys = append(c.Pubkeys, priv.PublicKey().(*blst.PublicKey))
+	}
+	c.Pubkeys = append(c.Pubkeys, nil)
+
+	enc, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.Contains(t, string(enc), `"`+c.Pubkeys[0].Hex()+`"`)
+	assert.Contains(t, string(enc), "null]")
+
+	var dec committee
+	require.NoError(t, json.Unmarshal(enc, &dec))
+	require.Equal(t, len(c.Pubkeys), len(dec.Pubkeys))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, true, dec.Pubkeys[i].Equals(c.Pubkeys[i]), "Key %d does not round trip", i)
+	}
+	assert.Nil(t, dec.Pubkeys[3])
+}
+
+func TestPublicKey_UnmarshalJSON_Invalid(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	h := priv.PublicKey().(*blst.PublicKey).Hex()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "NotAString", input: `123`, err: "public key must be a JSON string"},
+		{name: "NonHex", input: `"0xzz"`, err: "public key is not valid hex"},
+		{name: "Short", input: `"` + h[:len(h)-2] + `"`, err: "public key must be 48 bytes"},
+		{name: "NotOnCurve", input: `"0x` + strings.Repeat("00", common.BLSPubkeyLength) + `"`, err: "could not unmarshal bytes into public key"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pub := new(blst.PublicKey)
+			err := json.Unmarshal([]byte(test.input), pub)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+var _ fssz.Marshaler = (*blst.PublicKey)(nil)
+var _ fssz.Unmarshaler = (*blst.PublicKey)(nil)
+
+func TestPublicKey_SSZ(t *testing.T) {
+	assert.Equal(t, common.BLSPubkeyLength, new(blst.PublicKey).SizeSSZ())
+	for i := 0; i < 5; i++ {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		pub := priv.PublicKey().(*blst.PublicKey)
+		assert.Equal(t, common.BLSPubkeyLength, pub.SizeSSZ())
+
+		enc, err := pub.MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, pub.Marshal(), enc)
+		assert.Equal(t, pub.SizeSSZ(), len(enc))
+
+		prefix := []byte{0x01, 0x02}
+		appended, err := pub.MarshalSSZTo(prefix)
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte{0x01, 0x02}, enc...), appended)
+
+		dec := new(blst.PublicKey)
+		require.NoError(t, dec.UnmarshalSSZ(enc))
+		assert.Equal(t, true, dec.Equals(pub))
+	}
+}
+
+func TestPublicKey_UnmarshalSSZ_Invalid(t *testing.T) {
+	pub := new(blst.PublicKey)
+	err := pub.UnmarshalSSZ(make([]byte, common.BLSPubkeyLength-1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public key must be 48 bytes")
+
+	err = pub.UnmarshalSSZ(make([]byte, common.BLSPubkeyLength))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not unmarshal bytes into public key")
+
+	err = pub.UnmarshalSSZ(common.InfinitePublicKey[:])
+	assert.Equal(t, common.ErrInfinitePubKey, err)
+}
+
+// otherPublicKey is a common.PublicKey implementation that is not backed by blst.
+type otherPublicKey struct {
+	raw []byte
+}
+
+func (o *otherPublicKey) Marshal() []byte                                     { return o.raw }
+func (o *otherPublicKey) Copy() common.PublicKey                              { return o }
+func (o *otherPublicKey) Aggregate(p2 common.PublicKey) common.PublicKey      { return o }
+func (o *otherPublicKey) AggregateWith(p2 common.PublicKey) common.PublicKey  { return o }
+func (o *otherPublicKey) IsInfinite() bool                                    { return false }
+func (o *otherPublicKey) Equals(p2 common.PublicKey) bool                     { return false }
+func (o *otherPublicKey) VerifyProofOfPossession(proof common.Signature) bool { return false }
+
+func TestPublicKey_Equals_NilSafe(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+
+	var typedNil *blst.PublicKey
+	assert.Equal(t, false, pub.Equals(nil), "Nil interface")
+	assert.Equal(t, false, pub.Equals(typedNil), "Typed nil")
+	assert.Equal(t, false, pub.Equals(new(blst.PublicKey)), "Unset key")
+	assert.Equal(t, false, pub.Equals(&otherPublicKey{raw: pub.Marshal()}), "Other implementation")
+	assert.Equal(t, false, typedNil.Equals(pub), "Typed nil receiver")
+	assert.Equal(t, false, new(blst.PublicKey).Equals(pub), "Unset receiver")
+	assert.Equal(t, true, pub.Equals(pub.Copy()))
+}
+
+func TestProofOfPossession(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	proof := priv.SignProofOfPossession()
+
+	assert.Equal(t, true, priv.PublicKey().VerifyProofOfPossession(proof))
+	assert.Equal(t, false, other.PublicKey().VerifyProofOfPossession(proof), "Proof of another key verified")
+	assert.Equal(t, false, priv.PublicKey().VerifyProofOfPossession(other.SignProofOfPossession()), "Proof from another key verified")
+	assert.Equal(t, false, priv.PublicKey().VerifyProofOfPossession(nil))
+
+	// The proof domain is separate from the signing domain.
+	sig := priv.Sign(priv.PublicKey().Marshal())
+	assert.NotEqual(t, sig.Marshal(), proof.Marshal())
+	assert.Equal(t, false, priv.PublicKey().VerifyProofOfPossession(sig), "Plain signature accepted as proof")
+	assert.Equal(t, false, proof.Verify(priv.PublicKey(), priv.PublicKey().Marshal()), "Proof accepted as plain signature")
+}
+
+func TestPublicKey_MarshalCompressed(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+
+	b := pub.MarshalCompressed()
+	require.Equal(t, common.BLSPubkeyLength, len(b))
+	assert.Equal(t, pub.Marshal(), b)
+	// The compression flag is set in the most significant bit of the first byte.
+	assert.Equal(t, byte(0x80), b[0]&0x80)
+
+	restored, err := blst.PublicKeyFromBytes(b)
+	require.NoError(t, err)
+	assert.Equal(t, true, pub.Equals(restored))
+}
+
+func TestPublicKey_MarshalInto(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	computed := priv.PublicKey().(*blst.PublicKey)
+	decoded, err := blst.PublicKeyFromBytes(computed.Marshal())
+	require.NoError(t, err)
+
+	for _, pub := range []*blst.PublicKey{computed, decoded.(*blst.PublicKey), decoded.Copy().(*blst.PublicKey)} {
+		dst := make([]byte, common.BLSPubkeyLength+2)
+		n, err := pub.MarshalInto(dst)
+		require.NoError(t, err)
+		assert.Equal(t, common.BLSPubkeyLength, n)
+		assert.Equal(t, computed.Marshal(), dst[:n])
+		assert.Equal(t, []byte{0, 0}, dst[n:], "MarshalInto wrote past the key")
+	}
+
+	_, err = computed.MarshalInto(make([]byte, common.BLSPubkeyLength-1))
+	assert.Error(t, err)
+
+	// Aggregating in place must not keep the encoding of the decoded key.
+	agg := decoded.Copy()
+	agg.Aggregate(computed)
+	dst := make([]byte, common.BLSPubkeyLength)
+	_, err = agg.(*blst.PublicKey).MarshalInto(dst)
+	require.NoError(t, err)
+	assert.Equal(t, computed.AggregateWith(computed).Marshal(), dst)
+	assert.Equal(t, agg.Marshal(), dst)
+}
+
+func TestPublicKey_MarshalUncompressed(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+
+	b := pub.MarshalUncompressed()
+	require.Equal(t, common.BLSPubkeyUncompressedLength, len(b))
+	assert.Equal(t, byte(0), b[0]&0x80, "Compression flag set on uncompressed encoding")
+	// The x coordinate is shared with the compressed encoding, less the flag bits.
+	compressed := pub.MarshalCompressed()
+	assert.Equal(t, compressed[0]&0x1f, b[0])
+	assert.Equal(t, compressed[1:], b[1:common.BLSPubkeyLength])
+
+	restored, err := blst.PublicKeyFromUncompressed(b)
+	require.NoError(t, err)
+	assert.Equal(t, true, pub.Equals(restored))
+	assert.Equal(t, b, restored.(*blst.PublicKey).MarshalUncompressed())
+	assert.Equal(t, compressed, restored.Marshal())
+}
+
+func TestPublicKeyFromUncompressed_Invalid(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	b := priv.PublicKey().(*blst.PublicKey).MarshalUncompressed()
+
+	_, err = blst.PublicKeyFromUncompressed(b[:common.BLSPubkeyLength])
+	assert.Error(t, err)
+	_, err = blst.PublicKeyFromUncompressed(append(b, 0x00))
+	assert.Error(t, err)
+
+	// Flipping a bit of y moves the point off the curve.
+	offCurve := append([]byte(nil), b...)
+	offCurve[len(offCurve)-1] ^= 0x01
+	compressed := append([]byte(nil), b...)
+	compressed[0] |= 0x80
+	infinite := make([]byte, common.BLSPubkeyUncompressedLength)
+	infinite[0] = 0x40
+	infiniteWithTail := append([]byte(nil), infinite...)
+	infiniteWithTail[95] = 0x01
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{name: "Short", input: b[:common.BLSPubkeyLength], err: common.ErrPubKeyLength},
+		{name: "Long", input: append(append([]byte(nil), b...), 0x00), err: common.ErrPubKeyLength},
+		{name: "Compressed", input: compressed, err: common.ErrPubKeyCompression},
+		{name: "InfinityWithTail", input: infiniteWithTail, err: common.ErrPubKeyCompression},
+		{name: "Infinity", input: infinite, err: common.ErrInfinitePubKey},
+		{name: "OffCurve", input: offCurve, err: common.ErrPubKeyNotOnCurve},
+		{name: "NotInSubgroup", input: curvePointNotInSubgroup(t), err: common.ErrPubKeyNotInSubgroup},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := blst.PublicKeyFromUncompressed(test.input)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.err), "got %v", err)
+		})
+	}
+}
+
+// curvePointNotInSubgroup returns the uncompressed encoding of a point of y^2 = x^3 + 4 outside
+// the G1 subgroup, the point with the smallest x, which is outside it but for a negligible chance.
+func curvePointNotInSubgroup(t *testing.T) []byte {
+	p, ok := new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	require.Equal(t, true, ok)
+	for x := big.NewInt(1); ; x.Add(x, big.NewInt(1)) {
+		rhs := new(big.Int).Exp(x, big.NewInt(3), p)
+		rhs.Add(rhs, big.NewInt(4)).Mod(rhs, p)
+		y := new(big.Int).ModSqrt(rhs, p)
+		if y == nil {
+			continue
+		}
+		b := make([]byte, common.BLSPubkeyUncompressedLength)
+		x.FillBytes(b[:common.BLSPubkeyLength])
+		y.FillBytes(b[common.BLSPubkeyLength:])
+		return b
+	}
+}
+
+func TestPublicKey_Hash(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+
+	assert.Equal(t, pub.Hash(), pub.Copy().(*blst.PublicKey).Hash(), "Hash changed across Copy")
+	restored, err := blst.PublicKeyFromBytes(pub.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, true, pub.Equals(restored))
+	assert.Equal(t, pub.Hash(), restored.(*blst.PublicKey).Hash())
+}
+
+func TestPublicKey_Hash_NoCollisions(t *testing.T) {
+	seen := make(map[[32]byte]*blst.PublicKey)
+	for i := 0; i < 2000; i++ {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		pub := priv.PublicKey().(*blst.PublicKey)
+		if prev, ok := seen[pub.Hash()]; ok {
+			// Equal hashes must only come from equal keys.
+			require.Equal(t, true, prev.Equals(pub), "Distinct keys collided")
+		}
+		seen[pub.Hash()] = pub
+	}
+	assert.Equal(t, 2000, len(seen))
+}
+
+func TestSetPublicKeyHasher(t *testing.T) {
+	defer blst.SetPublicKeyHasher(nil)
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+
+	keccak := sha3.NewLegacyKeccak256()
+	keccak.Write(pub.Marshal())
+	var want [32]byte
+	copy(want[:], keccak.Sum(nil))
+	assert.Equal(t, want, pub.Hash(), "Default hasher is not keccak256")
+
+	blst.SetPublicKeyHasher(sha256.Sum256)
+	assert.Equal(t, sha256.Sum256(pub.Marshal()), pub.Hash())
+	assert.NotEqual(t, want, pub.Hash())
+	assert.Equal(t, pub.Hash(), pub.Copy().(*blst.PublicKey).Hash())
+
+	blst.SetPublicKeyHasher(nil)
+	assert.Equal(t, want, pub.Hash(), "Nil did not restore the default hasher")
+}
+
+func TestPublicKeyFromBytes_LengthError(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+
+	for _, input := range [][]byte{nil, pub[:47], append(pub, 0x00)} {
+		_, err := blst.PublicKeyFromBytes(input)
+		assert.Equal(t, true, errors.Is(err, common.ErrPubKeyLength), "Unexpected error %v", err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("got %d", len(input)))
+
+		_, err = blst.PublicKeyFromBytesNoValidate(input)
+		assert.Equal(t, true, errors.Is(err, common.ErrPubKeyLength))
+		_, err = blst.PublicKeyFromUncompressed(input)
+		assert.Equal(t, true, errors.Is(err, common.ErrPubKeyLength))
+	}
+
+	// Other failures are not length errors.
+	_, err = blst.PublicKeyFromBytes(make([]byte, common.BLSPubkeyLength))
+	require.Error(t, err)
+	assert.Equal(t, false, errors.Is(err, common.ErrPubKeyLength))
+}
+
+func TestPublicKey_String(t *testing.T) {
+	pub, err := blst.PublicKeyFromHex("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c")
+	require.NoError(t, err)
+	assert.Equal(t, "0xa99a76ed...4a38e44c", pub.(*blst.PublicKey).String())
+	assert.Equal(t, "0xa99a76ed...4a38e44c", fmt.Sprintf("%v", pub))
+	assert.True(t, strings.HasPrefix(pub.(*blst.PublicKey).Hex(), "0xa99a76ed"))
+
+	var nilKey *blst.PublicKey
+	assert.Equal(t, "<nil pubkey>", nilKey.String())
+	assert.Equal(t, "<nil pubkey>", (&blst.PublicKey{}).String())
+	assert.Equal(t, "<nil pubkey>", fmt.Sprintf("%s", nilKey))
+}
+
+func TestMarshalPublicKeys_RoundTrip(t *testing.T) {
+	pubs := randPublicKeys(t, 1000)
+	buf := blst.MarshalPublicKeys(pubs)
+	require.Len(t, buf, 1000*common.BLSPubkeyLength)
+	for i, pub := range pubs {
+		require.Equal(t, pub.Marshal(), buf[i*common.BLSPubkeyLength:(i+1)*common.BLSPubkeyLength])
+	}
+
+	decoded, err := blst.UnmarshalPublicKeys(buf)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(pubs))
+	for i := range pubs {
+		assert.True(t, pubs[i].Equals(decoded[i]), "key %d does not round trip", i)
+	}
+}
+
+func TestMarshalPublicKeys_Empty(t *testing.T) {
+	assert.Empty(t, blst.MarshalPublicKeys(nil))
+	decoded, err := blst.UnmarshalPublicKeys(nil)
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+}
+
+func TestUnmarshalPublicKeys_Invalid(t *testing.T) {
+	buf := blst.MarshalPublicKeys(randPublicKeys(t, 3))
+
+	_, err := blst.UnmarshalPublicKeys(buf[:len(buf)-1])
+	assert.True(t, errors.Is(err, common.ErrPubKeyLength))
+
+	infinite := append([]byte{0xC0}, make([]byte, common.BLSPubkeyLength-1)...)
+	copy(buf[common.BLSPubkeyLength:], infinite)
+	_, err = blst.UnmarshalPublicKeys(buf)
+	assert.True(t, errors.Is(err, common.ErrInfinitePubKey))
+	assert.Contains(t, err.Error(), "index 1")
+}
+
+func TestSortPublicKeys(t *testing.T) {
+	pubs := randPublicKeys(t, 100)
+	// Duplicates must be kept, in their original relative order.
+	dup := pubs[7].Copy()
+	pubs = append(pubs, dup, pubs[3])
+
+	sorted := append([]common.PublicKey(nil), pubs...)
+	blst.SortPublicKeys(sorted)
+	require.Len(t, sorted, len(pubs))
+	for i := 1; i < len(sorted); i++ {
+		assert.True(t, bytes.Compare(sorted[i-1].Marshal(), sorted[i].Marshal()) <= 0, "keys %d and %d out of order", i-1, i)
+	}
+	for i, pub := range sorted {
+		if pub == dup {
+			require.True(t, sorted[i-1].Equals(dup))
+			assert.True(t, sorted[i-1] == pubs[7], "stable sort reordered equal keys")
+		}
+	}
+
+	// Any input order sorts to the same sequence.
+	reversed := make([]common.PublicKey, len(pubs))
+	for i, pub := range pubs {
+		reversed[len(pubs)-1-i] = pub
+	}
+	blst.SortPublicKeys(reversed)
+	for i := range sorted {
+		assert.True(t, sorted[i].Equals(reversed[i]))
+	}
+
+	// Sorting a sorted slice is a no-op.
+	again := append([]common.PublicKey(nil), sorted...)
+	blst.SortPublicKeys(again)
+	for i := range sorted {
+		assert.True(t, sorted[i] == again[i])
+	}
+}
+
+func TestSortPublicKeys_Empty(t *testing.T) {
+	blst.SortPublicKeys(nil)
+	keys := []common.PublicKey{}
+	blst.SortPublicKeys(keys)
+	assert.Empty(t, keys)
+}
+
+func TestPublicKey_VerifyAggregateConsistency(t *testing.T) {
+	parts := make([]common.PublicKey, 5)
+	for i := range parts {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		parts[i] = priv.PublicKey()
+	}
+	agg, err := blst.AggregateMultiplePubkeys(parts)
+	require.NoError(t, err)
+	aggKey := agg.(*blst.PublicKey)
+	assert.Equal(t, true, aggKey.VerifyAggregateConsistency(parts))
+
+	// Aggregation does not depend on the order of the parts.
+	reordered := append([]common.PublicKey{parts[4]}, parts[:4]...)
+	assert.Equal(t, true, aggKey.VerifyAggregateConsistency(reordered))
+
+	// Flipping one part to its negation is detected.
+	flipped := append([]common.PublicKey(nil), parts...)
+	flipped[2] = parts[2].(*blst.PublicKey).Neg()
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency(flipped))
+
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency(parts[:4]), "Missing part not detected")
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency(append(parts, parts[0])), "Duplicate part not detected")
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency(nil))
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency([]common.PublicKey{parts[0], nil}))
+	assert.Equal(t, false, aggKey.VerifyAggregateConsistency([]common.PublicKey{parts[0], &otherPublicKey{}}))
+
+	// The aggregate of no parts is the point at infinity.
+	infinite := parts[0].AggregateWith(parts[0].(*blst.PublicKey).Neg()).(*blst.PublicKey)
+	assert.Equal(t, true, infinite.VerifyAggregateConsistency(nil))
+	assert.Equal(t, true, infinite.VerifyAggregateConsistency([]common.PublicKey{}))
+}
+
+func TestDecodePublicKeysStream(t *testing.T) {
+	pubs := randPublicKeys(t, 100)
+	keys := make([]common.PublicKey, 10000)
+	for i := range keys {
+		keys[i] = pubs[i%len(pubs)]
+	}
+	buf := blst.MarshalPublicKeys(keys)
+
+	count := 0
+	err := blst.DecodePublicKeysStream(bytes.NewReader(buf), func(i int, pub common.PublicKey) error {
+		require.Equal(t, count, i)
+		require.True(t, keys[i].Equals(pub), "key %d does not round trip", i)
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, len(keys), count)
+
+	// An error returned by the callback aborts the stream and is returned unchanged.
+	errStop := errors.New("stop")
+	count = 0
+	err = blst.DecodePublicKeysStream(bytes.NewReader(buf), func(i int, _ common.PublicKey) error {
+		count++
+		if i == 4999 {
+			return errStop
+		}
+		return nil
+	})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, 5000, count)
+}
+
+func TestDecodePublicKeysStream_Invalid(t *testing.T) {
+	buf := blst.MarshalPublicKeys(randPublicKeys(t, 3))
+	noop := func(int, common.PublicKey) error { return nil }
+
+	require.NoError(t, blst.DecodePublicKeysStream(bytes.NewReader(nil), noop))
+
+	count := 0
+	err := blst.DecodePublicKeysStream(bytes.NewReader(buf[:len(buf)-1]), func(int, common.PublicKey) error {
+		count++
+		return nil
+	})
+	assert.Equal(t, true, errors.Is(err, common.ErrPubKeyLength))
+	assert.Equal(t, 2, count)
+
+	bad := append(append([]byte{}, buf...), make([]byte, common.BLSPubkeyLength)...)
+	err = blst.DecodePublicKeysStream(bytes.NewReader(bad), noop)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "public key at index 3")
 }
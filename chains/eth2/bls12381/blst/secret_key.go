@@ -5,42 +5,46 @@
 package blst
 
 import (
+	"bytes"
+	"crypto/rand"
 	"crypto/subtle"
 	"fmt"
 	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
-	"github.com/mapprotocol/atlas/chains/eth2/rand"
 
 	blst "github.com/supranational/blst/bindings/go"
 )
 
-const BLSSecretKeyLength = 32
-
 // bls12SecretKey used in the BLS signature scheme.
 type bls12SecretKey struct {
-	p *blst.SecretKey
+	p         *blst.SecretKey
+	destroyed bool
 }
 
-// RandKey creates a new private key using a random method provided as an io.Reader.
+// RandKey creates a new private key from 32 bytes of crypto/rand entropy, using it as the
+// input keying material of the blst key generation.
 func RandKey() (common2.SecretKey, error) {
 	// Generate 32 bytes of randomness
 	var ikm [32]byte
-	_, err := rand.NewGenerator().Read(ikm[:])
-	if err != nil {
-		return nil, err
+	if _, err := rand.Read(ikm[:]); err != nil {
+		return nil, fmt.Errorf("could not read random bytes: %w", err)
 	}
 	// Defensive check, that we have not generated a secret key,
-	secKey := &bls12SecretKey{blst.KeyGen(ikm[:])}
+	secKey := &bls12SecretKey{p: blst.KeyGen(ikm[:])}
 	if IsZero(secKey.Marshal()) {
 		return nil, common2.ErrZeroKey
 	}
 	return secKey, nil
 }
 
-// SecretKeyFromBytes creates a BLS private key from a BigEndian byte slice.
+// SecretKeyFromBytes creates a BLS private key from a BigEndian byte slice. The key must be
+// non-zero and less than the curve order.
 func SecretKeyFromBytes(privKey []byte) (common2.SecretKey, error) {
 	if len(privKey) != BLSSecretKeyLength {
 		return nil, fmt.Errorf("secret key must be %d bytes", BLSSecretKeyLength)
 	}
+	if bytes.Compare(privKey, curveOrder[:]) >= 0 {
+		return nil, fmt.Errorf("%w: secret key must be less than the curve order", common2.ErrSecretUnmarshal)
+	}
 	secKey := new(blst.SecretKey).Deserialize(privKey)
 	if secKey == nil {
 		return nil, common2.ErrSecretUnmarshal
@@ -54,18 +58,10 @@ func SecretKeyFromBytes(privKey []byte) (common2.SecretKey, error) {
 
 // PublicKey obtains the public key corresponding to the BLS secret key.
 func (s *bls12SecretKey) PublicKey() common2.PublicKey {
+	s.checkNotDestroyed()
 	return &PublicKey{p: new(blstPublicKey).From(s.p)}
 }
 
-// IsZero checks if the secret key is a zero key.
-func IsZero(sKey []byte) bool {
-	b := byte(0)
-	for _, s := range sKey {
-		b |= s
-	}
-	return subtle.ConstantTimeByteEq(b, 0) == 1
-}
-
 // Sign a message using a secret key - in a beacon/validator client.
 //
 // In IETF draft BLS specification:
@@ -75,12 +71,51 @@ func IsZero(sKey []byte) bool {
 // In Ethereum proof of stake specification:
 // def Sign(SK: int, message: Bytes) -> BLSSignature
 func (s *bls12SecretKey) Sign(msg []byte) common2.Signature {
+	s.checkNotDestroyed()
 	signature := new(blstSignature).Sign(s.p, msg, dst)
 	return &Signature{s: signature}
 }
 
-// Marshal a secret key into a LittleEndian byte slice.
+// SignProofOfPossession signs the compressed public key under the proof of possession domain.
+//
+// In IETF draft BLS specification:
+// PopProve(SK) -> proof: an algorithm that generates a proof of
+//      possession for the public key corresponding to secret key SK.
+func (s *bls12SecretKey) SignProofOfPossession() common2.Signature {
+	s.checkNotDestroyed()
+	proof := new(blstSignature).Sign(s.p, s.PublicKey().Marshal(), popDst)
+	return &Signature{s: proof}
+}
+
+// Marshal a secret key into a big-endian byte slice. A destroyed key marshals to nil.
 func (s *bls12SecretKey) Marshal() []byte {
+	if s.destroyed {
+		return nil
+	}
 	keyBytes := s.p.Serialize()
 	return keyBytes
 }
+
+// Equals checks if the provided secret key is equal to the current one. The scalars are
+// compared in constant time so that comparing keys does not leak their contents through
+// timing.
+func (s *bls12SecretKey) Equals(s2 common2.SecretKey) bool {
+	other, ok := s2.(*bls12SecretKey)
+	if !ok || s == nil || other == nil || s.p == nil || other.p == nil || s.destroyed || other.destroyed {
+		return false
+	}
+	return subtle.ConstantTimeCompare(s.p.Serialize(), other.p.Serialize()) == 1
+}
+
+// Copy the secret key to a new pointer reference.
+func (s *bls12SecretKey) Copy() common2.SecretKey {
+	np := *s.p
+	return &bls12SecretKey{p: &np, destroyed: s.destroyed}
+}
+
+// Destroy overwrites the secret scalar with zeros. Any later use of the key to derive a
+// public key or sign panics, and Marshal returns nil.
+func (s *bls12SecretKey) Destroy() {
+	s.p.Zeroize()
+	s.destroyed = true
+}
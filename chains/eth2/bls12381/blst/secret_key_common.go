@@ -0,0 +1,100 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+
+	common2 "github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+const BLSSecretKeyLength = 32
+
+// MinSeedLength is the minimum length of an EIP-2333 seed.
+const MinSeedLength = 32
+
+// curveOrder is the order r of the BLS12-381 groups, big-endian. Valid secret keys lie in [1, r).
+var curveOrder = [BLSSecretKeyLength]byte{
+	0x73, 0xed, 0xa7, 0x53, 0x29, 0x9d, 0x7d, 0x48, 0x33, 0x39, 0xd8, 0x08, 0x09, 0xa1, 0xd8, 0x05,
+	0x53, 0xbd, 0xa4, 0x02, 0xff, 0xfe, 0x5b, 0xfe, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x01,
+}
+
+// errDestroyedSecretKey is the panic value of using a secret key after Destroy.
+const errDestroyedSecretKey = "bls: use of destroyed secret key"
+
+// SecretKeyFromSeed deterministically derives a secret key from seed with the EIP-2333 master
+// key derivation, so that tests can recreate the same keys from the same seed. Seeds shorter
+// than MinSeedLength are hashed with sha256 first; longer ones yield the same key as
+// DeriveMasterSK.
+//
+// It is meant for tests and fixtures only. The seed is used as the only source of entropy, so
+// keys from guessable seeds are insecure: use RandKey or DeriveMasterSK with a properly random
+// seed for real keys.
+func SecretKeyFromSeed(seed []byte) (common2.SecretKey, error) {
+	if len(seed) == 0 {
+		return nil, errors.New("seed must not be empty")
+	}
+	if len(seed) < MinSeedLength {
+		h := sha256.Sum256(seed)
+		seed = h[:]
+	}
+	return DeriveMasterSK(seed)
+}
+
+// IsZero checks if the secret key is a zero key.
+func IsZero(sKey []byte) bool {
+	b := byte(0)
+	for _, s := range sKey {
+		b |= s
+	}
+	return subtle.ConstantTimeByteEq(b, 0) == 1
+}
+
+func (s *bls12SecretKey) checkNotDestroyed() {
+	if s.destroyed {
+		panic(errDestroyedSecretKey)
+	}
+}
+
+// reduceScalar reduces the big-endian integer scalar mod r.
+func reduceScalar(scalar []byte) [BLSSecretKeyLength]byte {
+	r := new(big.Int).SetBytes(curveOrder[:])
+	k := new(big.Int).SetBytes(scalar)
+	k.Mod(k, r)
+	var out [BLSSecretKeyLength]byte
+	k.FillBytes(out[:])
+	return out
+}
+
+// AggregateSecretKeys adds the scalars of keys mod r, as when the shares of a distributed key
+// generation are summed into a single secret key. The public key of the sum is the aggregate of
+// the public keys of keys. An error is returned for empty input, a nil or destroyed key, and for
+// shares that sum to zero, which is not a valid secret key.
+func AggregateSecretKeys(keys []common2.SecretKey) (common2.SecretKey, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("nil or empty secret keys")
+	}
+	r := new(big.Int).SetBytes(curveOrder[:])
+	sum := new(big.Int)
+	for i, key := range keys {
+		if key == nil {
+			return nil, fmt.Errorf("nil secret key at index %d", i)
+		}
+		b := key.Marshal()
+		if b == nil {
+			return nil, fmt.Errorf("destroyed secret key at index %d", i)
+		}
+		sum.Add(sum, new(big.Int).SetBytes(b)).Mod(sum, r)
+	}
+	if sum.Sign() == 0 {
+		return nil, fmt.Errorf("aggregate of %d secret keys: %w", len(keys), common2.ErrZeroKey)
+	}
+	var buf [BLSSecretKeyLength]byte
+	sum.FillBytes(buf[:])
+	return SecretKeyFromBytes(buf[:])
+}
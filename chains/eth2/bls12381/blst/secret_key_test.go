@@ -1,6 +1,5 @@
-//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
 // +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
-// +build !blst_disabled
 
 package blst_test
 
@@ -12,6 +11,7 @@ import (
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"math/big"
 	"testing"
 )
 
@@ -57,6 +57,25 @@ func TestSecretKeyFromBytes(t *testing.T) {
 			input: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			err:   common.ErrSecretUnmarshal,
 		},
+		{
+			name:  "Zero",
+			input: make([]byte, 32),
+			err:   common.ErrSecretUnmarshal,
+		},
+		{
+			name:  "CurveOrder",
+			input: []byte{0x73, 0xed, 0xa7, 0x53, 0x29, 0x9d, 0x7d, 0x48, 0x33, 0x39, 0xd8, 0x08, 0x09, 0xa1, 0xd8, 0x05, 0x53, 0xbd, 0xa4, 0x02, 0xff, 0xfe, 0x5b, 0xfe, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x01},
+			err:   errors.New("secret key must be less than the curve order"),
+		},
+		{
+			name:  "CurveOrderPlusOne",
+			input: []byte{0x73, 0xed, 0xa7, 0x53, 0x29, 0x9d, 0x7d, 0x48, 0x33, 0x39, 0xd8, 0x08, 0x09, 0xa1, 0xd8, 0x05, 0x53, 0xbd, 0xa4, 0x02, 0xff, 0xfe, 0x5b, 0xfe, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x02},
+			err:   errors.New("secret key must be less than the curve order"),
+		},
+		{
+			name:  "CurveOrderMinusOne",
+			input: []byte{0x73, 0xed, 0xa7, 0x53, 0x29, 0x9d, 0x7d, 0x48, 0x33, 0x39, 0xd8, 0x08, 0x09, 0xa1, 0xd8, 0x05, 0x53, 0xbd, 0xa4, 0x02, 0xff, 0xfe, 0x5b, 0xfe, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00},
+		},
 		{
 			name:  "Good",
 			input: []byte{0x25, 0x29, 0x5f, 0x0d, 0x1d, 0x59, 0x2a, 0x90, 0xb3, 0x33, 0xe2, 0x6e, 0x85, 0x14, 0x97, 0x08, 0x20, 0x8e, 0x9f, 0x8e, 0x8b, 0xc1, 0x8f, 0x6c, 0x77, 0xbd, 0x62, 0xf8, 0xad, 0x7a, 0x68, 0x66},
@@ -68,7 +87,7 @@ func TestSecretKeyFromBytes(t *testing.T) {
 			res, err := blst.SecretKeyFromBytes(test.input)
 			if test.err != nil {
 				assert.NotEqual(t, nil, err, "No error returned")
-				assert.ErrorContains(t, err, test.err.Error(), "Unexpected error returned")
+				assert.Contains(t, err.Error(), test.err.Error(), "Unexpected error returned")
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, 0, bytes.Compare(res.Marshal(), test.input))
@@ -105,3 +124,126 @@ func ToBytes32(x []byte) [32]byte {
 	copy(y[:], x)
 	return y
 }
+
+func TestRandKey_Distinct(t *testing.T) {
+	seen := make(map[[32]byte]bool)
+	for i := 0; i < 1000; i++ {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		b := ToBytes32(priv.Marshal())
+		require.Equal(t, false, blst.IsZero(b[:]), "Generated a zero key")
+		require.Equal(t, false, seen[b], "Generated a duplicate key")
+		seen[b] = true
+
+		// PublicKeyFromBytes runs KeyValidate on keys it has not seen before.
+		_, err = blst.PublicKeyFromBytes(priv.PublicKey().Marshal())
+		require.NoError(t, err)
+	}
+}
+
+func TestSecretKey_SignRoundTrip(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := []byte("sign me")
+
+	restored, err := blst.SecretKeyFromBytes(priv.Marshal())
+	require.NoError(t, err)
+	sig := restored.Sign(msg)
+	assert.Equal(t, true, sig.Verify(priv.PublicKey(), msg), "Signature did not verify")
+	assert.Equal(t, priv.Sign(msg).Marshal(), sig.Marshal(), "Signing is not deterministic")
+	assert.Equal(t, false, sig.Verify(priv.PublicKey(), []byte("other msg")))
+}
+
+func TestSecretKey_PublicKeyMatchesSigner(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := []byte("sign me")
+	sig := priv.Sign(msg)
+
+	assert.Equal(t, true, sig.Verify(priv.PublicKey(), msg))
+	assert.Equal(t, false, sig.Verify(other.PublicKey(), msg), "Signature verified under the wrong key")
+	assert.Equal(t, true, priv.PublicKey().Equals(priv.PublicKey()), "PublicKey is not deterministic")
+}
+
+func TestSecretKey_Copy(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	cp := priv.Copy()
+	assert.NotSame(t, priv, cp)
+	assert.Equal(t, priv.Marshal(), cp.Marshal())
+	assert.Equal(t, true, priv.PublicKey().Equals(cp.PublicKey()))
+}
+
+func TestSecretKey_Equals(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	restored, err := blst.SecretKeyFromBytes(priv.Marshal())
+	require.NoError(t, err)
+
+	assert.Equal(t, true, priv.Equals(priv))
+	assert.Equal(t, true, priv.Equals(restored))
+	assert.Equal(t, true, priv.Equals(priv.Copy()))
+	assert.Equal(t, false, priv.Equals(other))
+	assert.Equal(t, false, priv.Equals(nil))
+}
+
+func TestAggregateSecretKeys(t *testing.T) {
+	msg := []byte("hello")
+	for _, n := range []int{1, 2, 10} {
+		keys := make([]common.SecretKey, n)
+		pubs := make([]common.PublicKey, n)
+		sigs := make([]common.Signature, n)
+		for i := range keys {
+			priv, err := blst.RandKey()
+			require.NoError(t, err)
+			keys[i], pubs[i], sigs[i] = priv, priv.PublicKey(), priv.Sign(msg)
+		}
+		aggKey, err := blst.AggregateSecretKeys(keys)
+		require.NoError(t, err)
+
+		// Aggregating the secret keys commutes with deriving their public keys and signing.
+		aggPub, err := blst.AggregateMultiplePubkeys(pubs)
+		require.NoError(t, err)
+		assert.Equal(t, true, aggKey.PublicKey().Equals(aggPub), "Aggregate of %d secret keys does not match its public key", n)
+		aggSig, err := blst.AggregateSignatures(sigs)
+		require.NoError(t, err)
+		assert.Equal(t, aggSig.Marshal(), aggKey.Sign(msg).Marshal())
+	}
+}
+
+func TestAggregateSecretKeys_ReducesModR(t *testing.T) {
+	r, ok := new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	require.Equal(t, true, ok)
+	var buf [32]byte
+	// r-1 and r-1 sum to r-2.
+	new(big.Int).Sub(r, big.NewInt(1)).FillBytes(buf[:])
+	maxKey, err := blst.SecretKeyFromBytes(buf[:])
+	require.NoError(t, err)
+	sum, err := blst.AggregateSecretKeys([]common.SecretKey{maxKey, maxKey})
+	require.NoError(t, err)
+	new(big.Int).Sub(r, big.NewInt(2)).FillBytes(buf[:])
+	assert.Equal(t, buf[:], sum.Marshal())
+
+	// A key and its negation sum to zero.
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	new(big.Int).Sub(r, new(big.Int).SetBytes(priv.Marshal())).FillBytes(buf[:])
+	neg, err := blst.SecretKeyFromBytes(buf[:])
+	require.NoError(t, err)
+	_, err = blst.AggregateSecretKeys([]common.SecretKey{priv, neg})
+	assert.Equal(t, true, errors.Is(err, common.ErrZeroKey))
+}
+
+func TestAggregateSecretKeys_Invalid(t *testing.T) {
+	_, err := blst.AggregateSecretKeys(nil)
+	assert.Error(t, err)
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	_, err = blst.AggregateSecretKeys([]common.SecretKey{priv, nil})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nil secret key at index 1")
+}
@@ -6,6 +6,7 @@ package blst
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
 	"github.com/mapprotocol/atlas/chains/eth2/rand"
@@ -14,21 +15,23 @@ import (
 	"sync"
 )
 
-var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
-
 const scalarBytes = 32
 const randBitsEntropy = 64
-const BLSSignatureLength = 96
 
 // Signature used in the BLS signature scheme.
 type Signature struct {
 	s *blstSignature
 }
 
-// SignatureFromBytes creates a BLS signature from a LittleEndian byte slice.
+// SignatureFromBytes creates a BLS signature from its compressed, big-endian encoding.
 func SignatureFromBytes(sig []byte) (common.Signature, error) {
 	if len(sig) != BLSSignatureLength {
-		return nil, fmt.Errorf("signature must be %d bytes", BLSSignatureLength)
+		return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(sig))
+	}
+	var cacheKey [BLSSignatureLength]byte
+	copy(cacheKey[:], sig)
+	if cv, ok := cachedSignature(cacheKey); ok {
+		return cv.Copy(), nil
 	}
 	signature := new(blstSignature).Uncompress(sig)
 	if signature == nil {
@@ -39,12 +42,36 @@ func SignatureFromBytes(sig []byte) (common.Signature, error) {
 	if !signature.SigValidate(false) {
 		return nil, errors.New("signature not in group")
 	}
+	sigObj := &Signature{s: signature}
+	cacheSignature(cacheKey, sigObj.Copy().(*Signature))
+	return sigObj, nil
+}
+
+// SignatureFromBytesNoValidate creates a BLS signature from its compressed, big-endian encoding
+// without the subgroup check performed by SignatureFromBytes. Only the length and the on-curve
+// decompression are checked.
+//
+// A signature outside the G2 subgroup can make verification and aggregation unsound, so this
+// must only be used for signatures that were group checked before they were stored, such as
+// round-trips through our own database. Never use it on signatures received from peers.
+func SignatureFromBytesNoValidate(sig []byte) (common.Signature, error) {
+	if len(sig) != BLSSignatureLength {
+		return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(sig))
+	}
+	signature := new(blstSignature).Uncompress(sig)
+	if signature == nil {
+		return nil, errors.New("could not unmarshal bytes into signature")
+	}
 	return &Signature{s: signature}, nil
 }
 
 // AggregateCompressedSignatures converts a list of compressed signatures into a single, aggregated sig.
 func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error) {
+	if len(multiSigs) == 0 {
+		return nil, errors.New("nil or empty signatures")
+	}
 	signature := new(blstAggregateSignature)
+	// Each signature is group checked once while it is decompressed.
 	valid := signature.AggregateCompressed(multiSigs, true)
 	if !valid {
 		return nil, errors.New("provided signatures fail the group check and cannot be compressed")
@@ -52,14 +79,14 @@ func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error)
 	return &Signature{s: signature.ToAffine()}, nil
 }
 
-// MultipleSignaturesFromBytes creates a group of BLS signatures from a LittleEndian 2d-byte slice.
+// MultipleSignaturesFromBytes creates a group of BLS signatures from a list of compressed, big-endian encodings.
 func MultipleSignaturesFromBytes(multiSigs [][]byte) ([]common.Signature, error) {
 	if len(multiSigs) == 0 {
 		return nil, fmt.Errorf("0 signatures provided to the method")
 	}
 	for _, s := range multiSigs {
 		if len(s) != BLSSignatureLength {
-			return nil, fmt.Errorf("signature must be %d bytes", BLSSignatureLength)
+			return nil, fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(s))
 		}
 	}
 	multiSignatures := new(blstSignature).BatchUncompress(multiSigs)
@@ -91,9 +118,27 @@ func MultipleSignaturesFromBytes(multiSigs [][]byte) ([]common.Signature, error)
 //
 // In the Ethereum proof of stake specification:
 // def Verify(PK: BLSPubkey, message: Bytes, signature: BLSSignature) -> bool
-func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) bool {
+//
+// An infinite public key or signature never verifies.
+func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, false) }()
+	pub, ok := pubKey.(*PublicKey)
+	if !ok || pub == nil || pub.p == nil || s == nil || s.s == nil {
+		return false
+	}
+	if pub.IsInfinite() || s.IsInfinite() {
+		return false
+	}
 	// Signature and PKs are assumed to have been validated upon decompression!
-	return s.s.Verify(false, pubKey.(*PublicKey).p, false, msg, dst)
+	return s.s.Verify(false, pub.p, false, msg, dst)
+}
+
+// VerifyHashed verifies the signature over root, a message that has already been hashed down to
+// 32 bytes, typically an eth2 signing root. root is passed to hash-to-curve as is and is not
+// hashed again, so it must be the exact hash-to-curve input that was signed, not the plain
+// message or object it was derived from. It is equivalent to Verify(pubKey, root[:]).
+func (s *Signature) VerifyHashed(pubKey common.PublicKey, root [32]byte) bool {
+	return s.Verify(pubKey, root[:])
 }
 
 // AggregateVerify verifies each public key against its respective message. This is vulnerable to
@@ -112,13 +157,15 @@ func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) bool {
 // In the Ethereum proof of stake specification:
 // def AggregateVerify(pairs: Sequence[PK: BLSPubkey, message: Bytes], signature: BLSSignature) -> bool
 //
+// An infinite signature never verifies.
+//
 // Deprecated: Use FastAggregateVerify or use this method in spectests only.
 func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte) bool {
 	size := len(pubKeys)
 	if size == 0 {
 		return false
 	}
-	if size != len(msgs) {
+	if size != len(msgs) || s.IsInfinite() {
 		return false
 	}
 	msgSlices := make([][]byte, len(msgs))
@@ -132,6 +179,7 @@ func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte)
 }
 
 // FastAggregateVerify verifies all the provided public keys with their aggregated signature.
+// Results are cached while the verification cache is enabled, see SetVerificationCacheSize.
 //
 // In IETF draft BLS specification:
 // FastAggregateVerify(PK_1, ..., PK_n, message, signature) -> VALID
@@ -141,33 +189,96 @@ func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte)
 //
 // In the Ethereum proof of stake specification:
 // def FastAggregateVerify(PKs: Sequence[BLSPubkey], message: Bytes, signature: BLSSignature) -> bool
-func (s *Signature) FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) bool {
-	if len(pubKeys) == 0 {
+//
+// An infinite signature never verifies, use Eth2FastAggregateVerify for the empty committee case
+// of the spec.
+func (s *Signature) FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, true) }()
+	if len(pubKeys) == 0 || s.IsInfinite() {
 		return false
 	}
 	rawKeys := make([]*blstPublicKey, len(pubKeys))
 	for i := 0; i < len(pubKeys); i++ {
 		rawKeys[i] = pubKeys[i].(*PublicKey).p
 	}
-	return s.s.FastAggregateVerify(true, rawKeys, msg[:], dst)
+	return cachedFastAggregateVerify(pubKeys, msg, s, func() bool {
+		return s.s.FastAggregateVerify(true, rawKeys, msg[:], dst)
+	})
+}
+
+// FastAggregateVerifyAggregated verifies the signature over a single message against an
+// aggregate public key that was computed up front, such as a stored committee aggregate. It is
+// equivalent to FastAggregateVerify with the keys that aggregated to aggPub, and an aggregate
+// key at infinity never verifies.
+func (s *Signature) FastAggregateVerifyAggregated(aggPub common.PublicKey, msg [32]byte) (valid bool) {
+	defer func() { recordVerifications(1, valid, true) }()
+	pub, ok := aggPub.(*PublicKey)
+	if !ok || pub == nil || pub.p == nil || s == nil || s.s == nil || pub.IsInfinite() || s.IsInfinite() {
+		return false
+	}
+	return s.s.Verify(true, pub.p, false, msg[:], dst)
 }
 
-// Eth2FastAggregateVerify implements a wrapper on top of bls's FastAggregateVerify. It accepts G2_POINT_AT_INFINITY signature
-// when pubkeys empty.
+// FastAggregateVerify verifies sig against the aggregate of the provided public keys over a
+// single message. The keys are aggregated internally by blst, so callers do not need to build
+// the aggregate public key with AggregatePublicKeys first.
 //
-// Spec code:
-// def eth2_fast_aggregate_verify(pubkeys: Sequence[BLSPubkey], message: Bytes32, signature: BLSSignature) -> bool:
-//    """
-//    Wrapper to ``bls.FastAggregateVerify`` accepting the ``G2_POINT_AT_INFINITY`` signature when ``pubkeys`` is empty.
-//    """
-//    if len(pubkeys) == 0 and signature == G2_POINT_AT_INFINITY:
-//        return True
-//    return bls.FastAggregateVerify(pubkeys, message, signature)
-func (s *Signature) Eth2FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) bool {
-	if len(pubKeys) == 0 && bytes.Equal(s.Marshal(), common.InfiniteSignature[:]) {
-		return true
-	}
-	return s.FastAggregateVerify(pubKeys, msg)
+// False is returned if no public keys are provided, if the signature is infinite or if the keys
+// aggregate to the point at infinity, since an infinite aggregate key would accept a trivially
+// forged signature. Results are cached like those of the method. A nil key or signature, or one
+// of another backend, is false as well.
+func FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte, sig common.Signature) (valid bool) {
+	defer func() { recordVerifications(1, valid, true) }()
+	s, ok := sig.(*Signature)
+	if len(pubKeys) == 0 || !ok || s == nil || s.s == nil || s.IsInfinite() {
+		return false
+	}
+	rawKeys := make([]*blstPublicKey, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		pub, ok := pubKeys[i].(*PublicKey)
+		if !ok || pub == nil || pub.p == nil {
+			return false
+		}
+		rawKeys[i] = pub.p
+	}
+	// blst rejects an aggregate key at infinity when adding it to the pairing.
+	return cachedFastAggregateVerify(pubKeys, msg, s, func() bool {
+		return s.s.FastAggregateVerify(true, rawKeys, msg[:], dst)
+	})
+}
+
+// AggregateVerify verifies sig as the aggregate of signatures where each public key signed its
+// respective message. Unlike the method of the same name, this rejects inputs where any
+// (public key, message) pair appears more than once.
+//
+// Only the domain separation tag of the proof-of-possession ciphersuite is applied to the
+// messages, so callers are expected to have mixed any protocol domain into msgs already (e.g. by
+// passing signing roots) and every public key must have a verified proof of possession. An
+// infinite signature never verifies.
+func AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte, sig common.Signature) bool {
+	size := len(pubKeys)
+	if size == 0 || size != len(msgs) || sig == nil || sig.IsInfinite() {
+		return false
+	}
+	type pair struct {
+		pub [common.BLSPubkeyLength]byte
+		msg [32]byte
+	}
+	seen := make(map[pair]struct{}, size)
+	msgSlices := make([][]byte, size)
+	rawKeys := make([]*blstPublicKey, size)
+	for i := 0; i < size; i++ {
+		key := pair{msg: msgs[i]}
+		copy(key.pub[:], pubKeys[i].Marshal())
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+		msgSlices[i] = msgs[i][:]
+		rawKeys[i] = pubKeys[i].(*PublicKey).p
+	}
+	// Public keys are assumed to have been validated upon decompression!
+	return sig.(*Signature).s.AggregateVerify(true, rawKeys, false, msgSlices, dst)
 }
 
 // NewAggregateSignature creates a blank aggregate signature.
@@ -177,20 +288,34 @@ func NewAggregateSignature() common.Signature {
 }
 
 // AggregateSignatures converts a list of signatures into a single, aggregated sig.
-func AggregateSignatures(sigs []common.Signature) common.Signature {
+func AggregateSignatures(sigs []common.Signature) (common.Signature, error) {
 	if len(sigs) == 0 {
-		return nil
+		return nil, errors.New("nil or empty signatures")
 	}
 
 	rawSigs := make([]*blstSignature, len(sigs))
 	for i := 0; i < len(sigs); i++ {
-		rawSigs[i] = sigs[i].(*Signature).s
+		sig, ok := sigs[i].(*Signature)
+		if !ok || sig == nil || sig.s == nil {
+			return nil, errors.Errorf("signature at index %d is not a valid blst signature", i)
+		}
+		rawSigs[i] = sig.s
 	}
 
 	// Signature and PKs are assumed to have been validated upon decompression!
 	signature := new(blstAggregateSignature)
 	signature.Aggregate(rawSigs, false)
-	return &Signature{s: signature.ToAffine()}
+	return &Signature{s: signature.ToAffine()}, nil
+}
+
+// HashToG2 hashes msg to a point of G2 under the caller's domain separation tag, following the
+// BLS12381G2_XMD:SHA-256_SSWU_RO_ suite of RFC 9380. G2 points are represented as signatures in
+// this package, so the point is returned as one. An empty tag is rejected.
+func HashToG2(msg, dst []byte) (common.Signature, error) {
+	if len(dst) == 0 {
+		return nil, errors.New("domain separation tag must not be empty")
+	}
+	return &Signature{s: blst.HashToG2(msg, dst).ToAffine()}, nil
 }
 
 // VerifyMultipleSignatures verifies a non-singular set of signatures and its respective pubkeys and messages.
@@ -201,23 +326,72 @@ func AggregateSignatures(sigs []common.Signature) common.Signature {
 // e(S*, G) = \prod_{i=1}^n \prod_{j=1}^{m_i} e(P'_{i,j}, M_{i,j})
 // Using this we can verify multiple signatures safely.
 func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
-	if len(sigs) == 0 || len(pubKeys) == 0 {
-		return false, nil
+	rawSigs, mulP1Aff, rawMsgs, err := prepareMultipleSignatures(sigs, msgs, pubKeys)
+	if err != nil || len(rawSigs) == 0 {
+		return false, err
 	}
-	rawSigs := new(blstSignature).BatchUncompress(sigs)
+	return multipleAggregateVerify(rawSigs, mulP1Aff, rawMsgs), nil
+}
+
+// VerifyMultipleSignaturesContext is VerifyMultipleSignatures split into chunks of
+// BatchVerifyChunkSize signatures, each verified as its own random linear combination. The
+// context is checked before every chunk, and its error is returned as soon as it is done.
+func VerifyMultipleSignaturesContext(ctx context.Context, sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	rawSigs, mulP1Aff, rawMsgs, err := prepareMultipleSignatures(sigs, msgs, pubKeys)
+	if err != nil || len(rawSigs) == 0 {
+		return false, err
+	}
+	chunk := BatchVerifyChunkSize()
+	for i := 0; i < len(rawSigs); i += chunk {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		j := i + chunk
+		if j > len(rawSigs) {
+			j = len(rawSigs)
+		}
+		if !multipleAggregateVerify(rawSigs[i:j], mulP1Aff[i:j], rawMsgs[i:j]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
 
+// prepareMultipleSignatures checks the inputs of a batch verification and decompresses the
+// signatures. The returned slices are empty if there is nothing to verify.
+func prepareMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) ([]*blstSignature, []*blstPublicKey, []blst.Message, error) {
 	length := len(sigs)
 	if length != len(pubKeys) || length != len(msgs) {
-		return false, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+		return nil, nil, nil, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
 			length, len(pubKeys), len(msgs))
 	}
+	if length == 0 {
+		return nil, nil, nil, nil
+	}
 	mulP1Aff := make([]*blstPublicKey, length)
 	rawMsgs := make([]blst.Message, length)
 
 	for i := 0; i < length; i++ {
-		mulP1Aff[i] = pubKeys[i].(*PublicKey).p
+		pub, ok := pubKeys[i].(*PublicKey)
+		if !ok || pub == nil || pub.p == nil {
+			return nil, nil, nil, errors.Errorf("public key at index %d is not a valid blst public key", i)
+		}
+		mulP1Aff[i] = pub.p
 		rawMsgs[i] = msgs[i][:]
 	}
+	rawSigs := new(blstSignature).BatchUncompress(sigs)
+	if len(rawSigs) != length {
+		return nil, nil, nil, errors.New("could not unmarshal bytes into signature")
+	}
+	return rawSigs, mulP1Aff, rawMsgs, nil
+}
+
+// multipleAggregateVerify verifies the signatures against their public keys and messages as a
+// random linear combination.
+func multipleAggregateVerify(rawSigs []*blstSignature, mulP1Aff []*blstPublicKey, rawMsgs []blst.Message) bool {
 	// Secure source of RNG
 	randGen := rand.NewGenerator()
 	randLock := new(sync.Mutex)
@@ -235,23 +409,79 @@ func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.P
 	dummySig := new(blstSignature)
 
 	// Validate signatures since we uncompress them here. Public keys should already be validated.
-	return dummySig.MultipleAggregateVerify(rawSigs, true, mulP1Aff, false, rawMsgs, dst, randFunc, randBitsEntropy), nil
+	valid := dummySig.MultipleAggregateVerify(rawSigs, true, mulP1Aff, false, rawMsgs, dst, randFunc, randBitsEntropy)
+	recordVerifications(len(rawSigs), valid, true)
+	return valid
 }
 
-// Marshal a signature into a LittleEndian byte slice.
+// Marshal a signature into its 96 byte compressed, big-endian encoding.
 func (s *Signature) Marshal() []byte {
 	return s.s.Compress()
 }
 
+// IsInfinite checks if the signature is the point at infinity, the signature of any message
+// under the infinite public key. It never verifies, except for the empty committee case of
+// Eth2FastAggregateVerify.
+func (s *Signature) IsInfinite() bool {
+	return bytes.Equal(s.s.Compress(), common.InfiniteSignature[:])
+}
+
 // Copy returns a full deep copy of a signature.
 func (s *Signature) Copy() common.Signature {
 	sign := *s.s
 	return &Signature{s: &sign}
 }
 
-// VerifyCompressed verifies that the compressed signature and pubkey
-// are valid from the message provided.
-func VerifyCompressed(signature, pub, msg []byte) bool {
-	// Validate signature and PKs since we will uncompress them here
-	return new(blstSignature).VerifyCompressed(signature, true, pub, true, msg, dst)
+// AggregateWith returns the aggregate of the two signatures as a new signature, leaving both
+// operands untouched.
+func (s *Signature) AggregateWith(s2 common.Signature) common.Signature {
+	agg := new(blstAggregateSignature)
+	// No group check here since it is checked at decompression time
+	agg.Aggregate([]*blstSignature{s.s, s2.(*Signature).s}, false)
+	return &Signature{s: agg.ToAffine()}
+}
+
+// Subtract returns the signature minus other, the aggregate of the signature and the negation of
+// other, as a new signature, leaving both operands untouched. Subtracting a signature that was
+// aggregated before undoes the aggregation. Subtracting one that never was is well defined, the
+// group has no invalid points, but the result is not the signature of anything meaningful.
+func (s *Signature) Subtract(other common.Signature) common.Signature {
+	agg := new(blstAggregateSignature)
+	// No group check here since it is checked at decompression time
+	agg.Aggregate([]*blstSignature{s.s, negateSignature(other.(*Signature).s)}, false)
+	return &Signature{s: agg.ToAffine()}
+}
+
+// negateSignature returns -p. Negating a point only flips the sign of its y coordinate, which
+// is the sign flag of the compressed encoding, so p is negated by flipping that flag. The
+// infinity point is its own negation.
+func negateSignature(p *blstSignature) *blstSignature {
+	enc := p.Compress()
+	if bytes.Equal(enc, common.InfiniteSignature[:]) {
+		neg := *p
+		return &neg
+	}
+	enc[0] ^= 0x20
+	return new(blstSignature).Uncompress(enc)
+}
+
+// combineSignatures returns the sum of scalars[i]*sigs[i], with the scalars given as big-endian
+// integers below the curve order.
+func combineSignatures(sigs []*Signature, scalars [][BLSSecretKeyLength]byte) *Signature {
+	var sum blst.P2
+	for i := range sigs {
+		var term blst.P2
+		term.FromAffine(sigs[i].s)
+		sum.AddAssign(term.MultAssign(littleEndianScalar(scalars[i]), 255))
+	}
+	return &Signature{s: sum.ToAffine()}
+}
+
+// littleEndianScalar converts a big-endian scalar to the little-endian order blst multiplies by.
+func littleEndianScalar(k [BLSSecretKeyLength]byte) []byte {
+	le := make([]byte, len(k))
+	for i := range k {
+		le[i] = k[len(k)-1-i]
+	}
+	return le
 }
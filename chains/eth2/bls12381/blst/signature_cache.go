@@ -0,0 +1,92 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+var maxSigs = 100000
+var sigCache *lru.Cache
+
+// sigCacheLock guards swapping the sigCache instance and its size. The cache itself is
+// thread-safe.
+var sigCacheLock sync.RWMutex
+
+// Counters backing SignatureCacheStats, updated atomically.
+var sigCacheHits, sigCacheMisses, sigCacheEvictions uint64
+
+func init() {
+	cache, err := lru.New(maxSigs)
+	if err != nil {
+		panic(fmt.Errorf("lru new failed: %w", err))
+	}
+	sigCache = cache
+}
+
+// SetSignatureCacheSize rebuilds the signature cache with a capacity of n entries. The most
+// recently used entries of the current cache are carried over, as many as fit.
+func SetSignatureCacheSize(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("signature cache size must be positive, got %d", n)
+	}
+	sigCacheLock.Lock()
+	defer sigCacheLock.Unlock()
+	cache, err := resizeCache(sigCache, n, &sigCacheEvictions, nil)
+	if err != nil {
+		return err
+	}
+	sigCache = cache
+	maxSigs = n
+	return nil
+}
+
+// SignatureCacheSize returns the capacity of the signature cache.
+func SignatureCacheSize() int {
+	sigCacheLock.RLock()
+	defer sigCacheLock.RUnlock()
+	return maxSigs
+}
+
+// SignatureCacheStats returns a snapshot of the signature cache counters.
+func SignatureCacheStats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&sigCacheHits),
+		Misses:    atomic.LoadUint64(&sigCacheMisses),
+		Evictions: atomic.LoadUint64(&sigCacheEvictions),
+	}
+}
+
+// ResetSignatureCacheStats zeroes the signature cache counters.
+func ResetSignatureCacheStats() {
+	atomic.StoreUint64(&sigCacheHits, 0)
+	atomic.StoreUint64(&sigCacheMisses, 0)
+	atomic.StoreUint64(&sigCacheEvictions, 0)
+}
+
+// cachedSignature looks up a decompressed, group checked signature by its compressed bytes.
+func cachedSignature(key [BLSSignatureLength]byte) (*Signature, bool) {
+	sigCacheLock.RLock()
+	defer sigCacheLock.RUnlock()
+	cv, ok := sigCache.Get(key)
+	if !ok {
+		atomic.AddUint64(&sigCacheMisses, 1)
+		return nil, false
+	}
+	atomic.AddUint64(&sigCacheHits, 1)
+	return cv.(*Signature), true
+}
+
+// cacheSignature stores a decompressed, group checked signature under its compressed bytes.
+func cacheSignature(key [BLSSignatureLength]byte, sig *Signature) {
+	sigCacheLock.RLock()
+	defer sigCacheLock.RUnlock()
+	if sigCache.Add(key, sig) {
+		atomic.AddUint64(&sigCacheEvictions, 1)
+	}
+}
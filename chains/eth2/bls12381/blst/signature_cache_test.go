@@ -0,0 +1,148 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resetSignatureCache empties the cache and restores its original size once the test ends.
+func resetSignatureCache(t *testing.T) {
+	size := SignatureCacheSize()
+	sigCache.Purge()
+	ResetSignatureCacheStats()
+	t.Cleanup(func() {
+		require.NoError(t, SetSignatureCacheSize(size))
+		sigCache.Purge()
+		ResetSignatureCacheStats()
+	})
+}
+
+func randSignatureBytes(t testing.TB, n int) [][]byte {
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		sigs[i] = priv.Sign([]byte{'s', 'i', 'g', byte(i)}).Marshal()
+	}
+	return sigs
+}
+
+func sigCacheContains(sig []byte) bool {
+	var k [BLSSignatureLength]byte
+	copy(k[:], sig)
+	return sigCache.Contains(k)
+}
+
+func TestSetSignatureCacheSize_Invalid(t *testing.T) {
+	resetSignatureCache(t)
+	size := SignatureCacheSize()
+	assert.Error(t, SetSignatureCacheSize(0))
+	assert.Error(t, SetSignatureCacheSize(-1))
+	assert.Equal(t, size, SignatureCacheSize())
+}
+
+func TestSetSignatureCacheSize_PreservesNewestEntries(t *testing.T) {
+	resetSignatureCache(t)
+	require.NoError(t, SetSignatureCacheSize(10))
+
+	sigs := randSignatureBytes(t, 5)
+	for _, s := range sigs {
+		_, err := SignatureFromBytes(s)
+		require.NoError(t, err)
+	}
+	require.NoError(t, SetSignatureCacheSize(3))
+	assert.Equal(t, 3, sigCache.Len())
+	for i, s := range sigs {
+		assert.Equal(t, i >= 2, sigCacheContains(s), "Unexpected cache membership for signature %d", i)
+	}
+	assert.Equal(t, CacheStats{Misses: 5, Evictions: 2}, SignatureCacheStats())
+}
+
+func TestSignatureCacheStats(t *testing.T) {
+	resetSignatureCache(t)
+	require.NoError(t, SetSignatureCacheSize(2))
+
+	sigs := randSignatureBytes(t, 3)
+	// miss, hit, miss, miss (evicts sigs[0]), hit
+	for _, s := range [][]byte{sigs[0], sigs[0], sigs[1], sigs[2], sigs[2]} {
+		_, err := SignatureFromBytes(s)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 3, Evictions: 1}, SignatureCacheStats())
+
+	// Signatures failing the group check are never cached.
+	_, err := SignatureFromBytes(notInSubgroupSig)
+	require.Error(t, err)
+	assert.Equal(t, false, sigCacheContains(notInSubgroupSig))
+}
+
+func TestSignatureCache_StoresCopies(t *testing.T) {
+	resetSignatureCache(t)
+	sigs := randSignatureBytes(t, 2)
+
+	first, err := SignatureFromBytes(sigs[0])
+	require.NoError(t, err)
+	cached, err := SignatureFromBytes(sigs[0])
+	require.NoError(t, err)
+	assert.NotSame(t, first.(*Signature).s, cached.(*Signature).s)
+
+	// Mutating returned signatures must not leak into the cache.
+	other, err := SignatureFromBytes(sigs[1])
+	require.NoError(t, err)
+	*first.(*Signature).s = *other.(*Signature).s
+	*cached.(*Signature).s = *other.(*Signature).s
+	again, err := SignatureFromBytes(sigs[0])
+	require.NoError(t, err)
+	assert.Equal(t, sigs[0], again.Marshal())
+}
+
+func BenchmarkSignatureCache_ReverifyBlock(b *testing.B) {
+	const n = 128 // MAX_ATTESTATIONS per block.
+	priv, err := RandKey()
+	require.NoError(b, err)
+	msg := []byte("block")
+	pub := priv.PublicKey()
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		sigs[i] = priv.Sign(append(msg, byte(i))).Marshal()
+	}
+	verifyBlock := func() {
+		for i, s := range sigs {
+			sig, err := SignatureFromBytes(s)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if !sig.Verify(pub, append(msg, byte(i))) {
+				b.Fatal("could not verify sig")
+			}
+		}
+	}
+
+	b.Run("FirstPass", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			sigCache.Purge()
+			b.StartTimer()
+			verifyBlock()
+		}
+	})
+	b.Run("SecondPass", func(b *testing.B) {
+		sigCache.Purge()
+		verifyBlock()
+		ResetSignatureCacheStats()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			verifyBlock()
+		}
+		if stats := SignatureCacheStats(); stats.Misses != 0 {
+			b.Fatalf("second pass decompressed %d signatures", stats.Misses)
+		}
+	})
+	sigCache.Purge()
+	ResetSignatureCacheStats()
+}
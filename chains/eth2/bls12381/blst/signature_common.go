@@ -0,0 +1,287 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// popDst is the domain separation tag of proofs of possession, distinct from dst as required
+// by the proof of possession scheme of the IETF BLS draft.
+var popDst = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+const BLSSignatureLength = 96
+
+// batchVerifyChunkSize is the number of signatures VerifyMultipleSignaturesContext verifies
+// between two checks of its context.
+var batchVerifyChunkSize int64 = 128
+
+// SetBatchVerifyChunkSize sets the number of signatures VerifyMultipleSignaturesContext verifies
+// between two checks of its context. Smaller chunks react faster to cancellation, at the cost of
+// a final exponentiation per chunk.
+func SetBatchVerifyChunkSize(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("batch verify chunk size must be positive, got %d", n)
+	}
+	atomic.StoreInt64(&batchVerifyChunkSize, int64(n))
+	return nil
+}
+
+// BatchVerifyChunkSize returns the number of signatures verified between two context checks.
+func BatchVerifyChunkSize() int {
+	return int(atomic.LoadInt64(&batchVerifyChunkSize))
+}
+
+// Eth2FastAggregateVerify implements a wrapper on top of bls's FastAggregateVerify. It accepts G2_POINT_AT_INFINITY signature
+// when pubkeys empty.
+//
+// Spec code:
+// def eth2_fast_aggregate_verify(pubkeys: Sequence[BLSPubkey], message: Bytes32, signature: BLSSignature) -> bool:
+//    """
+//    Wrapper to ``bls.FastAggregateVerify`` accepting the ``G2_POINT_AT_INFINITY`` signature when ``pubkeys`` is empty.
+//    """
+//    if len(pubkeys) == 0 and signature == G2_POINT_AT_INFINITY:
+//        return True
+//    return bls.FastAggregateVerify(pubkeys, message, signature)
+func (s *Signature) Eth2FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) bool {
+	if len(pubKeys) == 0 && s.IsInfinite() {
+		return true
+	}
+	return s.FastAggregateVerify(pubKeys, msg)
+}
+
+// VerifyCompressed verifies a compressed signature over msg against a compressed public key. Both
+// are parsed and validated like in PublicKeyFromBytes and SignatureFromBytes, going through their
+// caches. An error is only returned for malformed inputs; a well formed signature that does not
+// verify returns false and no error.
+func VerifyCompressed(pubKey, sig, msg []byte) (bool, error) {
+	pub, err := PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid public key: %w", err)
+	}
+	signature, err := SignatureFromBytes(sig)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature: %w", err)
+	}
+	return signature.Verify(pub, msg), nil
+}
+
+// DeserializeAndVerify decodes a compressed public key and signature and verifies the signature
+// over msg, for validating signed objects received from the network in one call. Decoding goes
+// through the public key and signature caches, and the result is cached by the verification
+// cache when msg is a 32 byte signing root. It returns nil for a valid signature, and otherwise
+// an error wrapping ErrMalformedPubKey or ErrMalformedSignature for inputs that do not decode and
+// ErrSignatureVerification for a well formed signature that does not verify, so that malformed
+// messages can be told apart from invalid ones.
+func DeserializeAndVerify(pubKey, sig, msg []byte) error {
+	pub, err := PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return fmt.Errorf("%w: %v", common.ErrMalformedPubKey, err)
+	}
+	s, err := SignatureFromBytes(sig)
+	if err != nil {
+		return fmt.Errorf("%w: %v", common.ErrMalformedSignature, err)
+	}
+	signature := s.(*Signature)
+	var valid bool
+	if len(msg) == 32 {
+		// Verifying against a single key is FastAggregateVerify of that key, so the result is
+		// shared with it.
+		var root [32]byte
+		copy(root[:], msg)
+		valid = cachedFastAggregateVerify([]common.PublicKey{pub}, root, signature, func() bool {
+			return signature.Verify(pub, msg)
+		})
+	} else {
+		valid = signature.Verify(pub, msg)
+	}
+	if !valid {
+		return common.ErrSignatureVerification
+	}
+	return nil
+}
+
+// AggregateKeysAndSignatures aggregates the public keys and the signatures of the same signers in
+// one pass, for flows that receive both. pubs[i] and sigs[i] are decoded and validated together on
+// up to GOMAXPROCS goroutines, and the error of the lowest failing index names which of the two is
+// malformed. Keys that cancel each other out are rejected with ErrInfinitePubKey, like in
+// AggregatePublicKeys.
+func AggregateKeysAndSignatures(pubs [][]byte, sigs [][]byte) (common.PublicKey, common.Signature, error) {
+	if len(pubs) != len(sigs) {
+		return nil, nil, fmt.Errorf("provided %d public keys but %d signatures", len(pubs), len(sigs))
+	}
+	if len(pubs) == 0 {
+		return nil, nil, fmt.Errorf("nil or empty public keys and signatures")
+	}
+	keys := make([]common.PublicKey, len(pubs))
+	signatures := make([]common.Signature, len(sigs))
+	_, err := forEachParallel(len(pubs), func(i int) (err error) {
+		if keys[i], err = PublicKeyFromBytes(pubs[i]); err != nil {
+			return fmt.Errorf("public key at index %d: %w", i, err)
+		}
+		if signatures[i], err = SignatureFromBytes(sigs[i]); err != nil {
+			return fmt.Errorf("signature at index %d: %w", i, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	aggKey, err := AggregateMultiplePubkeys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	if aggKey.IsInfinite() {
+		return nil, nil, common.ErrInfinitePubKey
+	}
+	aggSig, err := AggregateSignatures(signatures)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aggKey, aggSig, nil
+}
+
+// RecoverSignature recovers the signature of a t-of-n threshold group from t partial signatures,
+// where partials[i] was made with the secret key share of participant indices[i], the evaluation
+// at indices[i] of the polynomial whose constant term is the group secret key. The partials are
+// interpolated at x = 0 in the exponent, which yields the signature of the group secret key if
+// all partials are over the same message and there are at least t of them. With fewer the result
+// is well defined but verifies under the group public key with negligible probability.
+//
+// An error is returned if partials and indices differ in length, for an index of 0, which is the
+// group secret key itself, and for duplicate indices.
+func RecoverSignature(partials []common.Signature, indices []uint64) (common.Signature, error) {
+	if len(partials) != len(indices) {
+		return nil, fmt.Errorf("provided %d partial signatures but %d indices", len(partials), len(indices))
+	}
+	if len(partials) == 0 {
+		return nil, fmt.Errorf("nil or empty partial signatures")
+	}
+	sigs := make([]*Signature, len(partials))
+	seen := make(map[uint64]struct{}, len(indices))
+	for i, index := range indices {
+		sig, ok := partials[i].(*Signature)
+		if !ok || sig == nil || sig.s == nil {
+			return nil, fmt.Errorf("partial signature at index %d is not a valid signature", i)
+		}
+		if index == 0 {
+			return nil, fmt.Errorf("partial signature at index %d has participant index 0", i)
+		}
+		if _, ok := seen[index]; ok {
+			return nil, fmt.Errorf("duplicate participant index %d", index)
+		}
+		seen[index] = struct{}{}
+		sigs[i] = sig
+	}
+	return combineSignatures(sigs, lagrangeCoefficientsAtZero(indices)), nil
+}
+
+// VerifyPartials verifies the partial signatures of a threshold scheme over msg before they are
+// passed to RecoverSignature, partials[i] against the share public key sharePubs[i] of its
+// participant. Verifying a partial is plain Verify against the share key, so unlike a batch
+// verification the partials are checked one by one, on up to GOMAXPROCS goroutines, and the
+// indices into partials of those that do not verify are returned in increasing order. A nil
+// result means every partial verified. An error is only returned when the lengths differ or no
+// partials are given.
+func VerifyPartials(partials []common.Signature, sharePubs []common.PublicKey, msg []byte) ([]int, error) {
+	if len(partials) != len(sharePubs) {
+		return nil, fmt.Errorf("provided %d partial signatures but %d share public keys", len(partials), len(sharePubs))
+	}
+	if len(partials) == 0 {
+		return nil, fmt.Errorf("nil or empty partial signatures")
+	}
+	valid := make([]bool, len(partials))
+	forEachParallel(len(partials), func(i int) error {
+		sig, ok := partials[i].(*Signature)
+		valid[i] = ok && sig != nil && sig.Verify(sharePubs[i], msg)
+		return nil
+	})
+	var failed []int
+	for i, ok := range valid {
+		if !ok {
+			failed = append(failed, i)
+		}
+	}
+	return failed, nil
+}
+
+// lagrangeCoefficientsAtZero returns the Lagrange basis polynomials of the distinct, non-zero
+// points xs evaluated at 0 mod r, lambda_i = prod_{j != i} x_j / (x_j - x_i), as big-endian
+// scalars.
+func lagrangeCoefficientsAtZero(xs []uint64) [][BLSSecretKeyLength]byte {
+	r := new(big.Int).SetBytes(curveOrder[:])
+	coefficients := make([][BLSSecretKeyLength]byte, len(xs))
+	for i := range xs {
+		xi := new(big.Int).SetUint64(xs[i])
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j := range xs {
+			if j == i {
+				continue
+			}
+			xj := new(big.Int).SetUint64(xs[j])
+			num.Mul(num, xj).Mod(num, r)
+			den.Mul(den, new(big.Int).Sub(xj, xi)).Mod(den, r)
+		}
+		num.Mul(num, den.ModInverse(den, r)).Mod(num, r)
+		num.FillBytes(coefficients[i][:])
+	}
+	return coefficients
+}
+
+// ValidateSignatureBytes cheaply rejects inputs that cannot be a valid compressed signature, by
+// checking the length and the flag bits without decompressing the point. Passing it does not
+// mean the signature is valid, SignatureFromBytes still has to be called. Unlike public keys, the
+// point at infinity is accepted since aggregate signatures can be infinite.
+func ValidateSignatureBytes(b []byte) error {
+	if len(b) != BLSSignatureLength {
+		return fmt.Errorf("%w: signature must be %d bytes, got %d", common.ErrSignatureLength, BLSSignatureLength, len(b))
+	}
+	if _, err := checkCompressedFlags(b); err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	return nil
+}
+
+// SignatureFromHex creates a BLS signature from a hex encoded string, with or without a 0x prefix.
+// The decoded signature is validated like in SignatureFromBytes.
+func SignatureFromHex(s string) (common.Signature, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	if len(s)%2 != 0 {
+		return nil, fmt.Errorf("signature hex must have an even length, got %d characters", len(s))
+	}
+	sig, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("signature is not valid hex: %w", err)
+	}
+	return SignatureFromBytes(sig)
+}
+
+// String returns a shortened 0x prefixed hex form of the compressed signature for logs, the
+// first and last 4 bytes joined by "...". Use Hex for the full form.
+func (s *Signature) String() string {
+	if s == nil || s.s == nil {
+		return "<nil signature>"
+	}
+	b := s.Marshal()
+	return "0x" + hex.EncodeToString(b[:4]) + "..." + hex.EncodeToString(b[len(b)-4:])
+}
+
+// Hex returns the compressed signature as a 0x prefixed hex string, or an empty string for a
+// nil signature.
+func (s *Signature) Hex() string {
+	if s == nil || s.s == nil {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(s.Marshal())
+}
@@ -1,15 +1,18 @@
-//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
 // +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
-// +build !blst_disabled
 
 package blst
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -23,6 +26,115 @@ func TestSignVerify(t *testing.T) {
 	assert.Equal(t, true, sig.Verify(pub, msg), "Signature did not verify")
 }
 
+func TestSignature_VerifyHashed(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	objectRoot := sha256.Sum256([]byte("cross-chain message"))
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+	sig := SignRootWithDomain(priv, objectRoot, domain).(*Signature)
+
+	root := signingRoot(objectRoot, domain)
+	assert.Equal(t, VerifyRootWithDomain(pub, objectRoot, domain, sig), sig.VerifyHashed(pub, root))
+	assert.Equal(t, true, sig.VerifyHashed(pub, root), "Signature did not verify over its signing root")
+
+	// The root is not hashed again, so the object it was derived from does not verify.
+	assert.Equal(t, false, sig.VerifyHashed(pub, objectRoot))
+	assert.Equal(t, false, sig.VerifyHashed(pub, signingRoot(objectRoot, [32]byte{})))
+
+	other, err := RandKey()
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.VerifyHashed(other.PublicKey(), root))
+}
+
+func TestSecretKey_Destroy(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	before := priv.Marshal()
+	cp := priv.Copy()
+
+	priv.Destroy()
+	after := priv.(*bls12SecretKey).p.Serialize()
+	assert.NotEqual(t, before, after, "Scalar was not overwritten")
+	assert.Equal(t, true, IsZero(after))
+
+	assert.PanicsWithValue(t, errDestroyedSecretKey, func() { priv.Sign([]byte("hello")) })
+	assert.PanicsWithValue(t, errDestroyedSecretKey, func() { priv.PublicKey() })
+	assert.Nil(t, priv.Marshal())
+	assert.Equal(t, false, priv.Equals(cp))
+	_, err = AggregateSecretKeys([]common.SecretKey{cp, priv})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "destroyed secret key at index 1")
+	_, err = EncryptKeystore(priv, "secret")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "destroyed secret key")
+
+	// Copies taken before Destroy are independent.
+	assert.Equal(t, before, cp.Marshal())
+	assert.Equal(t, true, cp.Sign([]byte("hello")).Verify(cp.PublicKey(), []byte("hello")))
+}
+
+func TestSignVerify_Rejects(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	msg := []byte("hello")
+	sig := priv.Sign(msg)
+
+	assert.Equal(t, false, sig.Verify(pub, []byte("world")), "Signature verified a different message")
+
+	tampered := sig.Marshal()
+	tampered[len(tampered)-1] ^= 0x01
+	if tamperedSig, err := SignatureFromBytes(tampered); err == nil {
+		assert.Equal(t, false, tamperedSig.Verify(pub, msg), "Tampered signature verified")
+	}
+
+	infPub, err := PublicKeyFromBytesNoValidate(common.InfinitePublicKey[:])
+	require.NoError(t, err)
+	infSig, err := SignatureFromBytes(common.InfiniteSignature[:])
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(infPub, msg), "Infinite public key verified")
+	assert.Equal(t, false, infSig.Verify(infPub, msg), "Infinite public key and signature verified")
+	assert.Equal(t, false, infSig.Verify(pub, msg), "Infinite signature verified")
+
+	var typedNil *PublicKey
+	assert.Equal(t, false, sig.Verify(nil, msg))
+	assert.Equal(t, false, sig.Verify(typedNil, msg))
+	assert.Equal(t, false, sig.Verify(new(PublicKey), msg))
+}
+
+func TestSignature_IsInfinite(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	msg := [32]byte{'i', 'n', 'f'}
+	sig := priv.Sign(msg[:])
+	assert.Equal(t, false, sig.IsInfinite())
+
+	inf, err := SignatureFromBytes(common.InfiniteSignature[:])
+	require.NoError(t, err)
+	assert.Equal(t, true, inf.IsInfinite())
+	assert.Equal(t, true, sig.Subtract(sig).IsInfinite())
+
+	infPub, err := PublicKeyFromBytesNoValidate(common.InfinitePublicKey[:])
+	require.NoError(t, err)
+	for _, p := range []common.PublicKey{pub, infPub} {
+		pubs := []common.PublicKey{p}
+		assert.Equal(t, false, inf.Verify(p, msg[:]))
+		assert.Equal(t, false, inf.(*Signature).VerifyHashed(p, msg))
+		assert.Equal(t, false, inf.AggregateVerify(pubs, [][32]byte{msg}))
+		assert.Equal(t, false, AggregateVerify(pubs, [][32]byte{msg}, inf))
+		assert.Equal(t, false, inf.FastAggregateVerify(pubs, msg))
+		assert.Equal(t, false, FastAggregateVerify(pubs, msg, inf))
+		assert.Equal(t, false, inf.(*Signature).FastAggregateVerifyAggregated(p, msg))
+		assert.Equal(t, false, inf.Eth2FastAggregateVerify(pubs, msg))
+	}
+
+	// The spec accepts the infinite signature of an empty committee.
+	assert.Equal(t, true, inf.Eth2FastAggregateVerify(nil, msg))
+	assert.Equal(t, false, inf.FastAggregateVerify(nil, msg))
+}
+
 func TestAggregateVerify(t *testing.T) {
 	pubkeys := make([]common.PublicKey, 0, 100)
 	sigs := make([]common.Signature, 0, 100)
@@ -37,7 +149,8 @@ func TestAggregateVerify(t *testing.T) {
 		sigs = append(sigs, sig)
 		msgs = append(msgs, msg)
 	}
-	aggSig := AggregateSignatures(sigs)
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
 	assert.Equal(t, true, aggSig.AggregateVerify(pubkeys, msgs), "Signature did not verify")
 }
 
@@ -57,7 +170,8 @@ func TestAggregateVerify_CompressedSignatures(t *testing.T) {
 		sigBytes = append(sigBytes, sig.Marshal())
 		msgs = append(msgs, msg)
 	}
-	aggSig := AggregateSignatures(sigs)
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
 	assert.Equal(t, true, aggSig.AggregateVerify(pubkeys, msgs), "Signature did not verify")
 
 	aggSig2, err := AggregateCompressedSignatures(sigBytes)
@@ -65,6 +179,41 @@ func TestAggregateVerify_CompressedSignatures(t *testing.T) {
 	assert.Equal(t, aggSig.Marshal(), aggSig2.Marshal(), "Signature did not match up")
 }
 
+func TestAggregateVerify_Package(t *testing.T) {
+	for _, n := range []int{1, 2, 100} {
+		pubkeys := make([]common.PublicKey, 0, n)
+		sigs := make([]common.Signature, 0, n)
+		msgs := make([][32]byte, 0, n)
+		for i := 0; i < n; i++ {
+			msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+			priv, err := RandKey()
+			require.NoError(t, err)
+			pubkeys = append(pubkeys, priv.PublicKey())
+			sigs = append(sigs, priv.Sign(msg[:]))
+			msgs = append(msgs, msg)
+		}
+		aggSig, err := AggregateSignatures(sigs)
+		require.NoError(t, err)
+		assert.Equal(t, true, AggregateVerify(pubkeys, msgs, aggSig), "Signature did not verify for %d messages", n)
+		assert.Equal(t, false, AggregateVerify(pubkeys, msgs[:n-1], aggSig), "Expected false on length mismatch")
+
+		msgs[0][31] ^= 0xff
+		assert.Equal(t, false, AggregateVerify(pubkeys, msgs, aggSig), "Signature verified for a different message")
+	}
+}
+
+func TestAggregateVerify_Package_RejectsDuplicatePairs(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sig := priv.Sign(msg[:])
+	aggSig, err := AggregateSignatures([]common.Signature{sig, sig})
+	require.NoError(t, err)
+
+	pubkeys := []common.PublicKey{priv.PublicKey(), priv.PublicKey()}
+	assert.Equal(t, false, AggregateVerify(pubkeys, [][32]byte{msg, msg}, aggSig), "Duplicate pairs must be rejected")
+}
+
 func TestFastAggregateVerify(t *testing.T) {
 	pubkeys := make([]common.PublicKey, 0, 100)
 	sigs := make([]common.Signature, 0, 100)
@@ -77,11 +226,105 @@ func TestFastAggregateVerify(t *testing.T) {
 		pubkeys = append(pubkeys, pub)
 		sigs = append(sigs, sig)
 	}
-	aggSig := AggregateSignatures(sigs)
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
 	assert.Equal(t, true, aggSig.FastAggregateVerify(pubkeys, msg), "Signature did not verify")
 
 }
 
+func TestFastAggregateVerify_Package(t *testing.T) {
+	pubkeys := make([]common.PublicKey, 0, 100)
+	sigs := make([]common.Signature, 0, 100)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for i := 0; i < 100; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:]))
+	}
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.Equal(t, true, FastAggregateVerify(pubkeys, msg, aggSig), "Signature did not verify")
+
+	otherMsg := [32]byte{'w', 'o', 'r', 'l', 'd'}
+	assert.Equal(t, false, FastAggregateVerify(pubkeys, otherMsg, aggSig), "Signature verified for a different message")
+	assert.Equal(t, false, FastAggregateVerify(nil, msg, aggSig), "Expected false with empty input")
+
+	var nilPub *PublicKey
+	var nilSig *Signature
+	for _, bad := range []common.PublicKey{nil, nilPub, &PublicKey{}} {
+		keys := append(append([]common.PublicKey(nil), pubkeys[:99]...), bad)
+		assert.Equal(t, false, FastAggregateVerify(keys, msg, aggSig), "Expected false with a bad key %#v", bad)
+	}
+	for _, bad := range []common.Signature{nil, nilSig, &Signature{}} {
+		assert.Equal(t, false, FastAggregateVerify(pubkeys, msg, bad), "Expected false with a bad signature %#v", bad)
+	}
+}
+
+func TestFastAggregateVerify_Package_RejectsInfiniteAggregateKey(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	negPriv := negateSecretKey(t, priv)
+
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	pubkeys := []common.PublicKey{priv.PublicKey(), negPriv.PublicKey()}
+	aggSig, err := AggregateSignatures([]common.Signature{priv.Sign(msg[:]), negPriv.Sign(msg[:])})
+	require.NoError(t, err)
+	assert.Equal(t, common.InfiniteSignature[:], aggSig.Marshal())
+	assert.Equal(t, false, FastAggregateVerify(pubkeys, msg, aggSig), "Infinite aggregate key must be rejected")
+}
+
+func TestFastAggregateVerifyAggregated(t *testing.T) {
+	pubkeys := make([]common.PublicKey, 0, 100)
+	sigs := make([]common.Signature, 0, 100)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for i := 0; i < 100; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:]))
+	}
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	aggPub, err := AggregateMultiplePubkeys(pubkeys)
+	require.NoError(t, err)
+
+	sig := aggSig.(*Signature)
+	otherMsg := [32]byte{'w', 'o', 'r', 'l', 'd'}
+	for _, m := range [][32]byte{msg, otherMsg} {
+		assert.Equal(t, sig.FastAggregateVerify(pubkeys, m), sig.FastAggregateVerifyAggregated(aggPub, m))
+	}
+	assert.Equal(t, true, sig.FastAggregateVerifyAggregated(aggPub, msg), "Signature did not verify")
+	assert.Equal(t, false, sig.FastAggregateVerifyAggregated(pubkeys[0], msg), "Signature verified for a single committee member")
+	assert.Equal(t, false, sig.FastAggregateVerifyAggregated(nil, msg), "Expected false with a nil key")
+}
+
+func TestFastAggregateVerifyAggregated_RejectsInfiniteKey(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	negPriv := negateSecretKey(t, priv)
+
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	aggPub, err := AggregateMultiplePubkeys([]common.PublicKey{priv.PublicKey(), negPriv.PublicKey()})
+	require.NoError(t, err)
+	require.Equal(t, true, aggPub.IsInfinite())
+	aggSig, err := AggregateSignatures([]common.Signature{priv.Sign(msg[:]), negPriv.Sign(msg[:])})
+	require.NoError(t, err)
+	assert.Equal(t, false, aggSig.(*Signature).FastAggregateVerifyAggregated(aggPub, msg), "Infinite aggregate key must be rejected")
+}
+
+// negateSecretKey returns the secret key r - sk, whose public key cancels out the one of sk.
+func negateSecretKey(t testing.TB, sk common.SecretKey) common.SecretKey {
+	order, ok := new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	require.Equal(t, true, ok)
+	neg := new(big.Int).Sub(order, new(big.Int).SetBytes(sk.Marshal()))
+	negBytes := make([]byte, BLSSecretKeyLength)
+	neg.FillBytes(negBytes)
+	negKey, err := SecretKeyFromBytes(negBytes)
+	require.NoError(t, err)
+	return negKey
+}
+
 func TestVerifyCompressed(t *testing.T) {
 	priv, err := RandKey()
 	require.NoError(t, err)
@@ -89,7 +332,62 @@ func TestVerifyCompressed(t *testing.T) {
 	msg := []byte("hello")
 	sig := priv.Sign(msg)
 	assert.Equal(t, true, sig.Verify(pub, msg), "Non compressed signature did not verify")
-	assert.Equal(t, true, VerifyCompressed(sig.Marshal(), pub.Marshal(), msg), "Compressed signatures and pubkeys did not verify")
+	valid, err := VerifyCompressed(pub.Marshal(), sig.Marshal(), msg)
+	require.NoError(t, err)
+	assert.Equal(t, true, valid, "Compressed signatures and pubkeys did not verify")
+}
+
+func TestVerifyCompressed_BadSignature(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	other, err := RandKey()
+	require.NoError(t, err)
+	msg := []byte("hello")
+	sig := priv.Sign(msg).Marshal()
+
+	tests := []struct {
+		name string
+		pub  []byte
+		msg  []byte
+	}{
+		{name: "WrongKey", pub: other.PublicKey().Marshal(), msg: msg},
+		{name: "WrongMessage", pub: priv.PublicKey().Marshal(), msg: []byte("world")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			valid, err := VerifyCompressed(test.pub, sig, test.msg)
+			require.NoError(t, err, "Well formed inputs must not return an error")
+			assert.Equal(t, false, valid)
+		})
+	}
+}
+
+func TestVerifyCompressed_Malformed(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	msg := []byte("hello")
+	pub := priv.PublicKey().Marshal()
+	sig := priv.Sign(msg).Marshal()
+
+	tests := []struct {
+		name string
+		pub  []byte
+		sig  []byte
+		err  error
+	}{
+		{name: "ShortPublicKey", pub: pub[:10], sig: sig, err: common.ErrPubKeyLength},
+		{name: "InfinitePublicKey", pub: common.InfinitePublicKey[:], sig: sig, err: common.ErrInfinitePubKey},
+		{name: "ShortSignature", pub: pub, sig: sig[:10], err: common.ErrSignatureLength},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			valid, err := VerifyCompressed(test.pub, test.sig, msg)
+			assert.Equal(t, false, valid)
+			assert.True(t, errors.Is(err, test.err), "Got %v", err)
+		})
+	}
+	_, err = VerifyCompressed(pub, bytes.Repeat([]byte{0xff}, BLSSignatureLength), msg)
+	assert.Error(t, err, "Undecodable signature must be an error")
 }
 
 func TestMultipleSignatureVerification(t *testing.T) {
@@ -111,6 +409,53 @@ func TestMultipleSignatureVerification(t *testing.T) {
 	assert.Equal(t, true, verify, "Signature did not verify")
 }
 
+func multipleSignatureFixture(t *testing.T, n int) ([][]byte, [][32]byte, []common.PublicKey) {
+	sigs := make([][]byte, 0, n)
+	msgs := make([][32]byte, 0, n)
+	pubkeys := make([]common.PublicKey, 0, n)
+	for i := 0; i < n; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv, err := RandKey()
+		require.NoError(t, err)
+		sigs = append(sigs, priv.Sign(msg[:]).Marshal())
+		msgs = append(msgs, msg)
+		pubkeys = append(pubkeys, priv.PublicKey())
+	}
+	return sigs, msgs, pubkeys
+}
+
+func TestMultipleSignatureVerification_FlippedBit(t *testing.T) {
+	for bit := 0; bit < 8; bit++ {
+		sigs, msgs, pubkeys := multipleSignatureFixture(t, 50)
+		sigs[17][40] ^= 1 << bit
+		verify, _ := VerifyMultipleSignatures(sigs, msgs, pubkeys)
+		assert.Equal(t, false, verify, "Batch with bit %d flipped verified", bit)
+	}
+}
+
+func TestMultipleSignatureVerification_SwappedSignatures(t *testing.T) {
+	// Swapping two signatures leaves their sum unchanged, so only the random scalars catch it.
+	sigs, msgs, pubkeys := multipleSignatureFixture(t, 50)
+	sigs[3], sigs[4] = sigs[4], sigs[3]
+	verify, err := VerifyMultipleSignatures(sigs, msgs, pubkeys)
+	require.NoError(t, err)
+	assert.Equal(t, false, verify, "Batch with swapped signatures verified")
+}
+
+func TestMultipleSignatureVerification_LengthMismatch(t *testing.T) {
+	sigs, msgs, pubkeys := multipleSignatureFixture(t, 3)
+	_, err := VerifyMultipleSignatures(sigs[:2], msgs, pubkeys)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "S: 2, P: 3,M 3")
+	_, err = VerifyMultipleSignatures(sigs, msgs, pubkeys[:0])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "differing lengths")
+
+	verify, err := VerifyMultipleSignatures(nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, verify)
+}
+
 func TestFastAggregateVerify_ReturnsFalseOnEmptyPubKeyList(t *testing.T) {
 	var pubkeys []common.PublicKey
 	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
@@ -131,7 +476,8 @@ func TestEth2FastAggregateVerify(t *testing.T) {
 		pubkeys = append(pubkeys, pub)
 		sigs = append(sigs, sig)
 	}
-	aggSig := AggregateSignatures(sigs)
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
 	assert.Equal(t, true, aggSig.Eth2FastAggregateVerify(pubkeys, msg), "Signature did not verify")
 
 }
@@ -204,6 +550,33 @@ func TestSignatureFromBytes(t *testing.T) {
 	}
 }
 
+func TestSignatureFromBytesNoValidate(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).Marshal()
+
+	res, err := SignatureFromBytesNoValidate(sig)
+	require.NoError(t, err)
+	assert.Equal(t, sig, res.Marshal())
+
+	// Accepted even though SignatureFromBytes rejects it for being outside the subgroup, except
+	// by herumi, which always checks.
+	res, err = SignatureFromBytesNoValidate(notInSubgroupSig)
+	if noValidateSkipsSubgroupCheck {
+		require.NoError(t, err)
+		assert.Equal(t, notInSubgroupSig, res.Marshal())
+	} else {
+		assert.Error(t, err)
+	}
+
+	_, err = SignatureFromBytesNoValidate(sig[:95])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature must be 96 bytes")
+	_, err = SignatureFromBytesNoValidate(make([]byte, BLSSignatureLength))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not unmarshal bytes into signature")
+}
+
 func TestMultipleSignatureFromBytes(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -268,14 +641,545 @@ func TestCopy(t *testing.T) {
 	key, ok := priv.(*bls12SecretKey)
 	require.Equal(t, true, ok)
 
-	signatureA := &Signature{s: new(blstSignature).Sign(key.p, []byte("foo"), dst)}
+	signatureA := &Signature{s: rawSign(key, []byte("foo"))}
 	signatureB, ok := signatureA.Copy().(*Signature)
 	require.Equal(t, true, ok)
 
-	assert.NotEqual(t, signatureA, signatureB)
-	assert.NotEqual(t, signatureA.s, signatureB.s)
+	assert.NotSame(t, signatureA, signatureB)
+	assert.NotSame(t, signatureA.s, signatureB.s)
 	assert.Equal(t, signatureA, signatureB)
 
-	signatureA.s.Sign(key.p, []byte("bar"), dst)
+	*signatureA.s = *rawSign(key, []byte("bar"))
 	assert.NotEqual(t, signatureA, signatureB)
 }
+
+func TestSignature_AggregateWith(t *testing.T) {
+	msg := [32]byte{'a', 'g', 'g'}
+	privA, err := RandKey()
+	require.NoError(t, err)
+	privB, err := RandKey()
+	require.NoError(t, err)
+	sigA := privA.Sign(msg[:])
+	sigB := privB.Sign(msg[:])
+	encA, encB := sigA.Marshal(), sigB.Marshal()
+
+	copied := sigA.Copy()
+	agg := copied.AggregateWith(sigB)
+	assert.Equal(t, encA, sigA.Marshal(), "AggregateWith mutated the original")
+	assert.Equal(t, encA, copied.Marshal(), "AggregateWith mutated the receiver")
+	assert.Equal(t, encB, sigB.Marshal(), "AggregateWith mutated the argument")
+
+	assert.Equal(t, true, agg.FastAggregateVerify([]common.PublicKey{privA.PublicKey(), privB.PublicKey()}, msg))
+	want, err := AggregateSignatures([]common.Signature{sigA, sigB})
+	require.NoError(t, err)
+	assert.Equal(t, want.Marshal(), agg.Marshal())
+}
+
+func TestSignature_Subtract(t *testing.T) {
+	msg := [32]byte{'s', 'u', 'b'}
+	privs := make([]common.SecretKey, 3)
+	sigs := make([]common.Signature, 3)
+	for i := range privs {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		privs[i] = priv
+		sigs[i] = priv.Sign(msg[:])
+	}
+	all, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	encAll, encRetracted := all.Marshal(), sigs[1].Marshal()
+
+	remaining := all.Subtract(sigs[1])
+	assert.Equal(t, encAll, all.Marshal(), "Subtract mutated the receiver")
+	assert.Equal(t, encRetracted, sigs[1].Marshal(), "Subtract mutated the argument")
+
+	want, err := AggregateSignatures([]common.Signature{sigs[0], sigs[2]})
+	require.NoError(t, err)
+	assert.Equal(t, want.Marshal(), remaining.Marshal())
+	assert.Equal(t, true, remaining.FastAggregateVerify([]common.PublicKey{privs[0].PublicKey(), privs[2].PublicKey()}, msg))
+	assert.Equal(t, encAll, remaining.AggregateWith(sigs[1]).Marshal())
+
+	// Subtracting a signature from itself yields the infinity point.
+	assert.Equal(t, common.InfiniteSignature[:], sigs[0].Subtract(sigs[0]).Marshal())
+}
+
+// notInSubgroupSig is the compressed G2 point with x = 2, which lies on the curve but not in
+// the prime-order subgroup.
+var notInSubgroupSig = append([]byte{0x80}, append(make([]byte, BLSSignatureLength-2), 0x02)...)
+
+func TestAggregateSignatures_Empty(t *testing.T) {
+	_, err := AggregateSignatures(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nil or empty signatures")
+
+	_, err = AggregateCompressedSignatures([][]byte{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nil or empty signatures")
+}
+
+func TestAggregateSignatures_FastAggregateVerify(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for _, n := range []int{1, 2, 64} {
+		pubkeys := make([]common.PublicKey, 0, n)
+		sigs := make([]common.Signature, 0, n)
+		sigBytes := make([][]byte, 0, n)
+		for i := 0; i < n; i++ {
+			priv, err := RandKey()
+			require.NoError(t, err)
+			sig := priv.Sign(msg[:])
+			pubkeys = append(pubkeys, priv.PublicKey())
+			sigs = append(sigs, sig)
+			sigBytes = append(sigBytes, sig.Marshal())
+		}
+		aggSig, err := AggregateSignatures(sigs)
+		require.NoError(t, err)
+		assert.Equal(t, true, FastAggregateVerify(pubkeys, msg, aggSig), "Aggregate of %d signatures did not verify", n)
+
+		aggCompressed, err := AggregateCompressedSignatures(sigBytes)
+		require.NoError(t, err)
+		assert.Equal(t, aggSig.Marshal(), aggCompressed.Marshal())
+	}
+}
+
+func TestAggregateSignatures_RejectsInvalidElements(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello"))
+
+	var typedNil *Signature
+	_, err = AggregateSignatures([]common.Signature{sig, typedNil})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature at index 1")
+
+	requireNotInSubgroupSignature(t, notInSubgroupSig)
+	_, err = SignatureFromBytes(notInSubgroupSig)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), notInSubgroupSignatureError)
+	_, err = AggregateCompressedSignatures([][]byte{sig.Marshal(), notInSubgroupSig})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fail the group check")
+}
+
+func TestAggregateKeysAndSignatures(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for _, n := range []int{1, 2, 2 * parallelAggregateThreshold} {
+		pubkeys := make([]common.PublicKey, 0, n)
+		pubBytes := make([][]byte, 0, n)
+		sigBytes := make([][]byte, 0, n)
+		for i := 0; i < n; i++ {
+			priv, err := RandKey()
+			require.NoError(t, err)
+			pubkeys = append(pubkeys, priv.PublicKey())
+			pubBytes = append(pubBytes, priv.PublicKey().Marshal())
+			sigBytes = append(sigBytes, priv.Sign(msg[:]).Marshal())
+		}
+		aggKey, aggSig, err := AggregateKeysAndSignatures(pubBytes, sigBytes)
+		require.NoError(t, err)
+		assert.Equal(t, true, FastAggregateVerify(pubkeys, msg, aggSig), "Aggregate of %d signatures did not verify", n)
+		assert.Equal(t, true, aggSig.Verify(aggKey, msg[:]))
+
+		wantKey, err := AggregatePublicKeys(pubBytes)
+		require.NoError(t, err)
+		assert.Equal(t, wantKey.Marshal(), aggKey.Marshal())
+		wantSig, err := AggregateCompressedSignatures(sigBytes)
+		require.NoError(t, err)
+		assert.Equal(t, wantSig.Marshal(), aggSig.Marshal())
+	}
+}
+
+func TestAggregateKeysAndSignatures_Errors(t *testing.T) {
+	resetPublicKeyCache(t)
+	pubs := randPublicKeyBytes(t, 4)
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).Marshal()
+	sigs := [][]byte{sig, sig, sig, sig}
+
+	_, _, err = AggregateKeysAndSignatures(pubs, sigs[:3])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "4 public keys but 3 signatures")
+	_, _, err = AggregateKeysAndSignatures(nil, nil)
+	assert.Error(t, err)
+
+	badPubs := append([][]byte(nil), pubs...)
+	badPubs[2] = notInSubgroupKey
+	_, _, err = AggregateKeysAndSignatures(badPubs, sigs)
+	require.Error(t, err)
+	assert.Equal(t, true, errors.Is(err, common.ErrPubKeyNotInSubgroup))
+	assert.Contains(t, err.Error(), "public key at index 2")
+
+	badSigs := append([][]byte(nil), sigs...)
+	badSigs[1] = notInSubgroupSig
+	_, _, err = AggregateKeysAndSignatures(pubs, badSigs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature at index 1")
+
+	// The lowest failing index is reported, whichever of the two inputs is malformed.
+	_, _, err = AggregateKeysAndSignatures(badPubs, badSigs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature at index 1")
+
+	neg := append([]byte(nil), pubs[0]...)
+	neg[0] ^= 0x20
+	_, _, err = AggregateKeysAndSignatures([][]byte{pubs[0], neg}, sigs[:2])
+	assert.Equal(t, common.ErrInfinitePubKey, err)
+}
+
+func TestSignatureFromBytes_LengthError(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).Marshal()
+
+	for _, input := range [][]byte{nil, sig[:95], append(sig, 0x00)} {
+		_, err := SignatureFromBytes(input)
+		assert.Equal(t, true, errors.Is(err, common.ErrSignatureLength), "Unexpected error %v", err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("got %d", len(input)))
+
+		_, err = SignatureFromBytesNoValidate(input)
+		assert.Equal(t, true, errors.Is(err, common.ErrSignatureLength))
+		_, err = MultipleSignaturesFromBytes([][]byte{sig, input})
+		assert.Equal(t, true, errors.Is(err, common.ErrSignatureLength))
+	}
+
+	_, err = SignatureFromBytes(make([]byte, BLSSignatureLength))
+	require.Error(t, err)
+	assert.Equal(t, false, errors.Is(err, common.ErrSignatureLength))
+}
+
+// cancelAfterContext reports cancellation once Err has been called more than n times, so
+// tests can cancel a batch verification between two given chunks.
+type cancelAfterContext struct {
+	context.Context
+	n     int
+	calls int
+}
+
+func (c *cancelAfterContext) Err() error {
+	c.calls++
+	if c.calls > c.n {
+		return context.Canceled
+	}
+	return nil
+}
+
+func setBatchVerifyChunkSize(t *testing.T, n int) {
+	size := BatchVerifyChunkSize()
+	require.NoError(t, SetBatchVerifyChunkSize(n))
+	t.Cleanup(func() {
+		require.NoError(t, SetBatchVerifyChunkSize(size))
+	})
+}
+
+func TestVerifyMultipleSignaturesContext(t *testing.T) {
+	setBatchVerifyChunkSize(t, 4)
+	sigs, msgs, pubkeys := multipleSignatureFixture(t, 10)
+
+	verify, err := VerifyMultipleSignaturesContext(context.Background(), sigs, msgs, pubkeys)
+	require.NoError(t, err)
+	assert.Equal(t, true, verify)
+
+	// A bad signature in the last, partial chunk is caught.
+	sigs[9], sigs[8] = sigs[8], sigs[9]
+	verify, err = VerifyMultipleSignaturesContext(context.Background(), sigs, msgs, pubkeys)
+	require.NoError(t, err)
+	assert.Equal(t, false, verify)
+
+	_, err = VerifyMultipleSignaturesContext(context.Background(), sigs[:2], msgs, pubkeys)
+	assert.Error(t, err)
+	verify, err = VerifyMultipleSignaturesContext(context.Background(), nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, verify)
+}
+
+func TestVerifyMultipleSignaturesContext_Cancelled(t *testing.T) {
+	setBatchVerifyChunkSize(t, 1)
+	sigs, msgs, pubkeys := multipleSignatureFixture(t, 10)
+
+	// Cancelled after the first 3 of 10 chunks.
+	ctx := &cancelAfterContext{Context: context.Background(), n: 4}
+	verify, err := VerifyMultipleSignaturesContext(ctx, sigs, msgs, pubkeys)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, false, verify)
+	assert.Equal(t, 5, ctx.calls, "Verification did not stop at the cancellation")
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = VerifyMultipleSignaturesContext(cancelled, sigs, msgs, pubkeys)
+	assert.Equal(t, context.Canceled, err)
+
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	_, err = VerifyMultipleSignaturesContext(expired, sigs, msgs, pubkeys)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestSetBatchVerifyChunkSize_Invalid(t *testing.T) {
+	size := BatchVerifyChunkSize()
+	assert.Error(t, SetBatchVerifyChunkSize(0))
+	assert.Error(t, SetBatchVerifyChunkSize(-1))
+	assert.Equal(t, size, BatchVerifyChunkSize())
+}
+
+func TestSignatureFromHex(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).(*Signature)
+	h := sig.Hex()
+	require.Equal(t, "0x", h[:2])
+	require.Equal(t, 2+2*BLSSignatureLength, len(h))
+
+	for _, s := range []string{h, h[2:], "0X" + h[2:]} {
+		res, err := SignatureFromHex(s)
+		require.NoError(t, err)
+		assert.Equal(t, sig.Marshal(), res.Marshal())
+		assert.Equal(t, h, res.(*Signature).Hex())
+	}
+}
+
+func TestSignatureFromHex_Invalid(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	h := priv.Sign([]byte("hello")).(*Signature).Hex()
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "Empty", input: "", err: "signature must be 96 bytes"},
+		{name: "PrefixOnly", input: "0x", err: "signature must be 96 bytes"},
+		{name: "OddLength", input: h[:len(h)-1], err: "signature hex must have an even length"},
+		{name: "NonHex", input: h[:len(h)-2] + "zz", err: "signature is not valid hex"},
+		{name: "Short", input: h[:len(h)-2], err: "signature must be 96 bytes"},
+		{name: "Long", input: h + "00", err: "signature must be 96 bytes"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := SignatureFromHex(test.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestSignature_String(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).(*Signature)
+	h := sig.Hex()
+	want := h[:10] + "..." + h[len(h)-8:]
+	assert.Equal(t, want, sig.String())
+	assert.Equal(t, want, fmt.Sprintf("%v", sig))
+
+	var nilSig *Signature
+	assert.Equal(t, "<nil signature>", nilSig.String())
+	assert.Equal(t, "<nil signature>", (&Signature{}).String())
+	assert.Equal(t, "<nil signature>", fmt.Sprintf("%s", nilSig))
+	assert.Equal(t, "", nilSig.Hex())
+	assert.Equal(t, "", (&Signature{}).Hex())
+}
+
+func TestValidateSignatureBytes(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).Marshal()
+	require.NoError(t, ValidateSignatureBytes(sig))
+	require.NoError(t, ValidateSignatureBytes(common.InfiniteSignature[:]), "Infinite aggregates are well formed")
+
+	withFirst := func(b byte) []byte {
+		out := append([]byte(nil), sig...)
+		out[0] = b
+		return out
+	}
+	infinityWithTail := append([]byte(nil), common.InfiniteSignature[:]...)
+	infinityWithTail[95] = 0x01
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{name: "Short", input: sig[:95], err: "signature must be 96 bytes"},
+		{name: "Uncompressed", input: withFirst(sig[0] &^ 0x80), err: "compression flag is not set"},
+		{name: "InfinityWithSign", input: withFirst(0xe0), err: "sign flag"},
+		{name: "InfinityWithBits", input: withFirst(0xc1), err: "all other bits cleared"},
+		{name: "InfinityWithTail", input: infinityWithTail, err: "all other bits cleared"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSignatureBytes(test.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+			_, err = SignatureFromBytes(test.input)
+			assert.Error(t, err, "Input rejected by the pre-check must not decompress")
+		})
+	}
+}
+
+func TestDeserializeAndVerify(t *testing.T) {
+	enableVerificationCache(t, 16)
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+	root := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sig := priv.Sign(root[:]).Marshal()
+
+	require.NoError(t, DeserializeAndVerify(pub, sig, root[:]))
+	require.NoError(t, DeserializeAndVerify(pub, sig, root[:]))
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, VerificationCacheStats())
+	// The result is shared with FastAggregateVerify of the single key.
+	assert.Equal(t, true, FastAggregateVerify([]common.PublicKey{priv.PublicKey()}, root, priv.Sign(root[:])))
+	assert.Equal(t, uint64(2), VerificationCacheStats().Hits)
+
+	// Messages that are not signing roots are verified without the verification cache.
+	msg := []byte("a message that is not 32 bytes long")
+	require.NoError(t, DeserializeAndVerify(pub, priv.Sign(msg).Marshal(), msg))
+	assert.Equal(t, uint64(1), VerificationCacheStats().Misses)
+}
+
+func TestDeserializeAndVerify_Errors(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().Marshal()
+	root := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sig := priv.Sign(root[:]).Marshal()
+	other, err := RandKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		pub  []byte
+		sig  []byte
+		msg  []byte
+		want error
+	}{
+		{name: "ShortPubKey", pub: pub[:47], sig: sig, msg: root[:], want: common.ErrMalformedPubKey},
+		{name: "PubKeyNotInSubgroup", pub: notInSubgroupKey, sig: sig, msg: root[:], want: common.ErrMalformedPubKey},
+		{name: "InfinitePubKey", pub: append([]byte{0xc0}, make([]byte, common.BLSPubkeyLength-1)...), sig: sig, msg: root[:], want: common.ErrMalformedPubKey},
+		{name: "ShortSignature", pub: pub, sig: sig[:95], msg: root[:], want: common.ErrMalformedSignature},
+		{name: "SignatureNotInSubgroup", pub: pub, sig: notInSubgroupSig, msg: root[:], want: common.ErrMalformedSignature},
+		{name: "WrongMessage", pub: pub, sig: sig, msg: []byte("goodbye"), want: common.ErrSignatureVerification},
+		{name: "WrongKey", pub: other.PublicKey().Marshal(), sig: sig, msg: root[:], want: common.ErrSignatureVerification},
+		{name: "InfiniteSignature", pub: pub, sig: NewAggregateSignature().Marshal(), msg: root[:], want: common.ErrSignatureVerification},
+	}
+	categories := []error{common.ErrMalformedPubKey, common.ErrMalformedSignature, common.ErrSignatureVerification}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DeserializeAndVerify(tt.pub, tt.sig, tt.msg)
+			require.Error(t, err)
+			for _, category := range categories {
+				assert.Equal(t, category == tt.want, errors.Is(err, category), "Unexpected category %v of %v", category, err)
+			}
+		})
+	}
+}
+
+// thresholdShares splits a random group secret key into n shares of a t-of-n scheme, the
+// evaluations at 1..n of a random polynomial of degree t-1 whose constant term is the group key.
+func thresholdShares(t *testing.T, threshold, n int) (common.SecretKey, []common.SecretKey) {
+	r := new(big.Int).SetBytes(curveOrder[:])
+	coefficients := make([]*big.Int, threshold)
+	for i := range coefficients {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		coefficients[i] = new(big.Int).SetBytes(priv.Marshal())
+	}
+	toKey := func(v *big.Int) common.SecretKey {
+		var buf [BLSSecretKeyLength]byte
+		v.FillBytes(buf[:])
+		key, err := SecretKeyFromBytes(buf[:])
+		require.NoError(t, err)
+		return key
+	}
+	shares := make([]common.SecretKey, n)
+	for i := range shares {
+		x := big.NewInt(int64(i + 1))
+		y := new(big.Int)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			y.Mul(y, x).Add(y, coefficients[j]).Mod(y, r)
+		}
+		shares[i] = toKey(y)
+	}
+	return toKey(coefficients[0]), shares
+}
+
+func TestRecoverSignature(t *testing.T) {
+	groupKey, shares := thresholdShares(t, 3, 5)
+	msg := []byte("hello")
+	partials := make([]common.Signature, len(shares))
+	for i, share := range shares {
+		partials[i] = share.Sign(msg)
+	}
+
+	for _, subset := range [][]uint64{{1, 2, 3}, {1, 3, 5}, {5, 2, 4}} {
+		sigs := make([]common.Signature, len(subset))
+		for i, index := range subset {
+			sigs[i] = partials[index-1]
+		}
+		sig, err := RecoverSignature(sigs, subset)
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(groupKey.PublicKey(), msg), "Signature recovered from %v does not verify", subset)
+		assert.Equal(t, groupKey.Sign(msg).Marshal(), sig.Marshal())
+	}
+
+	// Fewer partials than the threshold do not recover the group signature.
+	sig, err := RecoverSignature(partials[:2], []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(groupKey.PublicKey(), msg))
+}
+
+func TestRecoverSignature_Invalid(t *testing.T) {
+	_, shares := thresholdShares(t, 2, 3)
+	msg := []byte("hello")
+	partials := []common.Signature{shares[0].Sign(msg), shares[1].Sign(msg)}
+
+	tests := []struct {
+		name     string
+		partials []common.Signature
+		indices  []uint64
+		err      string
+	}{
+		{name: "Empty", err: "empty partial signatures"},
+		{name: "LengthMismatch", partials: partials, indices: []uint64{1}, err: "2 partial signatures but 1 indices"},
+		{name: "DuplicateIndex", partials: partials, indices: []uint64{2, 2}, err: "duplicate participant index 2"},
+		{name: "ZeroIndex", partials: partials, indices: []uint64{0, 1}, err: "participant index 0"},
+		{name: "NilPartial", partials: []common.Signature{partials[0], nil}, indices: []uint64{1, 2}, err: "partial signature at index 1 is not a valid signature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RecoverSignature(tt.partials, tt.indices)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestVerifyPartials(t *testing.T) {
+	_, shares := thresholdShares(t, 3, 5)
+	msg := []byte("hello")
+	partials := make([]common.Signature, len(shares))
+	sharePubs := make([]common.PublicKey, len(shares))
+	for i, share := range shares {
+		partials[i] = share.Sign(msg)
+		sharePubs[i] = share.PublicKey()
+	}
+	failed, err := VerifyPartials(partials, sharePubs, msg)
+	require.NoError(t, err)
+	assert.Nil(t, failed)
+
+	// The partial of participant 4 is signed by participant 2.
+	corrupt := append([]common.Signature(nil), partials...)
+	corrupt[3] = partials[1]
+	failed, err = VerifyPartials(corrupt, sharePubs, msg)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, failed)
+
+	failed, err = VerifyPartials(partials, sharePubs, []byte("goodbye"))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, failed)
+
+	_, err = VerifyPartials(partials, sharePubs[:4], msg)
+	assert.Error(t, err)
+	_, err = VerifyPartials(nil, nil, msg)
+	assert.Error(t, err)
+}
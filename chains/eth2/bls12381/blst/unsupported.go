@@ -0,0 +1,11 @@
+//go:build (!linux && !darwin && !windows) || (linux && !amd64 && !arm64) || (darwin && !amd64 && !arm64) || (windows && !amd64)
+// +build !linux,!darwin,!windows linux,!amd64,!arm64 darwin,!amd64,!arm64 windows,!amd64
+
+package blst
+
+// Neither backend supports this platform. blst is only built for the platforms of the other
+// files, and herumi, which blst_disabled builds use instead, needs cgo and ships its static
+// libraries for the same platforms only, none for linux/386 for instance. Referring to an
+// undefined name makes builds for other platforms fail here with a readable error, rather than
+// deep inside the bindings.
+var _ = bls12381_requires_linux_or_darwin_on_amd64_or_arm64_or_windows_on_amd64
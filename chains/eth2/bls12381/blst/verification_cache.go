@@ -0,0 +1,120 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+)
+
+// verificationCache holds the results of FastAggregateVerify keyed by verificationCacheKey. It
+// is nil while the cache is disabled, which is the default.
+var verificationCache *lru.Cache
+var maxVerifications int
+
+// verificationCacheLock guards swapping the verificationCache instance and its size. The cache
+// itself is thread-safe.
+var verificationCacheLock sync.RWMutex
+
+// Counters backing VerificationCacheStats, updated atomically.
+var verificationCacheHits, verificationCacheMisses, verificationCacheEvictions uint64
+
+// SetVerificationCacheSize sets the capacity of the cache of FastAggregateVerify results to n
+// entries, carrying over the most recently used entries that fit. A size of 0 disables the cache
+// and drops its entries.
+//
+// Results are keyed on the aggregate public key, the message and the signature, so repeating a
+// verification only skips the pairing if all three are identical. Both valid and invalid results
+// are cached. The message is expected to be a signing root, which mixes in the domain, so a
+// result is never reused for another domain.
+func SetVerificationCacheSize(n int) error {
+	if n < 0 {
+		return fmt.Errorf("verification cache size must not be negative, got %d", n)
+	}
+	verificationCacheLock.Lock()
+	defer verificationCacheLock.Unlock()
+	if n == 0 {
+		verificationCache = nil
+		maxVerifications = 0
+		return nil
+	}
+	cache, err := resizeCache(verificationCache, n, &verificationCacheEvictions, nil)
+	if err != nil {
+		return err
+	}
+	verificationCache = cache
+	maxVerifications = n
+	return nil
+}
+
+// VerificationCacheSize returns the capacity of the verification cache, 0 while it is disabled.
+func VerificationCacheSize() int {
+	verificationCacheLock.RLock()
+	defer verificationCacheLock.RUnlock()
+	return maxVerifications
+}
+
+// VerificationCacheStats returns a snapshot of the verification cache counters.
+func VerificationCacheStats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&verificationCacheHits),
+		Misses:    atomic.LoadUint64(&verificationCacheMisses),
+		Evictions: atomic.LoadUint64(&verificationCacheEvictions),
+	}
+}
+
+// ResetVerificationCacheStats zeroes the verification cache counters.
+func ResetVerificationCacheStats() {
+	atomic.StoreUint64(&verificationCacheHits, 0)
+	atomic.StoreUint64(&verificationCacheMisses, 0)
+	atomic.StoreUint64(&verificationCacheEvictions, 0)
+}
+
+// verificationCacheKey hashes the ciphersuite tag, the compressed aggregate of pubKeys, msg and
+// the compressed signature.
+func verificationCacheKey(pubKeys []common.PublicKey, msg [32]byte, sig *Signature) ([32]byte, error) {
+	aggKey, err := AggregateMultiplePubkeys(pubKeys)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	h := sha256.New()
+	h.Write(dst)
+	h.Write(aggKey.Marshal())
+	h.Write(msg[:])
+	h.Write(sig.Marshal())
+	var key [32]byte
+	copy(key[:], h.Sum(nil))
+	return key, nil
+}
+
+// cachedFastAggregateVerify returns the cached result of verifying sig against pubKeys over msg,
+// calling verify and caching its result on a miss. verify is called directly while the cache is
+// disabled.
+func cachedFastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte, sig *Signature, verify func() bool) bool {
+	verificationCacheLock.RLock()
+	cache := verificationCache
+	verificationCacheLock.RUnlock()
+	if cache == nil {
+		return verify()
+	}
+	key, err := verificationCacheKey(pubKeys, msg, sig)
+	if err != nil {
+		return verify()
+	}
+	if cv, ok := cache.Get(key); ok {
+		atomic.AddUint64(&verificationCacheHits, 1)
+		return cv.(bool)
+	}
+	atomic.AddUint64(&verificationCacheMisses, 1)
+	valid := verify()
+	if cache.Add(key, valid) {
+		atomic.AddUint64(&verificationCacheEvictions, 1)
+	}
+	return valid
+}
@@ -0,0 +1,106 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package blst
+
+import (
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enableVerificationCache turns the verification cache on with n entries and disables it again
+// once the test ends.
+func enableVerificationCache(t *testing.T, n int) {
+	require.NoError(t, SetVerificationCacheSize(n))
+	ResetVerificationCacheStats()
+	t.Cleanup(func() {
+		require.NoError(t, SetVerificationCacheSize(0))
+		ResetVerificationCacheStats()
+	})
+}
+
+// signedAggregate returns n public keys and their aggregate signature over msg.
+func signedAggregate(t *testing.T, n int, msg [32]byte) ([]common.PublicKey, common.Signature) {
+	pubs := make([]common.PublicKey, n)
+	sigs := make([]common.Signature, n)
+	for i := range pubs {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pubs[i] = priv.PublicKey()
+		sigs[i] = priv.Sign(msg[:])
+	}
+	sig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	return pubs, sig
+}
+
+func TestVerificationCache_DisabledByDefault(t *testing.T) {
+	assert.Equal(t, 0, VerificationCacheSize())
+	ResetVerificationCacheStats()
+
+	msg := [32]byte{'d', 'e', 'f', 'a', 'u', 'l', 't'}
+	pubs, sig := signedAggregate(t, 3, msg)
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+	assert.Equal(t, true, FastAggregateVerify(pubs, msg, sig))
+	assert.Equal(t, CacheStats{}, VerificationCacheStats())
+}
+
+func TestSetVerificationCacheSize_Invalid(t *testing.T) {
+	assert.Error(t, SetVerificationCacheSize(-1))
+	assert.Equal(t, 0, VerificationCacheSize())
+}
+
+func TestVerificationCache_Hit(t *testing.T) {
+	enableVerificationCache(t, 16)
+	msg := [32]byte{'h', 'i', 't'}
+	pubs, sig := signedAggregate(t, 3, msg)
+
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+	assert.Equal(t, CacheStats{Misses: 1}, VerificationCacheStats())
+	assert.Equal(t, true, FastAggregateVerify(pubs, msg, sig))
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, VerificationCacheStats())
+
+	// A hit returns the cached result without verifying again.
+	key, err := verificationCacheKey(pubs, msg, sig.(*Signature))
+	require.NoError(t, err)
+	verificationCache.Add(key, false)
+	assert.Equal(t, false, sig.FastAggregateVerify(pubs, msg))
+
+	// Invalid results are cached as well.
+	assert.Equal(t, false, sig.FastAggregateVerify(pubs[1:], msg))
+	assert.Equal(t, false, sig.FastAggregateVerify(pubs[1:], msg))
+	assert.Equal(t, CacheStats{Hits: 3, Misses: 2}, VerificationCacheStats())
+}
+
+func TestVerificationCache_OneBitChangeMisses(t *testing.T) {
+	enableVerificationCache(t, 16)
+	msg := [32]byte{'b', 'i', 't'}
+	pubs, sig := signedAggregate(t, 3, msg)
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+
+	flipped := msg
+	flipped[31] ^= 0x01
+	assert.Equal(t, false, sig.FastAggregateVerify(pubs, flipped))
+	assert.Equal(t, CacheStats{Misses: 2}, VerificationCacheStats())
+}
+
+func TestSetVerificationCacheSize_Disable(t *testing.T) {
+	enableVerificationCache(t, 16)
+	msg := [32]byte{'o', 'f', 'f'}
+	pubs, sig := signedAggregate(t, 2, msg)
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+
+	require.NoError(t, SetVerificationCacheSize(0))
+	assert.Equal(t, 0, VerificationCacheSize())
+	ResetVerificationCacheStats()
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+	assert.Equal(t, CacheStats{}, VerificationCacheStats())
+
+	// Re-enabling starts from an empty cache.
+	require.NoError(t, SetVerificationCacheSize(16))
+	assert.Equal(t, true, sig.FastAggregateVerify(pubs, msg))
+	assert.Equal(t, CacheStats{Misses: 1}, VerificationCacheStats())
+}
@@ -0,0 +1,229 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
+package common_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/blst"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backend is the factory of a BLS implementation checked by the conformance suite.
+type backend struct {
+	name                string
+	randKey             func() (common.SecretKey, error)
+	secretKeyFromBytes  func([]byte) (common.SecretKey, error)
+	publicKeyFromBytes  func([]byte) (common.PublicKey, error)
+	signatureFromBytes  func([]byte) (common.Signature, error)
+	aggregatePublicKeys func([]common.PublicKey) (common.PublicKey, error)
+	aggregateSignatures func([]common.Signature) (common.Signature, error)
+}
+
+// blstBackend is the blst backend, which is herumi behind the same API with the blst_disabled
+// tag, so running the suite with and without the tag checks both.
+var blstBackend = backend{
+	name:                "blst",
+	randKey:             blst.RandKey,
+	secretKeyFromBytes:  blst.SecretKeyFromBytes,
+	publicKeyFromBytes:  blst.PublicKeyFromBytes,
+	signatureFromBytes:  blst.SignatureFromBytes,
+	aggregatePublicKeys: blst.AggregateMultiplePubkeys,
+	aggregateSignatures: blst.AggregateSignatures,
+}
+
+var conformanceMsg = [32]byte{'c', 'o', 'n', 'f', 'o', 'r', 'm'}
+
+// signers returns n random secret keys, their public keys and their signatures over
+// conformanceMsg.
+func (b backend) signers(t *testing.T, n int) ([]common.SecretKey, []common.PublicKey, []common.Signature) {
+	sks := make([]common.SecretKey, n)
+	pubs := make([]common.PublicKey, n)
+	sigs := make([]common.Signature, n)
+	for i := range sks {
+		sk, err := b.randKey()
+		require.NoError(t, err)
+		sks[i] = sk
+		pubs[i] = sk.PublicKey()
+		sigs[i] = sk.Sign(conformanceMsg[:])
+	}
+	return sks, pubs, sigs
+}
+
+var conformanceTests = []struct {
+	name string
+	run  func(t *testing.T, b backend)
+}{
+	{
+		name: "secret key round trip",
+		run: func(t *testing.T, b backend) {
+			sks, _, _ := b.signers(t, 1)
+			enc := sks[0].Marshal()
+			require.Equal(t, 32, len(enc))
+			decoded, err := b.secretKeyFromBytes(enc)
+			require.NoError(t, err)
+			assert.Equal(t, true, sks[0].Equals(decoded))
+			assert.Equal(t, enc, decoded.Marshal())
+			assert.Equal(t, sks[0].PublicKey().Marshal(), decoded.PublicKey().Marshal())
+
+			_, err = b.secretKeyFromBytes(common.ZeroSecretKey[:])
+			assert.Equal(t, true, errors.Is(err, common.ErrSecretUnmarshal))
+		},
+	},
+	{
+		name: "public key round trip",
+		run: func(t *testing.T, b backend) {
+			_, pubs, _ := b.signers(t, 1)
+			enc := pubs[0].Marshal()
+			require.Equal(t, common.BLSPubkeyLength, len(enc))
+			decoded, err := b.publicKeyFromBytes(enc)
+			require.NoError(t, err)
+			assert.Equal(t, true, pubs[0].Equals(decoded))
+			assert.Equal(t, enc, decoded.Marshal())
+			assert.Equal(t, enc, decoded.Copy().Marshal())
+
+			_, err = b.publicKeyFromBytes(enc[1:])
+			assert.Equal(t, true, errors.Is(err, common.ErrPubKeyLength))
+		},
+	},
+	{
+		name: "signature round trip",
+		run: func(t *testing.T, b backend) {
+			_, pubs, sigs := b.signers(t, 1)
+			enc := sigs[0].Marshal()
+			require.Equal(t, len(common.InfiniteSignature), len(enc))
+			decoded, err := b.signatureFromBytes(enc)
+			require.NoError(t, err)
+			assert.Equal(t, enc, decoded.Marshal())
+			assert.Equal(t, enc, decoded.Copy().Marshal())
+			assert.Equal(t, true, decoded.Verify(pubs[0], conformanceMsg[:]))
+
+			_, err = b.signatureFromBytes(enc[1:])
+			assert.Error(t, err)
+		},
+	},
+	{
+		name: "sign and verify",
+		run: func(t *testing.T, b backend) {
+			sks, pubs, sigs := b.signers(t, 2)
+			assert.Equal(t, true, sigs[0].Verify(pubs[0], conformanceMsg[:]))
+			assert.Equal(t, false, sigs[0].Verify(pubs[1], conformanceMsg[:]), "Verified under another key")
+			assert.Equal(t, false, sigs[0].Verify(pubs[0], []byte("other")), "Verified another message")
+
+			// Signing is deterministic.
+			assert.Equal(t, sigs[0].Marshal(), sks[0].Sign(conformanceMsg[:]).Marshal())
+			assert.Equal(t, true, pubs[0].VerifyProofOfPossession(sks[0].SignProofOfPossession()))
+			assert.Equal(t, false, pubs[1].VerifyProofOfPossession(sks[0].SignProofOfPossession()))
+		},
+	},
+	{
+		name: "aggregation is commutative",
+		run: func(t *testing.T, b backend) {
+			_, pubs, sigs := b.signers(t, 2)
+			assert.Equal(t, sigs[0].AggregateWith(sigs[1]).Marshal(), sigs[1].AggregateWith(sigs[0]).Marshal())
+			assert.Equal(t, pubs[0].AggregateWith(pubs[1]).Marshal(), pubs[1].AggregateWith(pubs[0]).Marshal())
+
+			aggSig, err := b.aggregateSignatures([]common.Signature{sigs[1], sigs[0]})
+			require.NoError(t, err)
+			assert.Equal(t, sigs[0].AggregateWith(sigs[1]).Marshal(), aggSig.Marshal())
+			aggPub, err := b.aggregatePublicKeys([]common.PublicKey{pubs[1], pubs[0]})
+			require.NoError(t, err)
+			assert.Equal(t, pubs[0].AggregateWith(pubs[1]).Marshal(), aggPub.Marshal())
+		},
+	},
+	{
+		name: "aggregation is associative",
+		run: func(t *testing.T, b backend) {
+			_, pubs, sigs := b.signers(t, 3)
+			left := sigs[0].AggregateWith(sigs[1]).AggregateWith(sigs[2])
+			right := sigs[0].AggregateWith(sigs[1].AggregateWith(sigs[2]))
+			assert.Equal(t, left.Marshal(), right.Marshal())
+
+			leftPub := pubs[0].AggregateWith(pubs[1]).AggregateWith(pubs[2])
+			rightPub := pubs[0].AggregateWith(pubs[1].AggregateWith(pubs[2]))
+			assert.Equal(t, leftPub.Marshal(), rightPub.Marshal())
+
+			assert.Equal(t, true, left.FastAggregateVerify(pubs, conformanceMsg))
+			assert.Equal(t, true, left.Verify(leftPub, conformanceMsg[:]))
+			assert.Equal(t, false, left.FastAggregateVerify(pubs[:2], conformanceMsg))
+			assert.Equal(t, sigs[0].AggregateWith(sigs[2]).Marshal(), left.Subtract(sigs[1]).Marshal())
+		},
+	},
+	{
+		name: "infinity",
+		run: func(t *testing.T, b backend) {
+			_, pubs, sigs := b.signers(t, 1)
+			_, err := b.publicKeyFromBytes(common.InfinitePublicKey[:])
+			assert.Equal(t, true, errors.Is(err, common.ErrInfinitePubKey))
+			assert.Equal(t, false, pubs[0].IsInfinite())
+
+			inf, err := b.signatureFromBytes(common.InfiniteSignature[:])
+			require.NoError(t, err)
+			assert.Equal(t, true, inf.IsInfinite())
+			assert.Equal(t, false, sigs[0].IsInfinite())
+			assert.Equal(t, common.InfiniteSignature[:], sigs[0].Subtract(sigs[0]).Marshal())
+			assert.Equal(t, sigs[0].Marshal(), sigs[0].AggregateWith(inf).Marshal())
+
+			assert.Equal(t, false, inf.Verify(pubs[0], conformanceMsg[:]))
+			assert.Equal(t, false, inf.FastAggregateVerify(pubs, conformanceMsg))
+			assert.Equal(t, false, inf.AggregateVerify(pubs, [][32]byte{conformanceMsg}))
+			assert.Equal(t, true, inf.Eth2FastAggregateVerify(nil, conformanceMsg))
+			assert.Equal(t, false, sigs[0].Eth2FastAggregateVerify(nil, conformanceMsg))
+		},
+	},
+	{
+		name: "public key decoding errors",
+		run: func(t *testing.T, b backend) {
+			_, pubs, _ := b.signers(t, 1)
+			valid := pubs[0].Marshal()
+			// compressedX returns the compressed encoding of the point with the given x coordinate
+			// and the smaller y.
+			compressedX := func(x []byte) []byte {
+				enc := make([]byte, common.BLSPubkeyLength)
+				copy(enc[len(enc)-len(x):], x)
+				enc[0] |= 0x80
+				return enc
+			}
+			fieldModulus, err := hex.DecodeString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+			require.NoError(t, err)
+			infiniteWithSign := append([]byte(nil), common.InfinitePublicKey[:]...)
+			infiniteWithSign[0] |= 0x20
+
+			tests := []struct {
+				name  string
+				input []byte
+				err   error
+			}{
+				{name: "short", input: valid[:common.BLSPubkeyLength-1], err: common.ErrPubKeyLength},
+				{name: "uncompressed", input: append(valid, valid...), err: common.ErrPubKeyLength},
+				{name: "compression flag cleared", input: append([]byte{valid[0] &^ 0x80}, valid[1:]...), err: common.ErrPubKeyCompression},
+				{name: "infinity with sign flag", input: infiniteWithSign, err: common.ErrPubKeyCompression},
+				{name: "x not reduced", input: compressedX(fieldModulus), err: common.ErrPubKeyNotOnCurve},
+				// 1 + 4 is not a square modulo p.
+				{name: "not on curve", input: compressedX([]byte{1}), err: common.ErrPubKeyNotOnCurve},
+				// (4, sqrt(68)) is on the curve but not in G1.
+				{name: "not in subgroup", input: compressedX([]byte{4}), err: common.ErrPubKeyNotInSubgroup},
+				{name: "infinity", input: common.InfinitePublicKey[:], err: common.ErrInfinitePubKey},
+			}
+			for _, tt := range tests {
+				_, err := b.publicKeyFromBytes(tt.input)
+				assert.Equal(t, true, errors.Is(err, tt.err), "%s: got %v", tt.name, err)
+			}
+		},
+	},
+}
+
+func TestConformance(t *testing.T) {
+	for _, b := range []backend{blstBackend} {
+		for _, tt := range conformanceTests {
+			t.Run(b.name+"/"+tt.name, func(t *testing.T) {
+				tt.run(t, b)
+			})
+		}
+	}
+}
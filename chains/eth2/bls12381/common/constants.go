@@ -2,6 +2,9 @@ package common
 
 const BLSPubkeyLength = 48
 
+// BLSPubkeyUncompressedLength is the length of a public key in uncompressed form.
+const BLSPubkeyUncompressedLength = 96
+
 // ZeroSecretKey represents a zero secret key.
 var ZeroSecretKey = [32]byte{}
 
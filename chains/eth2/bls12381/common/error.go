@@ -9,5 +9,42 @@ var ErrZeroKey = errors.New("received secret key is zero")
 // a secret key.
 var ErrSecretUnmarshal = errors.New("could not unmarshal bytes into secret key")
 
+// ErrPubKeyLength describes an error due to a public key of the wrong length.
+var ErrPubKeyLength = errors.New("invalid public key length")
+
+// ErrSignatureLength describes an error due to a signature of the wrong length.
+var ErrSignatureLength = errors.New("invalid signature length")
+
 // ErrInfinitePubKey describes an error due to an infinite public key.
 var ErrInfinitePubKey = errors.New("received an infinite public key")
+
+// ErrNoPublicKeys describes an error due to an empty list of public keys to aggregate.
+var ErrNoPublicKeys = errors.New("nil or empty public keys")
+
+// ErrPubKeyCompression describes an error due to the flag bits of a compressed public key, as
+// when an uncompressed or little-endian encoding is passed as a compressed one.
+var ErrPubKeyCompression = errors.New("invalid public key compression flags")
+
+// ErrPubKeyNotOnCurve describes an error due to a compressed public key whose x coordinate is
+// not that of a point on the curve.
+var ErrPubKeyNotOnCurve = errors.New("public key is not on the curve")
+
+// ErrPubKeyNotInSubgroup describes an error due to a public key on the curve but outside the G1
+// subgroup.
+var ErrPubKeyNotInSubgroup = errors.New("public key is not in the G1 subgroup")
+
+// ErrMalformedPubKey describes an error due to public key bytes that do not decode into a valid
+// public key.
+var ErrMalformedPubKey = errors.New("malformed public key")
+
+// ErrMalformedSignature describes an error due to signature bytes that do not decode into a
+// valid signature.
+var ErrMalformedSignature = errors.New("malformed signature")
+
+// ErrSignatureVerification describes an error due to a well formed signature that does not
+// verify.
+var ErrSignatureVerification = errors.New("signature verification failed")
+
+// ErrKeystorePassword describes an error due to a keystore checksum mismatch, which means
+// the password is wrong.
+var ErrKeystorePassword = errors.New("invalid keystore password")
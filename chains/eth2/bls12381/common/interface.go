@@ -10,6 +10,10 @@ type SecretKey interface {
 	PublicKey() PublicKey
 	Sign(msg []byte) Signature
 	Marshal() []byte
+	Copy() SecretKey
+	Equals(s2 SecretKey) bool
+	Destroy()
+	SignProofOfPossession() Signature
 }
 
 // PublicKey represents a BLS public key.
@@ -17,8 +21,10 @@ type PublicKey interface {
 	Marshal() []byte
 	Copy() PublicKey
 	Aggregate(p2 PublicKey) PublicKey
+	AggregateWith(p2 PublicKey) PublicKey
 	IsInfinite() bool
 	Equals(p2 PublicKey) bool
+	VerifyProofOfPossession(proof Signature) bool
 }
 
 // Signature represents a BLS signature.
@@ -30,4 +36,7 @@ type Signature interface {
 	Eth2FastAggregateVerify(pubKeys []PublicKey, msg [32]byte) bool
 	Marshal() []byte
 	Copy() Signature
+	AggregateWith(s2 Signature) Signature
+	Subtract(other Signature) Signature
+	IsInfinite() bool
 }
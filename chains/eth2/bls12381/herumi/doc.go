@@ -0,0 +1,4 @@
+// Package herumi initializes the herumi BLS library. The library needs cgo and ships static
+// libraries for linux and darwin on amd64 and arm64 and windows on amd64 only, so on other
+// platforms the package is empty.
+package herumi
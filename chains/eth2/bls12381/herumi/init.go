@@ -1,3 +1,6 @@
+//go:build (linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)
+// +build linux,amd64 linux,arm64 darwin,amd64 darwin,arm64 windows,amd64
+
 package herumi
 
 import "github.com/herumi/bls-eth-go-binary/bls"
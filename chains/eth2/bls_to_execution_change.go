@@ -0,0 +1,114 @@
+package eth2
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	"github.com/minio/sha256-simd"
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// DomainBLSToExecutionChange is the domain type of BLS to execution change messages, which are
+// signed under the genesis fork version so that they stay valid across forks.
+var DomainBLSToExecutionChange = [4]byte{0x0a, 0x00, 0x00, 0x00}
+
+// BLSWithdrawalPrefix is the first byte of withdrawal credentials committing to a BLS key.
+const BLSWithdrawalPrefix = 0x00
+
+// BLSToExecutionChange asks to change the BLS withdrawal credentials of a validator to an
+// execution address.
+type BLSToExecutionChange struct {
+	ValidatorIndex     ValidatorIndex
+	FromBLSPubkey      []byte
+	ToExecutionAddress []byte
+}
+
+// SignedBLSToExecutionChange is a BLSToExecutionChange signed by the withdrawal key.
+type SignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange
+	Signature []byte
+	// WithdrawalCredentials are the current credentials of the validator in the beacon state,
+	// which FromBLSPubkey must commit to. They are not part of the signed message.
+	WithdrawalCredentials []byte
+}
+
+// HashTreeRoot ssz hashes the BLSToExecutionChange object
+func (c *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
+func (c *BLSToExecutionChange) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(c.ValidatorIndex))
+
+	// Field (1) 'FromBLSPubkey'
+	if size := len(c.FromBLSPubkey); size != BLSPubkeyLength {
+		err = ssz.ErrBytesLengthFn("--.FromBLSPubkey", size, BLSPubkeyLength)
+		return
+	}
+	hh.PutBytes(c.FromBLSPubkey)
+
+	// Field (2) 'ToExecutionAddress'
+	if size := len(c.ToExecutionAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.ToExecutionAddress", size, 20)
+		return
+	}
+	hh.PutBytes(c.ToExecutionAddress)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// VerifyBLSToExecutionChange checks a BLS to execution change of the network with the given
+// genesis validators root, as in process_bls_to_execution_change: the withdrawal credentials must
+// be BLS credentials committing to FromBLSPubkey, and the signature must verify against it under
+// DomainBLSToExecutionChange and the genesis fork version of the network.
+//
+// It returns an error if the message is malformed, the network is not supported or the key does
+// not match the credentials, and false without an error if only the signature is invalid.
+func VerifyBLSToExecutionChange(msg *SignedBLSToExecutionChange, genesisValidatorsRoot [32]byte) (bool, error) {
+	if msg == nil || msg.Message == nil {
+		return false, fmt.Errorf("nil bls to execution change")
+	}
+	if len(msg.WithdrawalCredentials) != 32 {
+		return false, fmt.Errorf("withdrawal credentials must be 32 bytes, got %d", len(msg.WithdrawalCredentials))
+	}
+	if msg.WithdrawalCredentials[0] != BLSWithdrawalPrefix {
+		return false, fmt.Errorf("withdrawal credentials prefix %#x is not the bls withdrawal prefix", msg.WithdrawalCredentials[0])
+	}
+	pubkeyHash := sha256.Sum256(msg.Message.FromBLSPubkey)
+	if !bytes.Equal(pubkeyHash[1:], msg.WithdrawalCredentials[1:]) {
+		return false, fmt.Errorf("from bls pubkey does not match the withdrawal credentials")
+	}
+
+	config, err := networkConfigByGenesisValidatorsRoot(genesisValidatorsRoot)
+	if err != nil {
+		return false, err
+	}
+	domain, err := ComputeDomain(DomainBLSToExecutionChange, config.ForkSchedule.GenesisVersion, genesisValidatorsRoot)
+	if err != nil {
+		return false, fmt.Errorf("compute domain failed: %v", err)
+	}
+	root, err := ComputeSigningRoot(msg.Message, domain)
+	if err != nil {
+		return false, fmt.Errorf("compute signing root failed: %v", err)
+	}
+
+	pubkey, err := bls.PublicKeyFromBytes(msg.Message.FromBLSPubkey)
+	if err != nil {
+		return false, fmt.Errorf("invalid from bls pubkey: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(msg.Signature)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature: %v", err)
+	}
+	return sig.Verify(pubkey, root[:]), nil
+}
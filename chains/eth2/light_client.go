@@ -11,6 +11,7 @@ const EpochsPerSyncCommitteePeriod uint64 = 256
 const SlotsPerEpoch uint64 = 32
 
 const FinalizedRootIndex uint32 = 105
+const CurrentSyncCommitteeIndex uint32 = 54
 const NextSyncCommitteeIndex uint32 = 55
 
 const L1BeaconBlockBodyTreeExecutionPayloadIndex uint64 = 25
@@ -27,15 +28,20 @@ func VerifyLightClientUpdate(input []byte) error {
 		return err
 	}
 
+	config, err := newNetworkConfig(verify.state.chainID)
+	if err != nil {
+		return fmt.Errorf("new network failed: %v", err)
+	}
+
 	if err := verifyFinality(verify.update); err != nil {
 		return err
 	}
 
-	if err := verifyNextSyncCommittee(verify.state, verify.update); err != nil {
+	if err := verifyNextSyncCommittee(&config.Preset, verify.state, verify.update); err != nil {
 		return err
 	}
 
-	return verifyBlsSignatures(verify.state, verify.update)
+	return verifyBlsSignatures(config, verify.state, verify.update)
 }
 
 func verifyFinality(update *LightClientUpdate) error {
@@ -57,6 +63,9 @@ func verifyFinality(update *LightClientUpdate) error {
 		return fmt.Errorf("invalid finality proof")
 	}
 
+	if uint64(len(update.exeFinalityBranch)) != ExecutionProofSize {
+		return fmt.Errorf("execution finality branch must have %d nodes, got %d", ExecutionProofSize, len(update.exeFinalityBranch))
+	}
 	l1Proof := update.exeFinalityBranch[0:L1BeaconBlockBodyProofSize]
 	l2Proof := update.exeFinalityBranch[L1BeaconBlockBodyProofSize:ExecutionProofSize]
 
@@ -87,10 +96,10 @@ func verifyFinality(update *LightClientUpdate) error {
 	return nil
 }
 
-func verifyNextSyncCommittee(state *LightClientState, update *LightClientUpdate) error {
+func verifyNextSyncCommittee(preset *Preset, state *LightClientState, update *LightClientUpdate) error {
 	// The active header will always be the finalized header because we don't accept updates without the finality update.
-	updatePeriod := computeSyncCommitteePeriod(update.finalizedHeader.Slot)
-	finalizedPeriod := computeSyncCommitteePeriod(state.finalizedHeader.Slot)
+	updatePeriod := preset.computeSyncCommitteePeriod(update.finalizedHeader.Slot)
+	finalizedPeriod := preset.computeSyncCommitteePeriod(state.finalizedHeader.Slot)
 
 	// Verify that the `next_sync_committee`, if present, actually is the next sync committee saved in the
 	// state of the `active_header`
@@ -117,23 +126,18 @@ func verifyNextSyncCommittee(state *LightClientState, update *LightClientUpdate)
 	return nil
 }
 
-func verifyBlsSignatures(state *LightClientState, update *LightClientUpdate) error {
+func verifyBlsSignatures(config *NetworkConfig, state *LightClientState, update *LightClientUpdate) error {
 	syncCommitteeCount := update.syncAggregate.SyncCommitteeBits.Count()
 	if syncCommitteeCount < MinSyncCommitteeParticipants {
 		return fmt.Errorf("invalid sync committee participants count, min required %d, got %d", MinSyncCommitteeParticipants, syncCommitteeCount)
 	}
 
-	if syncCommitteeCount*3 < update.syncAggregate.SyncCommitteeBits.Len()*2 {
+	if !HasSupermajorityParticipation(update.syncAggregate.SyncCommitteeBits.Bytes(), int(config.Preset.SyncCommitteeSize)) {
 		return fmt.Errorf("not enought sync committe count %d", syncCommitteeCount)
 	}
 
-	finalizedPeriod := computeSyncCommitteePeriod(state.finalizedHeader.Slot)
-	config, err := newNetworkConfig(state.chainID)
-	if err != nil {
-		return fmt.Errorf("new network failed: %v", err)
-	}
-
-	signaturePeriod := computeSyncCommitteePeriod(update.signatureSlot)
+	finalizedPeriod := config.Preset.computeSyncCommitteePeriod(state.finalizedHeader.Slot)
+	signaturePeriod := config.Preset.computeSyncCommitteePeriod(update.signatureSlot)
 	var syncCommittee SyncCommittee
 
 	// Verify signature period does not skip a sync committee period
@@ -146,23 +150,28 @@ func verifyBlsSignatures(state *LightClientState, update *LightClientUpdate) err
 			finalizedPeriod, finalizedPeriod+1, signaturePeriod)
 	}
 
-	// Verify sync committee aggregate signature
+	return verifySyncAggregate(config, &syncCommittee, update)
+}
+
+// verifySyncAggregate verifies the sync aggregate of an update as signed by the participating
+// members of syncCommittee, under the sync committee domain of the signature slot.
+func verifySyncAggregate(config *NetworkConfig, syncCommittee *SyncCommittee, update *LightClientUpdate) error {
 	forkVersion := config.computeForkVersionBySlot(update.signatureSlot)
 	if forkVersion == nil {
 		return fmt.Errorf("unsupportted fork")
 	}
 
-	domain, err := ComputeDomain(DomainSyncCommittee, forkVersion[:], config.GenesisValidatorsRoot[:])
+	domain, err := ComputeDomain(DomainSyncCommittee, *forkVersion, config.GenesisValidatorsRoot)
 	if err != nil {
 		return fmt.Errorf("compute domain failed: %v", err)
 	}
 
-	signingRoot, err := ComputeSigningRoot(&update.attestedHeader, domain)
+	blockRoot, err := update.attestedHeader.HashTreeRoot()
 	if err != nil {
-		return fmt.Errorf("compute signing root failed: %v", err)
+		return fmt.Errorf("failed to compute hash tree root of attested header: %v", err)
 	}
 
-	pubKeys, err := getParticipantPubkeys(syncCommittee.Pubkeys, update.syncAggregate.SyncCommitteeBits)
+	pubKeys, err := getParticipantPubkeys(&config.Preset, syncCommittee.Pubkeys, update.syncAggregate.SyncCommitteeBits)
 	if err != nil {
 		return fmt.Errorf("get participiant pubkyes failed: %v", err)
 	}
@@ -172,9 +181,116 @@ func verifyBlsSignatures(state *LightClientState, update *LightClientUpdate) err
 		return fmt.Errorf("ddeserialize signature failed: %v", err)
 	}
 
-	if !signature.FastAggregateVerify(pubKeys, signingRoot) {
+	ok, err := VerifySyncCommitteeSignature(pubKeys, blockRoot, domain, signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
 		return fmt.Errorf("fast aggregate verify failed")
 	}
 
 	return nil
 }
+
+// VerifySyncCommitteeSignature verifies the aggregate signature of the participating sync committee
+// members over the signing root of blockRoot under domain. It returns an error rather than false
+// when there are no participants, so callers can tell an empty committee from a bad signature.
+func VerifySyncCommitteeSignature(participants []bls.PublicKey, blockRoot [32]byte, domain [32]byte, sig bls.Signature) (bool, error) {
+	if len(participants) == 0 {
+		return false, fmt.Errorf("no sync committee participants")
+	}
+	if sig == nil {
+		return false, fmt.Errorf("nil sync committee signature")
+	}
+
+	signingRoot, err := signingData(func() ([32]byte, error) { return blockRoot, nil }, domain[:])
+	if err != nil {
+		return false, fmt.Errorf("compute signing root failed: %v", err)
+	}
+
+	return sig.FastAggregateVerify(participants, signingRoot), nil
+}
+
+// Verify verifies the sync aggregate as signed by the members of committee whose bit is set,
+// over the signing root of root under domain, like VerifySyncCommitteeSignature. The bits must
+// cover exactly the members of the committee, which may be of any preset's size. Malformed
+// input, such as a bad member key or signature, or no bits set, is an error, while a signature
+// that does not verify is false without an error.
+func (a *SyncAggregate) Verify(committee *SyncCommittee, root [32]byte, domain [32]byte) (bool, error) {
+	if committee == nil {
+		return false, fmt.Errorf("nil sync committee")
+	}
+	if len(a.SyncCommitteeBits)*8 != len(committee.Pubkeys) {
+		return false, fmt.Errorf("sync committee bits cover %d members, but committee has %d", len(a.SyncCommitteeBits)*8, len(committee.Pubkeys))
+	}
+	var participants [][]byte
+	for i, pubkey := range committee.Pubkeys {
+		if a.SyncCommitteeBits[i/8]&(1<<uint(i%8)) != 0 {
+			participants = append(participants, pubkey)
+		}
+	}
+	if len(participants) == 0 {
+		return false, fmt.Errorf("no sync committee participants")
+	}
+
+	pubKeys, err := bls.PublicKeysFromBytes(participants)
+	if err != nil {
+		return false, fmt.Errorf("invalid sync committee participant: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(a.SyncCommitteeSignature)
+	if err != nil {
+		return false, fmt.Errorf("invalid sync committee signature: %v", err)
+	}
+	return VerifySyncCommitteeSignature(pubKeys, root, domain, sig)
+}
+
+// VerifySyncAggregatesBatch verifies the sync aggregates of several blocks at once. For block i,
+// the members of committees[i] whose bit is set in bits[i] are aggregated into a single key, and
+// sigs[i] is checked against it over the signing root of roots[i] under domain. All blocks are
+// then verified in one random linear combination of pairings, which is much cheaper than
+// verifying each aggregate on its own.
+//
+// Like VerifySyncCommitteeSignature it returns an error for malformed input, such as mismatched
+// lengths or a block without participants, and false without an error if any of the signatures
+// is invalid. A batch that fails does not tell which block is bad.
+func VerifySyncAggregatesBatch(committees [][]bls.PublicKey, bits [][]byte, roots [][32]byte, sigs []bls.Signature, domain [32]byte) (bool, error) {
+	n := len(committees)
+	if n == 0 {
+		return false, fmt.Errorf("no sync aggregates")
+	}
+	if len(bits) != n || len(roots) != n || len(sigs) != n {
+		return false, fmt.Errorf("sync aggregate batch lengths differ: %d committees, %d bitfields, %d roots, %d signatures",
+			n, len(bits), len(roots), len(sigs))
+	}
+
+	aggKeys := make([]bls.PublicKey, n)
+	signingRoots := make([][32]byte, n)
+	rawSigs := make([][]byte, n)
+	for i := range committees {
+		if sigs[i] == nil {
+			return false, fmt.Errorf("sync aggregate %d: nil signature", i)
+		}
+		participants, err := SelectParticipants(committees[i], bits[i])
+		if err != nil {
+			return false, fmt.Errorf("sync aggregate %d: %v", i, err)
+		}
+		aggKey, err := bls.AggregateMultiplePubkeys(participants)
+		if err != nil {
+			return false, fmt.Errorf("sync aggregate %d: aggregate participants failed: %v", i, err)
+		}
+		// An aggregate at infinity would accept the infinite signature.
+		if aggKey.IsInfinite() {
+			return false, nil
+		}
+		root := roots[i]
+		signingRoot, err := signingData(func() ([32]byte, error) { return root, nil }, domain[:])
+		if err != nil {
+			return false, fmt.Errorf("sync aggregate %d: compute signing root failed: %v", i, err)
+		}
+		aggKeys[i] = aggKey
+		signingRoots[i] = signingRoot
+		rawSigs[i] = sigs[i].Marshal()
+	}
+
+	return bls.VerifyMultipleSignatures(rawSigs, signingRoots, aggKeys)
+}
@@ -0,0 +1,172 @@
+package eth2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// finalityBranchDepth is the depth of the finalized checkpoint root in the beacon state tree,
+// floorlog2(FinalizedRootIndex).
+const finalityBranchDepth = 6
+
+type jsonBeaconBlockHeader struct {
+	Slot          uint64        `json:"slot,string"`
+	ProposerIndex uint64        `json:"proposer_index,string"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	BodyRoot      hexutil.Bytes `json:"body_root"`
+}
+
+// jsonLightClientHeader is a light client header, which wraps the beacon block header in a beacon
+// field since the light client API was standardized. Older nodes serve the bare block header.
+type jsonLightClientHeader struct {
+	Beacon *jsonBeaconBlockHeader `json:"beacon"`
+	jsonBeaconBlockHeader
+}
+
+type jsonSyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+type jsonSyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+type jsonLightClientUpdate struct {
+	AttestedHeader          *jsonLightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *jsonSyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []hexutil.Bytes        `json:"next_sync_committee_branch"`
+	FinalizedHeader         *jsonLightClientHeader `json:"finalized_header"`
+	FinalityBranch          []hexutil.Bytes        `json:"finality_branch"`
+	SyncAggregate           *jsonSyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64                 `json:"signature_slot,string"`
+}
+
+// UnmarshalLightClientUpdateJSON decodes a light client update in the JSON shape served by the
+// /eth/v1/beacon/light_client/updates endpoint of a beacon node, either one element of the
+// response, with its version and data fields, or the bare data object. Hex fields are checked to
+// have the length of their type, the sync committee bits that of the mainnet committee.
+//
+// The next sync committee and the finalized header are optional, updates without them decode with
+// these fields left empty. The finalized execution header and its branch are not served by the
+// beacon API and are left empty as well, for the caller to fill in.
+func UnmarshalLightClientUpdateJSON(input []byte) (*LightClientUpdate, error) {
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(input, &envelope); err != nil {
+		return nil, fmt.Errorf("unmarshal light client update json failed: %v", err)
+	}
+	if len(envelope.Data) != 0 && !bytes.Equal(envelope.Data, []byte("null")) {
+		input = envelope.Data
+	}
+	var dec jsonLightClientUpdate
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return nil, fmt.Errorf("unmarshal light client update json failed: %v", err)
+	}
+
+	if dec.AttestedHeader == nil {
+		return nil, fmt.Errorf("light client update is missing attested_header")
+	}
+	if dec.SyncAggregate == nil {
+		return nil, fmt.Errorf("light client update is missing sync_aggregate")
+	}
+	update := &LightClientUpdate{signatureSlot: dec.SignatureSlot}
+	var err error
+	if update.attestedHeader, err = dec.AttestedHeader.toBeaconBlockHeader(); err != nil {
+		return nil, fmt.Errorf("invalid attested_header: %v", err)
+	}
+	if dec.FinalizedHeader != nil {
+		if update.finalizedHeader, err = dec.FinalizedHeader.toBeaconBlockHeader(); err != nil {
+			return nil, fmt.Errorf("invalid finalized_header: %v", err)
+		}
+	}
+	if update.finalityBranch, err = decodeJSONBranch(dec.FinalityBranch, finalityBranchDepth); err != nil {
+		return nil, fmt.Errorf("invalid finality_branch: %v", err)
+	}
+	if dec.NextSyncCommittee != nil {
+		if update.nextSyncCommittee, err = dec.NextSyncCommittee.toSyncCommittee(); err != nil {
+			return nil, fmt.Errorf("invalid next_sync_committee: %v", err)
+		}
+	}
+	if update.nextSyncCommitteeBranch, err = decodeJSONBranch(dec.NextSyncCommitteeBranch, int(NextSyncCommitteeDepth)); err != nil {
+		return nil, fmt.Errorf("invalid next_sync_committee_branch: %v", err)
+	}
+
+	bits, sig := dec.SyncAggregate.SyncCommitteeBits, dec.SyncAggregate.SyncCommitteeSignature
+	if len(bits) != SyncCommitteeSize/8 {
+		return nil, fmt.Errorf("invalid sync_aggregate: sync_committee_bits must be %d bytes, got %d", SyncCommitteeSize/8, len(bits))
+	}
+	if n := len(update.nextSyncCommittee.Pubkeys); n != 0 && n != 8*len(bits) {
+		return nil, fmt.Errorf("invalid sync_aggregate: %d bytes of sync_committee_bits for a committee of %d", len(bits), n)
+	}
+	if len(sig) != 96 {
+		return nil, fmt.Errorf("invalid sync_aggregate: sync_committee_signature must be 96 bytes, got %d", len(sig))
+	}
+	update.syncAggregate = SyncAggregate{
+		SyncCommitteeBits:      bitfield.Bitvector512(bits),
+		SyncCommitteeSignature: sig,
+	}
+	return update, nil
+}
+
+func (h *jsonLightClientHeader) toBeaconBlockHeader() (BeaconBlockHeader, error) {
+	header := &h.jsonBeaconBlockHeader
+	if h.Beacon != nil {
+		header = h.Beacon
+	}
+	for i, root := range [][]byte{header.ParentRoot, header.StateRoot, header.BodyRoot} {
+		if len(root) != 32 {
+			return BeaconBlockHeader{}, fmt.Errorf("%s must be 32 bytes, got %d", []string{"parent_root", "state_root", "body_root"}[i], len(root))
+		}
+	}
+	return BeaconBlockHeader{
+		Slot:          header.Slot,
+		ProposerIndex: ValidatorIndex(header.ProposerIndex),
+		ParentRoot:    header.ParentRoot,
+		StateRoot:     header.StateRoot,
+		BodyRoot:      header.BodyRoot,
+	}, nil
+}
+
+func (c *jsonSyncCommittee) toSyncCommittee() (SyncCommittee, error) {
+	if len(c.Pubkeys) == 0 {
+		return SyncCommittee{}, fmt.Errorf("empty pubkeys")
+	}
+	committee := SyncCommittee{Pubkeys: make([][]byte, len(c.Pubkeys))}
+	for i, pubkey := range c.Pubkeys {
+		if len(pubkey) != BLSPubkeyLength {
+			return SyncCommittee{}, fmt.Errorf("pubkey %d must be %d bytes, got %d", i, BLSPubkeyLength, len(pubkey))
+		}
+		committee.Pubkeys[i] = pubkey
+	}
+	if len(c.AggregatePubkey) != BLSPubkeyLength {
+		return SyncCommittee{}, fmt.Errorf("aggregate_pubkey must be %d bytes, got %d", BLSPubkeyLength, len(c.AggregatePubkey))
+	}
+	committee.AggregatePubkey = c.AggregatePubkey
+	return committee, nil
+}
+
+// decodeJSONBranch converts a Merkle branch, which is either absent or depth nodes of 32 bytes.
+func decodeJSONBranch(nodes []hexutil.Bytes, depth int) ([][]byte, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	if len(nodes) != depth {
+		return nil, fmt.Errorf("branch must have %d nodes, got %d", depth, len(nodes))
+	}
+	branch := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		if len(node) != 32 {
+			return nil, fmt.Errorf("node %d must be 32 bytes, got %d", i, len(node))
+		}
+		branch[i] = node
+	}
+	return branch, nil
+}
@@ -0,0 +1,126 @@
+package eth2
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lightClientUpdatesResponse returns the elements of a /eth/v1/beacon/light_client/updates
+// response body for mainnet period 620, the update of the update fixture.
+func lightClientUpdatesResponse(t *testing.T) []json.RawMessage {
+	body, err := ioutil.ReadFile("testdata/light_client_updates_620.json")
+	require.NoError(t, err)
+	var elements []json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &elements))
+	require.Len(t, elements, 1)
+	return elements
+}
+
+func TestUnmarshalLightClientUpdateJSON(t *testing.T) {
+	decoded, err := UnmarshalLightClientUpdateJSON(lightClientUpdatesResponse(t)[0])
+	require.NoError(t, err)
+	assert.Equal(t, update.attestedHeader, decoded.attestedHeader)
+	assert.Equal(t, update.nextSyncCommittee, decoded.nextSyncCommittee)
+	assert.Equal(t, update.nextSyncCommitteeBranch, decoded.nextSyncCommitteeBranch)
+	assert.Equal(t, update.finalizedHeader, decoded.finalizedHeader)
+	assert.Equal(t, update.finalityBranch, decoded.finalityBranch)
+	assert.Equal(t, update.syncAggregate, decoded.syncAggregate)
+	assert.Equal(t, update.signatureSlot, decoded.signatureSlot)
+	assert.Nil(t, decoded.exeFinalityBranch)
+
+	// Once the execution data is filled in, the decoded update verifies like the fixture.
+	decoded.finalizedExeHeader = update.finalizedExeHeader
+	decoded.exeFinalityBranch = update.exeFinalityBranch
+	assert.NoError(t, verifyFinality(decoded))
+	assert.NoError(t, verifyNextSyncCommittee(&MainnetPreset, &state, decoded))
+	config, err := newNetworkConfig(state.chainID)
+	require.NoError(t, err)
+	assert.NoError(t, verifyBlsSignatures(config, &state, decoded))
+}
+
+func TestUnmarshalLightClientUpdateJSON_Shapes(t *testing.T) {
+	var element struct {
+		Version string                     `json:"version"`
+		Data    map[string]json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(lightClientUpdatesResponse(t)[0], &element))
+	encode := func(data map[string]json.RawMessage) []byte {
+		enc, err := json.Marshal(data)
+		require.NoError(t, err)
+		return enc
+	}
+
+	t.Run("BareData", func(t *testing.T) {
+		decoded, err := UnmarshalLightClientUpdateJSON(encode(element.Data))
+		require.NoError(t, err)
+		assert.Equal(t, update.attestedHeader, decoded.attestedHeader)
+	})
+
+	t.Run("UnwrappedHeaders", func(t *testing.T) {
+		data := make(map[string]json.RawMessage)
+		for k, v := range element.Data {
+			data[k] = v
+		}
+		for _, field := range []string{"attested_header", "finalized_header"} {
+			var header map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(data[field], &header))
+			data[field] = header["beacon"]
+		}
+		decoded, err := UnmarshalLightClientUpdateJSON(encode(data))
+		require.NoError(t, err)
+		assert.Equal(t, update.attestedHeader, decoded.attestedHeader)
+		assert.Equal(t, update.finalizedHeader, decoded.finalizedHeader)
+	})
+
+	t.Run("NoFinality", func(t *testing.T) {
+		data := make(map[string]json.RawMessage)
+		for k, v := range element.Data {
+			data[k] = v
+		}
+		for _, field := range []string{"finalized_header", "finality_branch", "next_sync_committee", "next_sync_committee_branch"} {
+			delete(data, field)
+		}
+		decoded, err := UnmarshalLightClientUpdateJSON(encode(data))
+		require.NoError(t, err)
+		assert.Equal(t, update.attestedHeader, decoded.attestedHeader)
+		assert.Equal(t, BeaconBlockHeader{}, decoded.finalizedHeader)
+		assert.Equal(t, false, isFinalityUpdate(decoded))
+		assert.Equal(t, false, isSyncCommitteeUpdate(decoded))
+		assert.Equal(t, update.syncAggregate, decoded.syncAggregate)
+	})
+}
+
+func TestUnmarshalLightClientUpdateJSON_Invalid(t *testing.T) {
+	valid := string(lightClientUpdatesResponse(t)[0])
+	root := "0x97bc7b137c043fe27bef204a448bd8888006644a47b96e21f08468b25d446c71"
+	bits := `"sync_committee_bits":"0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffdffffffffffffffffffffffffffffffffffffffff"`
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "NotJSON", input: "{", err: "unmarshal light client update json failed"},
+		{name: "ShortRoot", input: strings.Replace(valid, root, root[:64], 1), err: "parent_root must be 32 bytes"},
+		{name: "NotHex", input: strings.Replace(valid, root, "0xzz"+root[4:], 1), err: "unmarshal light client update json failed"},
+		{name: "SlotNotString", input: strings.Replace(valid, `"slot":"5079167"`, `"slot":5079167`, 1), err: "unmarshal light client update json failed"},
+		{name: "MissingAttestedHeader", input: strings.Replace(valid, `"attested_header"`, `"other_header"`, 1), err: "missing attested_header"},
+		{name: "MissingSyncAggregate", input: strings.Replace(valid, `"sync_aggregate"`, `"other_aggregate"`, 1), err: "missing sync_aggregate"},
+		{name: "ShortBranch", input: strings.Replace(valid, `"finality_branch":["0x016c020000000000000000000000000000000000000000000000000000000000",`, `"finality_branch":[`, 1), err: "finality_branch: branch must have 6 nodes, got 5"},
+		{name: "ShortBits", input: strings.Replace(valid, bits, `"sync_committee_bits":"0xff"`, 1), err: "sync_committee_bits must be 64 bytes, got 1"},
+		{name: "LongBits", input: strings.Replace(valid, bits, strings.Replace(bits, "0x", "0x00", 1), 1), err: "sync_committee_bits must be 64 bytes, got 65"},
+		{name: "ShortPubkey", input: strings.Replace(valid, `"0x8e9cde634bc00ab39e67c84b7dd4c72470b9f05474aa9469c9c09086f2e9b06115dd7ab58f89b3198863eba817003613"`, `"0x8e9c"`, 1), err: "pubkey 0 must be 48 bytes, got 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NotEqual(t, valid, tt.input, "Test input was not modified")
+			_, err := UnmarshalLightClientUpdateJSON([]byte(tt.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,698 @@
+package eth2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// NextSyncCommitteeDepth and NextSyncCommitteeSubtreeIndex locate the next sync committee in
+// the beacon state tree, floorlog2(NextSyncCommitteeIndex) and NextSyncCommitteeIndex mod
+// 2**NextSyncCommitteeDepth.
+const NextSyncCommitteeDepth uint64 = 5
+const NextSyncCommitteeSubtreeIndex = uint64(NextSyncCommitteeIndex) % (1 << NextSyncCommitteeDepth)
+
+// CurrentSyncCommitteeDepth and CurrentSyncCommitteeSubtreeIndex locate the current sync
+// committee in the beacon state tree, next to the next sync committee.
+const CurrentSyncCommitteeDepth uint64 = 5
+const CurrentSyncCommitteeSubtreeIndex = uint64(CurrentSyncCommitteeIndex) % (1 << CurrentSyncCommitteeDepth)
+
+// ErrSyncCommitteeEquivocation is matched by the SyncCommitteeEquivocationError returned when the
+// sync committee signed two different headers for the same slot.
+var ErrSyncCommitteeEquivocation = errors.New("sync committee equivocation")
+
+// Errors returned by ValidateUpdate, wrapped with the details of the failed check.
+var (
+	ErrInsufficientParticipation      = errors.New("insufficient sync committee participation")
+	ErrInvalidUpdateSlots             = errors.New("invalid update slots")
+	ErrInvalidSignaturePeriod         = errors.New("invalid signature period")
+	ErrIrrelevantUpdate               = errors.New("irrelevant update")
+	ErrInvalidFinalityBranch          = errors.New("invalid finality branch")
+	ErrInvalidNextSyncCommitteeBranch = errors.New("invalid next sync committee branch")
+	ErrInvalidSyncAggregateSignature  = errors.New("invalid sync aggregate signature")
+)
+
+// SyncCommitteeEquivocationError reports two headers for the same slot that were both signed by
+// a sync committee supermajority. FirstRoot is the root seen first, SecondRoot the conflicting one.
+type SyncCommitteeEquivocationError struct {
+	Slot       uint64
+	FirstRoot  [32]byte
+	SecondRoot [32]byte
+}
+
+func (e *SyncCommitteeEquivocationError) Error() string {
+	return fmt.Sprintf("%v at slot %d: attested header roots %#x and %#x", ErrSyncCommitteeEquivocation, e.Slot, e.FirstRoot, e.SecondRoot)
+}
+
+// Unwrap lets errors.Is match the error against ErrSyncCommitteeEquivocation.
+func (e *SyncCommitteeEquivocationError) Unwrap() error {
+	return ErrSyncCommitteeEquivocation
+}
+
+// LightClientStore tracks the finalized and optimistic headers and the sync committees a light
+// client follows.
+type LightClientStore struct {
+	// Beacon block header that is finalized
+	finalizedHeader BeaconBlockHeader
+	// Most recent beacon block header attested to by a sync committee supermajority, never older
+	// than the finalized header
+	optimisticHeader BeaconBlockHeader
+
+	// Sync committees corresponding to the header. The next sync committee is unknown while it
+	// has no public keys.
+	currentSyncCommittee SyncCommittee
+	nextSyncCommittee    SyncCommittee
+	chainID              uint64
+	// Genesis validators root signatures are verified under, the network's own if zero
+	genesisValidatorsRoot [32]byte
+	// Preset the slots, periods and committee sizes follow, the mainnet preset if nil
+	preset *Preset
+
+	// Best valid update seen so far for each sync committee period, keyed by the period of
+	// the attested header
+	bestValidUpdates map[uint64]*LightClientUpdate
+
+	// Roots of the attested headers of verified updates, keyed by slot. Slots older than the
+	// finalized header are dropped.
+	attestedRoots map[uint64][32]byte
+}
+
+// NewLightClientStore creates a store of the mainnet preset starting from a trusted light
+// client state.
+func NewLightClientStore(state *LightClientState) *LightClientStore {
+	return NewLightClientStoreWithPreset(state, MainnetPreset)
+}
+
+// NewLightClientStoreWithPreset creates a store starting from a trusted light client state of a
+// chain running the given preset, such as a minimal devnet.
+func NewLightClientStoreWithPreset(state *LightClientState, preset Preset) *LightClientStore {
+	return &LightClientStore{
+		finalizedHeader:      state.finalizedHeader,
+		optimisticHeader:     state.finalizedHeader,
+		currentSyncCommittee: state.currentSyncCommittee,
+		nextSyncCommittee:    state.nextSyncCommittee,
+		chainID:              state.chainID,
+		preset:               &preset,
+		bestValidUpdates:     make(map[uint64]*LightClientUpdate),
+	}
+}
+
+// NewLightClientStoreFromBootstrap creates a store starting from the bootstrap of a trusted block
+// root. The bootstrap header must hash to trustedRoot and its current sync committee must be
+// proven against the header's state root. The next sync committee is unknown until an update of
+// the bootstrap period provides it. The store follows the mainnet preset.
+//
+// Signatures are verified under genesisValidatorsRoot from then on. If the bootstrap carries a
+// sync aggregate over its header, the aggregate is verified first so that a wrong root is
+// reported as a genesis validators root mismatch instead of failing every later update.
+//
+// Spec pseudocode definition:
+//	def initialize_light_client_store(trusted_block_root: Root,
+//                                     bootstrap: LightClientBootstrap) -> LightClientStore:
+//    assert hash_tree_root(bootstrap.header) == trusted_block_root
+//
+//    assert is_valid_merkle_branch(
+//        leaf=hash_tree_root(bootstrap.current_sync_committee),
+//        branch=bootstrap.current_sync_committee_branch,
+//        depth=floorlog2(CURRENT_SYNC_COMMITTEE_INDEX),
+//        index=get_subtree_index(CURRENT_SYNC_COMMITTEE_INDEX),
+//        root=bootstrap.header.state_root,
+//    )
+//
+//    return LightClientStore(
+//        finalized_header=bootstrap.header,
+//        current_sync_committee=bootstrap.current_sync_committee,
+//        next_sync_committee=SyncCommittee(),
+//        best_valid_update=None,
+//        optimistic_header=bootstrap.header,
+//        previous_max_active_participants=0,
+//        current_max_active_participants=0,
+//    )
+func NewLightClientStoreFromBootstrap(bootstrap *LightClientBootstrap, trustedRoot, genesisValidatorsRoot [32]byte) (*LightClientStore, error) {
+	root, err := bootstrap.header.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute hash tree root of bootstrap header: %v", err)
+	}
+	if root != trustedRoot {
+		return nil, fmt.Errorf("bootstrap header root %#x does not match trusted root %#x", root, trustedRoot)
+	}
+	if err := verifySyncCommitteeProof("current", &bootstrap.currentSyncCommittee, bootstrap.currentSyncCommitteeBranch,
+		CurrentSyncCommitteeDepth, CurrentSyncCommitteeSubtreeIndex, bootstrap.header.StateRoot); err != nil {
+		return nil, err
+	}
+
+	store := &LightClientStore{
+		finalizedHeader:       bootstrap.header,
+		optimisticHeader:      bootstrap.header,
+		currentSyncCommittee:  bootstrap.currentSyncCommittee,
+		chainID:               bootstrap.chainID,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		bestValidUpdates:      make(map[uint64]*LightClientUpdate),
+	}
+	if bootstrap.syncAggregate.SyncCommitteeBits.Count() > 0 {
+		if err := store.verifyBootstrapSignature(bootstrap); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// verifyBootstrapSignature verifies the sync aggregate of a bootstrap over its own header under
+// the store's genesis validators root.
+func (s *LightClientStore) verifyBootstrapSignature(bootstrap *LightClientBootstrap) error {
+	headerPeriod := s.chainPreset().computeSyncCommitteePeriod(bootstrap.header.Slot)
+	if signaturePeriod := s.chainPreset().computeSyncCommitteePeriod(bootstrap.signatureSlot); signaturePeriod != headerPeriod ||
+		bootstrap.signatureSlot <= bootstrap.header.Slot {
+		return fmt.Errorf("bootstrap signature slot %d should be after header slot %d in period %d",
+			bootstrap.signatureSlot, bootstrap.header.Slot, headerPeriod)
+	}
+	config, err := s.networkConfig()
+	if err != nil {
+		return err
+	}
+	signed := &LightClientUpdate{
+		attestedHeader: bootstrap.header,
+		syncAggregate:  bootstrap.syncAggregate,
+		signatureSlot:  bootstrap.signatureSlot,
+	}
+	if err := verifySyncAggregate(config, &s.currentSyncCommittee, signed); err != nil {
+		return fmt.Errorf("genesis validators root mismatch: bootstrap header signature does not verify under %#x: %v",
+			config.GenesisValidatorsRoot, err)
+	}
+	return nil
+}
+
+// networkConfig returns the configuration of the store's network, with the store's preset and
+// its genesis validators root if it has one.
+func (s *LightClientStore) networkConfig() (*NetworkConfig, error) {
+	config, err := newNetworkConfig(s.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("new network failed: %v", err)
+	}
+	if s.genesisValidatorsRoot != ([32]byte{}) {
+		config.GenesisValidatorsRoot = s.genesisValidatorsRoot
+	}
+	config.Preset = *s.chainPreset()
+	return config, nil
+}
+
+// chainPreset returns the preset of the store's chain.
+func (s *LightClientStore) chainPreset() *Preset {
+	return orMainnet(s.preset)
+}
+
+// ProcessUpdate verifies an update against the store and keeps it as the best update of its
+// period if it is better than the one stored so far. Of two equally good updates the one
+// received first is kept. A verified update attesting to a different header than an earlier one
+// for the same slot is rejected with a SyncCommitteeEquivocationError.
+//
+// Updates without a finality branch are accepted as long as they carry no next sync committee
+// either, since the committee is proven against the finalized header. A verified update moves
+// the optimistic header to its attested header if that is newer, and an update with finality
+// moves the finalized header forward within the store's period. The committees are left
+// untouched; crossing into the next period is done by applying the best update with
+// ApplyNextSyncCommittee.
+func (s *LightClientStore) ProcessUpdate(update *LightClientUpdate) error {
+	state := s.lightClientState()
+	hasFinality := isFinalityUpdate(update)
+	if hasFinality {
+		if err := verifyFinality(update); err != nil {
+			return err
+		}
+		if err := verifyNextSyncCommittee(s.chainPreset(), state, update); err != nil {
+			return err
+		}
+	} else if isSyncCommitteeUpdate(update) {
+		return fmt.Errorf("next sync committee update without finality proof")
+	}
+	config, err := s.networkConfig()
+	if err != nil {
+		return err
+	}
+	if err := verifyBlsSignatures(config, state, update); err != nil {
+		return err
+	}
+	if err := s.checkEquivocation(&update.attestedHeader); err != nil {
+		return err
+	}
+
+	bits := update.syncAggregate.SyncCommitteeBits
+	if HasSupermajorityParticipation(bits.Bytes(), int(s.chainPreset().SyncCommitteeSize)) && update.attestedHeader.Slot > s.optimisticHeader.Slot {
+		s.optimisticHeader = update.attestedHeader
+	}
+	if hasFinality && update.finalizedHeader.Slot > s.finalizedHeader.Slot &&
+		s.chainPreset().computeSyncCommitteePeriod(update.finalizedHeader.Slot) == s.chainPreset().computeSyncCommitteePeriod(s.finalizedHeader.Slot) {
+		s.setFinalizedHeader(update.finalizedHeader)
+	}
+
+	period := s.chainPreset().computeSyncCommitteePeriod(update.attestedHeader.Slot)
+	if best, ok := s.bestValidUpdates[period]; ok && !isBetterUpdate(s.chainPreset(), update, best) {
+		return nil
+	}
+	if s.bestValidUpdates == nil {
+		s.bestValidUpdates = make(map[uint64]*LightClientUpdate)
+	}
+	s.bestValidUpdates[period] = update
+	return nil
+}
+
+// FinalizedHeader returns the most recent finalized header known to the store.
+func (s *LightClientStore) FinalizedHeader() BeaconBlockHeader {
+	return s.finalizedHeader
+}
+
+// OptimisticHeader returns the most recent header attested to by a sync committee
+// supermajority. It is the finalized header until a newer one is attested.
+func (s *LightClientStore) OptimisticHeader() BeaconBlockHeader {
+	return s.optimisticHeader
+}
+
+// setFinalizedHeader advances the finalized header, moving the optimistic header along if it
+// falls behind.
+func (s *LightClientStore) setFinalizedHeader(header BeaconBlockHeader) {
+	s.finalizedHeader = header
+	if header.Slot > s.optimisticHeader.Slot {
+		s.optimisticHeader = header
+	}
+	for slot := range s.attestedRoots {
+		if slot < header.Slot {
+			delete(s.attestedRoots, slot)
+		}
+	}
+}
+
+// checkEquivocation records the root of a verified attested header, returning a
+// SyncCommitteeEquivocationError if a different header was recorded for its slot.
+func (s *LightClientStore) checkEquivocation(header *BeaconBlockHeader) error {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("failed to compute hash tree root of attested header: %v", err)
+	}
+	if seen, ok := s.attestedRoots[header.Slot]; ok {
+		if seen != root {
+			return &SyncCommitteeEquivocationError{Slot: header.Slot, FirstRoot: seen, SecondRoot: root}
+		}
+		return nil
+	}
+	if s.attestedRoots == nil {
+		s.attestedRoots = make(map[uint64][32]byte)
+	}
+	s.attestedRoots[header.Slot] = root
+	return nil
+}
+
+// BestValidUpdate returns the best update processed for the sync committee period, or nil if
+// there is none.
+func (s *LightClientStore) BestValidUpdate(period uint64) *LightClientUpdate {
+	return s.bestValidUpdates[period]
+}
+
+func (s *LightClientStore) lightClientState() *LightClientState {
+	return &LightClientState{
+		finalizedHeader:      s.finalizedHeader,
+		currentSyncCommittee: s.currentSyncCommittee,
+		nextSyncCommittee:    s.nextSyncCommittee,
+		chainID:              s.chainID,
+	}
+}
+
+// isBetterUpdate reports whether newUpdate should replace oldUpdate as the best update.
+//
+// Spec pseudocode definition:
+//	def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//    # Compare supermajority (> 2/3) sync committee participation
+//    max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//    new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//    old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//    new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//    old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//    if new_has_supermajority != old_has_supermajority:
+//        return new_has_supermajority > old_has_supermajority
+//    if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Compare presence of relevant sync committee
+//    new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//        compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//    )
+//    old_has_relevant_sync_committee = is_sync_committee_update(old_update) and (
+//        compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(old_update.signature_slot)
+//    )
+//    if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//        return new_has_relevant_sync_committee
+//
+//    # Compare indication of any finality
+//    new_has_finality = is_finality_update(new_update)
+//    old_has_finality = is_finality_update(old_update)
+//    if new_has_finality != old_has_finality:
+//        return new_has_finality
+//
+//    # Compare sync committee finality
+//    if new_has_finality:
+//        new_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(new_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        )
+//        old_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(old_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        )
+//        if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//            return new_has_sync_committee_finality
+//
+//    # Tiebreaker 1: Sync committee participation beyond supermajority
+//    if new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Tiebreaker 2: Prefer older data (fewer changes to best)
+//    if new_update.attested_header.slot != old_update.attested_header.slot:
+//        return new_update.attested_header.slot < old_update.attested_header.slot
+//    return new_update.signature_slot < old_update.signature_slot
+func isBetterUpdate(preset *Preset, newUpdate, oldUpdate *LightClientUpdate) bool {
+	maxActiveParticipants := preset.SyncCommitteeSize
+	newNumActiveParticipants := newUpdate.syncAggregate.SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.syncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	newHasRelevantSyncCommittee := isSyncCommitteeUpdate(newUpdate) &&
+		preset.computeSyncCommitteePeriod(newUpdate.attestedHeader.Slot) == preset.computeSyncCommitteePeriod(newUpdate.signatureSlot)
+	oldHasRelevantSyncCommittee := isSyncCommitteeUpdate(oldUpdate) &&
+		preset.computeSyncCommitteePeriod(oldUpdate.attestedHeader.Slot) == preset.computeSyncCommitteePeriod(oldUpdate.signatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := isFinalityUpdate(newUpdate)
+	oldHasFinality := isFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	if newHasFinality {
+		newHasSyncCommitteeFinality := preset.computeSyncCommitteePeriod(newUpdate.finalizedHeader.Slot) ==
+			preset.computeSyncCommitteePeriod(newUpdate.attestedHeader.Slot)
+		oldHasSyncCommitteeFinality := preset.computeSyncCommitteePeriod(oldUpdate.finalizedHeader.Slot) ==
+			preset.computeSyncCommitteePeriod(oldUpdate.attestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	if newUpdate.attestedHeader.Slot != oldUpdate.attestedHeader.Slot {
+		return newUpdate.attestedHeader.Slot < oldUpdate.attestedHeader.Slot
+	}
+	return newUpdate.signatureSlot < oldUpdate.signatureSlot
+}
+
+// isSyncCommitteeUpdate reports whether the update carries a next sync committee branch.
+func isSyncCommitteeUpdate(update *LightClientUpdate) bool {
+	return !isEmptyBranch(update.nextSyncCommitteeBranch)
+}
+
+// isFinalityUpdate reports whether the update carries a finality branch.
+func isFinalityUpdate(update *LightClientUpdate) bool {
+	return !isEmptyBranch(update.finalityBranch)
+}
+
+// isEmptyBranch reports whether a branch is absent, either missing or all zero nodes.
+func isEmptyBranch(branch [][]byte) bool {
+	for _, h := range branch {
+		for _, b := range h {
+			if b != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ApplyNextSyncCommittee applies the next sync committee of an update. The update is verified
+// before anything is applied: its finalized header must be proven against the state root of its
+// attested header, the committee against the state root of the finalized header, and the sync
+// aggregate must be signed by at least two thirds of the store's committee of the signature
+// period, as the spec requires before applying a next committee. An update from the
+// store's period only fills in an unknown next committee, an update from the following period
+// rotates the stored next committee into the current one. Updates from any other period are
+// rejected.
+//
+// Spec pseudocode definition:
+//	def apply_light_client_update(store: LightClientStore, update: LightClientUpdate) -> None:
+//    store_period = compute_sync_committee_period_at_slot(store.finalized_header.slot)
+//    update_finalized_period = compute_sync_committee_period_at_slot(update.finalized_header.slot)
+//    if store.next_sync_committee is None:
+//        assert update_finalized_period == store_period
+//        store.next_sync_committee = update.next_sync_committee
+//    elif update_finalized_period == store_period + 1:
+//        store.current_sync_committee = store.next_sync_committee
+//        store.next_sync_committee = update.next_sync_committee
+//    if update.finalized_header.slot > store.finalized_header.slot:
+//        store.finalized_header = update.finalized_header
+func (s *LightClientStore) ApplyNextSyncCommittee(update *LightClientUpdate) error {
+	storePeriod := s.chainPreset().computeSyncCommitteePeriod(s.finalizedHeader.Slot)
+	updatePeriod := s.chainPreset().computeSyncCommitteePeriod(update.finalizedHeader.Slot)
+	if updatePeriod != storePeriod && updatePeriod != storePeriod+1 {
+		return fmt.Errorf("update period should be %d or %d, but got %d", storePeriod, storePeriod+1, updatePeriod)
+	}
+	if !s.hasNextSyncCommittee() && updatePeriod != storePeriod {
+		return fmt.Errorf("next sync committee of period %d is unknown, update period should be %d, but got %d",
+			storePeriod+1, storePeriod, updatePeriod)
+	}
+
+	// A minority of the committee could sign a made up attested header, and with it prove any
+	// finalized header and next committee.
+	if !HasSupermajorityParticipation(update.syncAggregate.SyncCommitteeBits.Bytes(), int(s.chainPreset().SyncCommitteeSize)) {
+		return fmt.Errorf("%w: %d of %d members signed, a next sync committee needs two thirds",
+			ErrInsufficientParticipation, update.syncAggregate.SyncCommitteeBits.Count(), s.chainPreset().SyncCommitteeSize)
+	}
+	if err := verifyFinality(update); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFinalityBranch, err)
+	}
+	if err := verifySyncCommitteeProof("next", &update.nextSyncCommittee, update.nextSyncCommitteeBranch,
+		NextSyncCommitteeDepth, NextSyncCommitteeSubtreeIndex, update.finalizedHeader.StateRoot); err != nil {
+		return err
+	}
+
+	syncCommittee := &s.currentSyncCommittee
+	signaturePeriod := s.chainPreset().computeSyncCommitteePeriod(update.signatureSlot)
+	if signaturePeriod == storePeriod+1 && s.hasNextSyncCommittee() {
+		syncCommittee = &s.nextSyncCommittee
+	} else if signaturePeriod != storePeriod {
+		return fmt.Errorf("%w: no sync committee of period %d is known", ErrInvalidSignaturePeriod, signaturePeriod)
+	}
+	config, err := s.networkConfig()
+	if err != nil {
+		return err
+	}
+	if err := verifySyncAggregate(config, syncCommittee, update); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSyncAggregateSignature, err)
+	}
+
+	if !s.hasNextSyncCommittee() {
+		s.nextSyncCommittee = update.nextSyncCommittee
+	} else if updatePeriod == storePeriod+1 {
+		s.currentSyncCommittee = s.nextSyncCommittee
+		s.nextSyncCommittee = update.nextSyncCommittee
+	}
+	if update.finalizedHeader.Slot > s.finalizedHeader.Slot {
+		s.setFinalizedHeader(update.finalizedHeader)
+	}
+	return nil
+}
+
+// ValidateUpdate checks an update against the store without changing it. currentSlot is the
+// local wall clock slot; the update must not be signed after it. Every failed check returns an
+// error wrapping one of the ErrInsufficientParticipation, ErrInvalidUpdateSlots,
+// ErrInvalidSignaturePeriod, ErrIrrelevantUpdate, ErrInvalidFinalityBranch,
+// ErrInvalidNextSyncCommitteeBranch and ErrInvalidSyncAggregateSignature errors.
+//
+// As elsewhere in the store, the next sync committee is proven against the state root of the
+// finalized header, so a sync committee update must also be a finality update. The sync
+// aggregate is verified under the fork version of the signature slot, like verifyBlsSignatures.
+//
+// Spec pseudocode definition:
+//	def validate_light_client_update(store: LightClientStore,
+//                                    update: LightClientUpdate,
+//                                    current_slot: Slot,
+//                                    genesis_validators_root: Root) -> None:
+//    # Verify sync committee has sufficient participants
+//    sync_aggregate = update.sync_aggregate
+//    assert sum(sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
+//
+//    # Verify update does not skip a sync committee period
+//    assert current_slot >= update.signature_slot > update.attested_header.slot >= update.finalized_header.slot
+//    store_period = compute_sync_committee_period_at_slot(store.finalized_header.slot)
+//    update_signature_period = compute_sync_committee_period_at_slot(update.signature_slot)
+//    if is_next_sync_committee_known(store):
+//        assert update_signature_period in (store_period, store_period + 1)
+//    else:
+//        assert update_signature_period == store_period
+//
+//    # Verify update is relevant
+//    update_attested_period = compute_sync_committee_period_at_slot(update.attested_header.slot)
+//    update_has_next_sync_committee = not is_next_sync_committee_known(store) and (
+//        is_sync_committee_update(update) and update_attested_period == store_period
+//    )
+//    assert (
+//        update.attested_header.slot > store.finalized_header.slot
+//        or update_has_next_sync_committee
+//    )
+//
+//    # Verify that the `finality_branch`, if present, confirms `finalized_header`
+//    # to match the finalized checkpoint root saved in the state of `attested_header`.
+//    if not is_finality_update(update):
+//        assert update.finalized_header == BeaconBlockHeader()
+//    else:
+//        ...
+//
+//    # Verify that the `next_sync_committee`, if present, actually is the next sync committee saved in the
+//    # state of the `attested_header`
+//    if not is_sync_committee_update(update):
+//        assert update.next_sync_committee == SyncCommittee()
+//    else:
+//        if update_attested_period == store_period and is_next_sync_committee_known(store):
+//            assert update.next_sync_committee == store.next_sync_committee
+//        ...
+//
+//    # Verify sync committee aggregate signature
+//    if update_signature_period == store_period:
+//        sync_committee = store.current_sync_committee
+//    else:
+//        sync_committee = store.next_sync_committee
+//    ...
+//    assert bls.FastAggregateVerify(participant_pubkeys, signing_root, sync_aggregate.sync_committee_signature)
+func (s *LightClientStore) ValidateUpdate(update *LightClientUpdate, currentSlot uint64) error {
+	participants := update.syncAggregate.SyncCommitteeBits.Count()
+	if participants < MinSyncCommitteeParticipants {
+		return fmt.Errorf("%w: min required %d, got %d", ErrInsufficientParticipation, MinSyncCommitteeParticipants, participants)
+	}
+
+	finalized := isFinalityUpdate(update)
+	if currentSlot < update.signatureSlot {
+		return fmt.Errorf("%w: signature slot %d is after current slot %d", ErrInvalidUpdateSlots, update.signatureSlot, currentSlot)
+	}
+	if update.signatureSlot <= update.attestedHeader.Slot {
+		return fmt.Errorf("%w: signature slot %d is not after attested slot %d",
+			ErrInvalidUpdateSlots, update.signatureSlot, update.attestedHeader.Slot)
+	}
+	if finalized && update.attestedHeader.Slot < update.finalizedHeader.Slot {
+		return fmt.Errorf("%w: attested slot %d is before finalized slot %d",
+			ErrInvalidUpdateSlots, update.attestedHeader.Slot, update.finalizedHeader.Slot)
+	}
+
+	storePeriod := s.chainPreset().computeSyncCommitteePeriod(s.finalizedHeader.Slot)
+	signaturePeriod := s.chainPreset().computeSyncCommitteePeriod(update.signatureSlot)
+	if s.hasNextSyncCommittee() {
+		if signaturePeriod != storePeriod && signaturePeriod != storePeriod+1 {
+			return fmt.Errorf("%w: should be %d or %d, but got %d", ErrInvalidSignaturePeriod, storePeriod, storePeriod+1, signaturePeriod)
+		}
+	} else if signaturePeriod != storePeriod {
+		return fmt.Errorf("%w: next sync committee is unknown, should be %d, but got %d", ErrInvalidSignaturePeriod, storePeriod, signaturePeriod)
+	}
+
+	attestedPeriod := s.chainPreset().computeSyncCommitteePeriod(update.attestedHeader.Slot)
+	bringsNextSyncCommittee := !s.hasNextSyncCommittee() && isSyncCommitteeUpdate(update) && attestedPeriod == storePeriod
+	if update.attestedHeader.Slot <= s.finalizedHeader.Slot && !bringsNextSyncCommittee {
+		return fmt.Errorf("%w: attested slot %d is not after finalized slot %d",
+			ErrIrrelevantUpdate, update.attestedHeader.Slot, s.finalizedHeader.Slot)
+	}
+
+	if !finalized {
+		if !isEmptyHeader(&update.finalizedHeader) {
+			return fmt.Errorf("%w: finalized header without finality branch", ErrInvalidFinalityBranch)
+		}
+	} else if err := verifyFinality(update); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFinalityBranch, err)
+	}
+
+	if !isSyncCommitteeUpdate(update) {
+		if len(update.nextSyncCommittee.Pubkeys) > 0 || !isEmptyBranch([][]byte{update.nextSyncCommittee.AggregatePubkey}) {
+			return fmt.Errorf("%w: next sync committee without branch", ErrInvalidNextSyncCommitteeBranch)
+		}
+	} else {
+		if !finalized {
+			return fmt.Errorf("%w: next sync committee update without finality proof", ErrInvalidNextSyncCommitteeBranch)
+		}
+		if attestedPeriod == storePeriod && s.hasNextSyncCommittee() &&
+			!syncCommitteesEqual(&update.nextSyncCommittee, &s.nextSyncCommittee) {
+			return fmt.Errorf("%w: next sync committee differs from the known one", ErrInvalidNextSyncCommitteeBranch)
+		}
+		if err := verifySyncCommitteeProof("next", &update.nextSyncCommittee, update.nextSyncCommitteeBranch,
+			NextSyncCommitteeDepth, NextSyncCommitteeSubtreeIndex, update.finalizedHeader.StateRoot); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidNextSyncCommitteeBranch, err)
+		}
+	}
+
+	syncCommittee := &s.currentSyncCommittee
+	if signaturePeriod != storePeriod {
+		syncCommittee = &s.nextSyncCommittee
+	}
+	config, err := s.networkConfig()
+	if err != nil {
+		return err
+	}
+	if err := verifySyncAggregate(config, syncCommittee, update); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSyncAggregateSignature, err)
+	}
+	return nil
+}
+
+// isEmptyHeader reports whether header is the zero BeaconBlockHeader.
+func isEmptyHeader(header *BeaconBlockHeader) bool {
+	return header.Slot == 0 && header.ProposerIndex == 0 &&
+		isEmptyBranch([][]byte{header.ParentRoot, header.StateRoot, header.BodyRoot})
+}
+
+// syncCommitteesEqual reports whether two sync committees have the same members and aggregate.
+func syncCommitteesEqual(a, b *SyncCommittee) bool {
+	if len(a.Pubkeys) != len(b.Pubkeys) || !bytes.Equal(a.AggregatePubkey, b.AggregatePubkey) {
+		return false
+	}
+	for i := range a.Pubkeys {
+		if !bytes.Equal(a.Pubkeys[i], b.Pubkeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *LightClientStore) hasNextSyncCommittee() bool {
+	return len(s.nextSyncCommittee.Pubkeys) > 0
+}
+
+// verifySyncCommitteeProof checks that branch proves committee at index in the subtree of the
+// given depth under stateRoot. name tells the current and next committee apart in errors.
+func verifySyncCommitteeProof(name string, committee *SyncCommittee, branch [][]byte, depth, index uint64, stateRoot []byte) error {
+	leaf, err := SyncCommitteeRoot(committee)
+	if err != nil {
+		return fmt.Errorf("failed to compute hash tree root of %s sync committee: %v", name, err)
+	}
+	if len(stateRoot) != 32 {
+		return fmt.Errorf("invalid state root length %d", len(stateRoot))
+	}
+	var root [32]byte
+	copy(root[:], stateRoot)
+	nodes := make([][32]byte, len(branch))
+	for i, h := range branch {
+		if len(h) != 32 {
+			return fmt.Errorf("invalid %s sync committee branch node length %d", name, len(h))
+		}
+		copy(nodes[i][:], h)
+	}
+	if !VerifyMerkleBranch(leaf, nodes, depth, index, root) {
+		return fmt.Errorf("invalid %s sync committee proof", name)
+	}
+	return nil
+}
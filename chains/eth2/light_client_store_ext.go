@@ -0,0 +1,161 @@
+package eth2
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// LightClientStoreVersion is the version of the encoding written by MarshalBinary. It is bumped
+// whenever the encoding changes, so that stores persisted by an older release are detected and
+// migrated instead of being misparsed.
+const LightClientStoreVersion byte = 1
+
+// ErrUnsupportedStoreVersion is returned by UnmarshalBinary for encodings of an unknown version.
+var ErrUnsupportedStoreVersion = errors.New("unsupported light client store version")
+
+type extLightClientStore struct {
+	FinalizedHeader       BeaconBlockHeader
+	OptimisticHeader      BeaconBlockHeader
+	CurrentSyncCommittee  SyncCommittee
+	NextSyncCommittee     SyncCommittee
+	ChainID               uint64
+	GenesisValidatorsRoot [32]byte
+	// Preset is empty for a store without a preset, which follows the mainnet preset
+	Preset                []Preset
+	BestValidUpdatesKey   []uint64
+	BestValidUpdatesValue []extLightClientUpdate
+	AttestedRootsKey      []uint64
+	AttestedRootsValue    [][32]byte
+}
+
+type extLightClientUpdate struct {
+	AttestedHeader          BeaconBlockHeader
+	NextSyncCommittee       SyncCommittee
+	NextSyncCommitteeBranch [][]byte
+	FinalizedHeader         BeaconBlockHeader
+	FinalityBranch          [][]byte
+	FinalizedExeHeader      types.Header
+	ExeFinalityBranch       [][]byte
+	SyncCommitteeBits       []byte
+	SyncCommitteeSignature  []byte
+	SignatureSlot           uint64
+}
+
+// MarshalBinary encodes the store for persistence: a leading LightClientStoreVersion byte
+// followed by the RLP encoding of the store.
+func (s *LightClientStore) MarshalBinary() ([]byte, error) {
+	ext := extLightClientStore{
+		FinalizedHeader:       s.finalizedHeader,
+		OptimisticHeader:      s.optimisticHeader,
+		CurrentSyncCommittee:  s.currentSyncCommittee,
+		NextSyncCommittee:     s.nextSyncCommittee,
+		ChainID:               s.chainID,
+		GenesisValidatorsRoot: s.genesisValidatorsRoot,
+	}
+	if s.preset != nil {
+		ext.Preset = []Preset{*s.preset}
+	}
+
+	for period := range s.bestValidUpdates {
+		ext.BestValidUpdatesKey = append(ext.BestValidUpdatesKey, period)
+	}
+	sort.Slice(ext.BestValidUpdatesKey, func(i, j int) bool { return ext.BestValidUpdatesKey[i] < ext.BestValidUpdatesKey[j] })
+	for _, period := range ext.BestValidUpdatesKey {
+		u := s.bestValidUpdates[period]
+		ext.BestValidUpdatesValue = append(ext.BestValidUpdatesValue, extLightClientUpdate{
+			AttestedHeader:          u.attestedHeader,
+			NextSyncCommittee:       u.nextSyncCommittee,
+			NextSyncCommitteeBranch: u.nextSyncCommitteeBranch,
+			FinalizedHeader:         u.finalizedHeader,
+			FinalityBranch:          u.finalityBranch,
+			FinalizedExeHeader:      u.finalizedExeHeader,
+			ExeFinalityBranch:       u.exeFinalityBranch,
+			SyncCommitteeBits:       u.syncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature:  u.syncAggregate.SyncCommitteeSignature,
+			SignatureSlot:           u.signatureSlot,
+		})
+	}
+
+	for slot := range s.attestedRoots {
+		ext.AttestedRootsKey = append(ext.AttestedRootsKey, slot)
+	}
+	sort.Slice(ext.AttestedRootsKey, func(i, j int) bool { return ext.AttestedRootsKey[i] < ext.AttestedRootsKey[j] })
+	for _, slot := range ext.AttestedRootsKey {
+		ext.AttestedRootsValue = append(ext.AttestedRootsValue, s.attestedRoots[slot])
+	}
+
+	enc, err := rlp.EncodeToBytes(&ext)
+	if err != nil {
+		return nil, fmt.Errorf("encode light client store failed: %v", err)
+	}
+	return append([]byte{LightClientStoreVersion}, enc...), nil
+}
+
+// UnmarshalBinary decodes a store encoded by MarshalBinary, replacing the receiver's contents.
+// Encodings of another version are rejected with ErrUnsupportedStoreVersion.
+func (s *LightClientStore) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("empty light client store encoding")
+	}
+	if data[0] != LightClientStoreVersion {
+		return fmt.Errorf("%w %d, expected %d", ErrUnsupportedStoreVersion, data[0], LightClientStoreVersion)
+	}
+	var ext extLightClientStore
+	if err := rlp.DecodeBytes(data[1:], &ext); err != nil {
+		return fmt.Errorf("decode light client store failed: %v", err)
+	}
+	if len(ext.BestValidUpdatesKey) != len(ext.BestValidUpdatesValue) || len(ext.AttestedRootsKey) != len(ext.AttestedRootsValue) {
+		return fmt.Errorf("decode light client store failed: map keys and values differ in length")
+	}
+	if len(ext.Preset) > 1 {
+		return fmt.Errorf("decode light client store failed: %d presets", len(ext.Preset))
+	}
+
+	store := LightClientStore{
+		finalizedHeader:       ext.FinalizedHeader,
+		optimisticHeader:      ext.OptimisticHeader,
+		currentSyncCommittee:  ext.CurrentSyncCommittee,
+		nextSyncCommittee:     ext.NextSyncCommittee,
+		chainID:               ext.ChainID,
+		genesisValidatorsRoot: ext.GenesisValidatorsRoot,
+		bestValidUpdates:      make(map[uint64]*LightClientUpdate, len(ext.BestValidUpdatesKey)),
+	}
+	if len(ext.Preset) == 1 {
+		preset := ext.Preset[0]
+		if preset.SlotsPerEpoch == 0 || preset.EpochsPerSyncCommitteePeriod == 0 || preset.SyncCommitteeSize == 0 {
+			return fmt.Errorf("decode light client store failed: invalid preset %+v", preset)
+		}
+		store.preset = &preset
+	}
+	for i, period := range ext.BestValidUpdatesKey {
+		u := ext.BestValidUpdatesValue[i]
+		store.bestValidUpdates[period] = &LightClientUpdate{
+			attestedHeader:          u.AttestedHeader,
+			nextSyncCommittee:       u.NextSyncCommittee,
+			nextSyncCommitteeBranch: u.NextSyncCommitteeBranch,
+			finalizedHeader:         u.FinalizedHeader,
+			finalityBranch:          u.FinalityBranch,
+			finalizedExeHeader:      u.FinalizedExeHeader,
+			exeFinalityBranch:       u.ExeFinalityBranch,
+			syncAggregate: SyncAggregate{
+				SyncCommitteeBits:      bitfield.Bitvector512(u.SyncCommitteeBits),
+				SyncCommitteeSignature: u.SyncCommitteeSignature,
+			},
+			signatureSlot: u.SignatureSlot,
+		}
+	}
+	if len(ext.AttestedRootsKey) > 0 {
+		store.attestedRoots = make(map[uint64][32]byte, len(ext.AttestedRootsKey))
+		for i, slot := range ext.AttestedRootsKey {
+			store.attestedRoots[slot] = ext.AttestedRootsValue[i]
+		}
+	}
+
+	*s = store
+	return nil
+}
@@ -0,0 +1,889 @@
+package eth2
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	"github.com/minio/sha256-simd"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientStore_ApplyNextSyncCommittee_Rotation(t *testing.T) {
+	// The fixture state is finalized in period 619, the update in period 620.
+	store := NewLightClientStore(&state)
+	require.NoError(t, store.ApplyNextSyncCommittee(&update))
+
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, update.nextSyncCommittee, store.nextSyncCommittee)
+	assert.Equal(t, update.finalizedHeader, store.finalizedHeader)
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_SamePeriod(t *testing.T) {
+	// The first slot of period 620, before the update's finalized header.
+	start := update.finalizedHeader
+	start.Slot = 620 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	store := &LightClientStore{
+		finalizedHeader:      start,
+		currentSyncCommittee: state.nextSyncCommittee,
+		nextSyncCommittee:    update.nextSyncCommittee,
+		chainID:              state.chainID,
+	}
+	require.NoError(t, store.ApplyNextSyncCommittee(&update))
+
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee, "Same period update rotated the committee")
+	assert.Equal(t, update.nextSyncCommittee, store.nextSyncCommittee)
+	assert.Equal(t, update.finalizedHeader, store.finalizedHeader)
+
+	// Applying it again leaves the store as is.
+	require.NoError(t, store.ApplyNextSyncCommittee(&update))
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, update.finalizedHeader, store.finalizedHeader)
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_UnknownNext(t *testing.T) {
+	start := update.finalizedHeader
+	start.Slot = 620 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	store := &LightClientStore{finalizedHeader: start, currentSyncCommittee: state.nextSyncCommittee, chainID: state.chainID}
+	require.NoError(t, store.ApplyNextSyncCommittee(&update))
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, update.nextSyncCommittee, store.nextSyncCommittee)
+
+	// Without a known next committee the store cannot skip into the next period.
+	store = &LightClientStore{finalizedHeader: state.finalizedHeader, currentSyncCommittee: state.currentSyncCommittee, chainID: state.chainID}
+	assert.Error(t, store.ApplyNextSyncCommittee(&update))
+	assert.Equal(t, false, store.hasNextSyncCommittee())
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_UnexpectedPeriod(t *testing.T) {
+	old := state
+	old.finalizedHeader.Slot = 618 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	store := NewLightClientStore(&old)
+	assert.Error(t, store.ApplyNextSyncCommittee(&update))
+
+	ahead := state
+	ahead.finalizedHeader.Slot = 621 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	store = NewLightClientStore(&ahead)
+	assert.Error(t, store.ApplyNextSyncCommittee(&update))
+	assert.Equal(t, ahead.currentSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, ahead.finalizedHeader, store.finalizedHeader)
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_InvalidProof(t *testing.T) {
+	tampered := update
+	tampered.nextSyncCommitteeBranch = append([][]byte(nil), update.nextSyncCommitteeBranch...)
+	tampered.nextSyncCommitteeBranch[0] = make([]byte, 32)
+	store := NewLightClientStore(&state)
+	assert.Error(t, store.ApplyNextSyncCommittee(&tampered))
+
+	// Nothing is applied when the proof does not verify.
+	assert.Equal(t, state.currentSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, state.nextSyncCommittee, store.nextSyncCommittee)
+	assert.Equal(t, state.finalizedHeader, store.finalizedHeader)
+
+	short := update
+	short.nextSyncCommitteeBranch = update.nextSyncCommitteeBranch[1:]
+	assert.Error(t, store.ApplyNextSyncCommittee(&short))
+
+	wrongCommittee := update
+	wrongCommittee.nextSyncCommittee = state.currentSyncCommittee
+	assert.Error(t, store.ApplyNextSyncCommittee(&wrongCommittee))
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_Forged(t *testing.T) {
+	// A committee of the forger's choice, with a finalized header whose state root is the
+	// root of a branch made up for it. The committee proof verifies, but nothing else does.
+	forger := newSyntheticCommittee(t)
+	root, err := SyncCommitteeRoot(&forger.committee)
+	require.NoError(t, err)
+	forged := update
+	forged.nextSyncCommittee = forger.committee
+	forged.nextSyncCommitteeBranch = randBranch(t, int(NextSyncCommitteeDepth))
+	stateRoot := branchRoot(root, forged.nextSyncCommitteeBranch, NextSyncCommitteeSubtreeIndex)
+	forged.finalizedHeader.StateRoot = stateRoot[:]
+	forged.finalizedHeader.Slot = update.finalizedHeader.Slot + 1
+	require.NoError(t, verifySyncCommitteeProof("next", &forged.nextSyncCommittee, forged.nextSyncCommitteeBranch,
+		NextSyncCommitteeDepth, NextSyncCommitteeSubtreeIndex, forged.finalizedHeader.StateRoot))
+
+	store := NewLightClientStore(&state)
+	err = store.ApplyNextSyncCommittee(&forged)
+	assert.True(t, errors.Is(err, ErrInvalidFinalityBranch), "got %v", err)
+
+	// Proving the forged finalized header against a forged attested header breaks the
+	// signature of the sync committee instead.
+	finalizedRoot, err := forged.finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	attestedStateRoot := branchRoot(finalizedRoot, forged.finalityBranch, uint64(FinalizedRootIndex)%64)
+	forged.attestedHeader.StateRoot = attestedStateRoot[:]
+	require.NoError(t, verifyFinality(&forged))
+	err = store.ApplyNextSyncCommittee(&forged)
+	assert.True(t, errors.Is(err, ErrInvalidSyncAggregateSignature), "got %v", err)
+
+	// Signed in a period the store has no committee for.
+	forged.signatureSlot += 2 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	err = store.ApplyNextSyncCommittee(&forged)
+	assert.True(t, errors.Is(err, ErrInvalidSignaturePeriod), "got %v", err)
+
+	// Without the execution branch, rather than panicking.
+	forged.exeFinalityBranch = nil
+	err = store.ApplyNextSyncCommittee(&forged)
+	assert.True(t, errors.Is(err, ErrInvalidFinalityBranch), "got %v", err)
+
+	assert.Equal(t, state.currentSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, state.nextSyncCommittee, store.nextSyncCommittee)
+	assert.Equal(t, state.finalizedHeader, store.finalizedHeader)
+}
+
+func TestLightClientStore_ApplyNextSyncCommittee_SingleSigner(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	forger := newSyntheticCommittee(t)
+	genesis := &LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	}
+	store := NewLightClientStore(genesis)
+
+	// A single member of the committee signs an attested header proving a finalized header
+	// and a next committee of the forger's choice.
+	forged := newSyntheticUpdate(t, forger, period620+32, period620+100, 1)
+	forged.syncAggregate = c.sign(t, &forged.attestedHeader, forged.signatureSlot, 1, mainnetGenesisValidatorsRoot(t))
+	err := store.ApplyNextSyncCommittee(forged)
+	assert.True(t, errors.Is(err, ErrInsufficientParticipation), "got %v", err)
+	assert.Equal(t, genesis.currentSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, false, store.hasNextSyncCommittee())
+	assert.Equal(t, genesis.finalizedHeader, store.finalizedHeader)
+
+	// One short of two thirds is still rejected, two thirds are enough.
+	forged.syncAggregate = c.sign(t, &forged.attestedHeader, forged.signatureSlot, 341, mainnetGenesisValidatorsRoot(t))
+	err = store.ApplyNextSyncCommittee(forged)
+	assert.True(t, errors.Is(err, ErrInsufficientParticipation), "got %v", err)
+	forged.syncAggregate = c.sign(t, &forged.attestedHeader, forged.signatureSlot, 342, mainnetGenesisValidatorsRoot(t))
+	require.NoError(t, store.ApplyNextSyncCommittee(forged))
+	assert.Equal(t, forger.committee, store.nextSyncCommittee)
+}
+
+// mainnetBootstrap returns the bootstrap of the finalized header of the fixture update, the
+// first checkpoint of period 620. Both sync committees sit next to each other in the state
+// tree, so the current committee branch is the next committee branch with its first node
+// replaced by the root of the next committee.
+func mainnetBootstrap(t *testing.T) (*LightClientBootstrap, [32]byte) {
+	nextRoot, err := SyncCommitteeRoot(&update.nextSyncCommittee)
+	require.NoError(t, err)
+	bootstrap := &LightClientBootstrap{
+		header:                     update.finalizedHeader,
+		currentSyncCommittee:       state.nextSyncCommittee,
+		currentSyncCommitteeBranch: append([][]byte{nextRoot[:]}, update.nextSyncCommitteeBranch[1:]...),
+		chainID:                    state.chainID,
+	}
+	root, err := bootstrap.header.HashTreeRoot()
+	require.NoError(t, err)
+	return bootstrap, root
+}
+
+func TestNewLightClientStoreFromBootstrap(t *testing.T) {
+	bootstrap, root := mainnetBootstrap(t)
+	store, err := NewLightClientStoreFromBootstrap(bootstrap, root, mainnetGenesisValidatorsRoot(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, update.finalizedHeader, store.finalizedHeader)
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, false, store.hasNextSyncCommittee())
+	assert.Equal(t, state.chainID, store.chainID)
+
+	// An update of the bootstrap period fills in the next committee.
+	require.NoError(t, store.ApplyNextSyncCommittee(&update))
+	assert.Equal(t, state.nextSyncCommittee, store.currentSyncCommittee)
+	assert.Equal(t, update.nextSyncCommittee, store.nextSyncCommittee)
+}
+
+func TestNewLightClientStoreFromBootstrap_Invalid(t *testing.T) {
+	bootstrap, root := mainnetBootstrap(t)
+
+	wrongRoot := root
+	wrongRoot[0] ^= 0xff
+	_, err := NewLightClientStoreFromBootstrap(bootstrap, wrongRoot, mainnetGenesisValidatorsRoot(t))
+	assert.Error(t, err)
+
+	tampered := *bootstrap
+	tampered.currentSyncCommitteeBranch = append([][]byte(nil), bootstrap.currentSyncCommitteeBranch...)
+	tampered.currentSyncCommitteeBranch[0] = make([]byte, 32)
+	_, err = NewLightClientStoreFromBootstrap(&tampered, root, mainnetGenesisValidatorsRoot(t))
+	assert.Error(t, err)
+
+	short := *bootstrap
+	short.currentSyncCommitteeBranch = bootstrap.currentSyncCommitteeBranch[1:]
+	_, err = NewLightClientStoreFromBootstrap(&short, root, mainnetGenesisValidatorsRoot(t))
+	assert.Error(t, err)
+
+	wrongCommittee := *bootstrap
+	wrongCommittee.currentSyncCommittee = update.nextSyncCommittee
+	_, err = NewLightClientStoreFromBootstrap(&wrongCommittee, root, mainnetGenesisValidatorsRoot(t))
+	assert.Error(t, err)
+
+	// The next committee proof does not prove the current committee.
+	nextBranch := *bootstrap
+	nextBranch.currentSyncCommitteeBranch = update.nextSyncCommitteeBranch
+	_, err = NewLightClientStoreFromBootstrap(&nextBranch, root, mainnetGenesisValidatorsRoot(t))
+	assert.Error(t, err)
+}
+
+// newSyntheticBootstrap builds a bootstrap whose header at slot is signed by the committee, which
+// is proven against the header's state root.
+func newSyntheticBootstrap(t *testing.T, c *syntheticCommittee, slot uint64) (*LightClientBootstrap, [32]byte) {
+	committeeRoot, err := SyncCommitteeRoot(&c.committee)
+	require.NoError(t, err)
+	branch := randBranch(t, int(CurrentSyncCommitteeDepth))
+	stateRoot := branchRoot(committeeRoot, branch, CurrentSyncCommitteeSubtreeIndex)
+	bootstrap := &LightClientBootstrap{
+		header: BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: randRoot(t),
+			StateRoot:  stateRoot[:],
+			BodyRoot:   randRoot(t),
+		},
+		currentSyncCommittee:       c.committee,
+		currentSyncCommitteeBranch: branch,
+		chainID:                    1,
+		signatureSlot:              slot + 1,
+	}
+	bootstrap.syncAggregate = c.sign(t, &bootstrap.header, bootstrap.signatureSlot, 500, mainnetGenesisValidatorsRoot(t))
+	root, err := bootstrap.header.HashTreeRoot()
+	require.NoError(t, err)
+	return bootstrap, root
+}
+
+func TestNewLightClientStoreFromBootstrap_GenesisValidatorsRoot(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	bootstrap, root := newSyntheticBootstrap(t, c, period620)
+
+	store, err := NewLightClientStoreFromBootstrap(bootstrap, root, mainnetGenesisValidatorsRoot(t))
+	require.NoError(t, err)
+	assert.Equal(t, mainnetGenesisValidatorsRoot(t), store.genesisValidatorsRoot)
+	require.NoError(t, store.ProcessUpdate(withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 500))))
+
+	// The Goerli root instead of the mainnet one.
+	goerli, err := newNetworkConfig(5)
+	require.NoError(t, err)
+	_, err = NewLightClientStoreFromBootstrap(bootstrap, root, goerli.GenesisValidatorsRoot)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "genesis validators root mismatch")
+
+	// Without a sync aggregate the root cannot be checked up front, but updates are verified
+	// under it.
+	unsigned := *bootstrap
+	unsigned.syncAggregate = SyncAggregate{}
+	store, err = NewLightClientStoreFromBootstrap(&unsigned, root, goerli.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Error(t, store.ProcessUpdate(withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 500))))
+}
+
+// syntheticCommittee is a sync committee of a preset whose secret keys are known, so tests can
+// produce valid sync aggregates.
+type syntheticCommittee struct {
+	keys      []bls.SecretKey
+	committee SyncCommittee
+	preset    *Preset
+}
+
+func newSyntheticCommittee(t *testing.T) *syntheticCommittee {
+	return newSyntheticCommitteeWithPreset(t, &MainnetPreset)
+}
+
+func newSyntheticCommitteeWithPreset(t *testing.T, preset *Preset) *syntheticCommittee {
+	c := &syntheticCommittee{preset: preset}
+	pubs := make([]bls.PublicKey, preset.SyncCommitteeSize)
+	for i := range pubs {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		c.keys = append(c.keys, priv)
+		pubs[i] = priv.PublicKey()
+		c.committee.Pubkeys = append(c.committee.Pubkeys, pubs[i].Marshal())
+	}
+	aggregate, err := bls.AggregateMultiplePubkeys(pubs)
+	require.NoError(t, err)
+	c.committee.AggregatePubkey = aggregate.Marshal()
+	return c
+}
+
+func randRoot(t *testing.T) []byte {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func randBranch(t *testing.T, n int) [][]byte {
+	branch := make([][]byte, n)
+	for i := range branch {
+		branch[i] = randRoot(t)
+	}
+	return branch
+}
+
+// branchRoot computes the root a branch proves leaf at the subtree index under.
+func branchRoot(leaf [32]byte, branch [][]byte, index uint64) [32]byte {
+	node := leaf
+	for i, h := range branch {
+		if index&(1<<uint(i)) != 0 {
+			node = sha256.Sum256(append(append([]byte{}, h...), node[:]...))
+		} else {
+			node = sha256.Sum256(append(append([]byte{}, node[:]...), h...))
+		}
+	}
+	return node
+}
+
+// newSyntheticUpdate builds an update for the mainnet chain with valid finality, next sync
+// committee and execution proofs, signed by the first participants members of the committee.
+// Slots follow the committee's preset.
+func newSyntheticUpdate(t *testing.T, c *syntheticCommittee, finalizedSlot, attestedSlot uint64, participants int) *LightClientUpdate {
+	u := &LightClientUpdate{
+		finalizedExeHeader:      types.Header{Number: big.NewInt(int64(finalizedSlot)), Difficulty: big.NewInt(0)},
+		exeFinalityBranch:       randBranch(t, int(ExecutionProofSize)),
+		finalityBranch:          randBranch(t, 6),
+		nextSyncCommittee:       c.committee,
+		nextSyncCommitteeBranch: randBranch(t, int(NextSyncCommitteeDepth)),
+		signatureSlot:           attestedSlot + 1,
+	}
+
+	exeRoot := branchRoot(u.finalizedExeHeader.Hash(), u.exeFinalityBranch[L1BeaconBlockBodyProofSize:], L2ExecutionPayloadTreeExecutionBlockIndex%(1<<L2ExecutionPayloadProofSize))
+	bodyRoot := branchRoot(exeRoot, u.exeFinalityBranch[:L1BeaconBlockBodyProofSize], L1BeaconBlockBodyTreeExecutionPayloadIndex%(1<<L1BeaconBlockBodyProofSize))
+	committeeRoot, err := SyncCommitteeRoot(&u.nextSyncCommittee)
+	require.NoError(t, err)
+	finalizedStateRoot := branchRoot(committeeRoot, u.nextSyncCommitteeBranch, NextSyncCommitteeSubtreeIndex)
+	u.finalizedHeader = BeaconBlockHeader{
+		Slot:       finalizedSlot,
+		ParentRoot: randRoot(t),
+		StateRoot:  finalizedStateRoot[:],
+		BodyRoot:   bodyRoot[:],
+	}
+
+	finalizedRoot, err := u.finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	stateRoot := branchRoot(finalizedRoot, u.finalityBranch, uint64(FinalizedRootIndex)%64)
+	u.attestedHeader = BeaconBlockHeader{
+		Slot:       attestedSlot,
+		ParentRoot: randRoot(t),
+		StateRoot:  stateRoot[:],
+		BodyRoot:   randRoot(t),
+	}
+
+	u.syncAggregate = c.sign(t, &u.attestedHeader, u.signatureSlot, participants, mainnetGenesisValidatorsRoot(t))
+	return u
+}
+
+// sign returns the sync aggregate of the first participants members of the committee over
+// header, signed at signatureSlot on the mainnet chain with the given genesis validators root.
+// The fork version of the signature slot follows the committee's preset.
+func (c *syntheticCommittee) sign(t *testing.T, header *BeaconBlockHeader, signatureSlot uint64, participants int, genesisValidatorsRoot [32]byte) SyncAggregate {
+	config, err := newNetworkConfig(1)
+	require.NoError(t, err)
+	forkVersion := config.ForkSchedule.ForkVersionAtEpoch(c.preset.computeEpochAtSlot(signatureSlot))
+	domain, err := ComputeDomain(DomainSyncCommittee, forkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	signingRoot, err := ComputeSigningRoot(header, domain)
+	require.NoError(t, err)
+
+	aggregate := SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512()}
+	sigs := make([]bls.Signature, participants)
+	for i := 0; i < participants; i++ {
+		aggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+		sigs[i] = c.keys[i].Sign(signingRoot[:])
+	}
+	sig, err := bls.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	aggregate.SyncCommitteeSignature = sig.Marshal()
+	return aggregate
+}
+
+func mainnetGenesisValidatorsRoot(t *testing.T) [32]byte {
+	config, err := newNetworkConfig(1)
+	require.NoError(t, err)
+	return config.GenesisValidatorsRoot
+}
+
+const period620 = 620 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+
+func TestLightClientStore_ProcessUpdate_KeepsBest(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+
+	low := newSyntheticUpdate(t, c, period620+32, period620+100, 400)
+	high := newSyntheticUpdate(t, c, period620+32, period620+110, 450)
+	later := newSyntheticUpdate(t, c, period620+32, period620+120, 450)
+
+	require.NoError(t, store.ProcessUpdate(low))
+	assert.Same(t, low, store.BestValidUpdate(620))
+
+	// More participation wins even though it attests to newer data.
+	require.NoError(t, store.ProcessUpdate(high))
+	assert.Same(t, high, store.BestValidUpdate(620))
+
+	// Same participation on newer data loses.
+	require.NoError(t, store.ProcessUpdate(later))
+	assert.Same(t, high, store.BestValidUpdate(620))
+
+	// An identical update does not replace the one received first.
+	duplicate := *high
+	require.NoError(t, store.ProcessUpdate(&duplicate))
+	assert.Same(t, high, store.BestValidUpdate(620))
+
+	assert.Nil(t, store.BestValidUpdate(621))
+}
+
+func TestLightClientStore_ProcessUpdate_RejectsInvalid(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+
+	badSignature := newSyntheticUpdate(t, c, period620+32, period620+100, 500)
+	badSignature.syncAggregate.SyncCommitteeBits.SetBitAt(0, false)
+	assert.Error(t, store.ProcessUpdate(badSignature))
+
+	badFinality := newSyntheticUpdate(t, c, period620+32, period620+100, 500)
+	badFinality.finalityBranch[0] = randRoot(t)
+	assert.Error(t, store.ProcessUpdate(badFinality))
+
+	noSupermajority := newSyntheticUpdate(t, c, period620+32, period620+100, 300)
+	assert.Error(t, store.ProcessUpdate(noSupermajority))
+
+	assert.Nil(t, store.BestValidUpdate(620))
+}
+
+// withoutFinality strips the finality and next sync committee proofs from an update, leaving
+// the signed attested header.
+func withoutFinality(u *LightClientUpdate) *LightClientUpdate {
+	u.finalizedHeader = BeaconBlockHeader{}
+	u.finalityBranch = nil
+	u.exeFinalityBranch = nil
+	u.nextSyncCommittee = SyncCommittee{}
+	u.nextSyncCommitteeBranch = nil
+	return u
+}
+
+func TestLightClientStore_ProcessUpdate_OptimisticOnly(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	start := BeaconBlockHeader{Slot: period620}
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      start,
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+	assert.Equal(t, start, store.OptimisticHeader())
+
+	optimistic := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 400))
+	require.NoError(t, store.ProcessUpdate(optimistic))
+	assert.Equal(t, optimistic.attestedHeader, store.OptimisticHeader())
+	assert.Equal(t, start, store.FinalizedHeader(), "Update without finality advanced the finalized header")
+
+	// An older attested header does not move the optimistic header back.
+	older := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+50, 500))
+	require.NoError(t, store.ProcessUpdate(older))
+	assert.Equal(t, optimistic.attestedHeader, store.OptimisticHeader())
+
+	// A next sync committee cannot be accepted without the finality proof it is checked against.
+	committeeOnly := newSyntheticUpdate(t, c, period620+32, period620+110, 400)
+	committeeOnly.finalityBranch = nil
+	assert.Error(t, store.ProcessUpdate(committeeOnly))
+	assert.Equal(t, optimistic.attestedHeader, store.OptimisticHeader())
+}
+
+func TestLightClientStore_ProcessUpdate_Finalized(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+
+	final := newSyntheticUpdate(t, c, period620+32, period620+100, 400)
+	require.NoError(t, store.ProcessUpdate(final))
+	assert.Equal(t, final.finalizedHeader, store.FinalizedHeader())
+	assert.Equal(t, final.attestedHeader, store.OptimisticHeader())
+
+	// An invalid finality branch advances neither header.
+	tampered := newSyntheticUpdate(t, c, period620+64, period620+200, 400)
+	tampered.finalityBranch[0] = randRoot(t)
+	assert.Error(t, store.ProcessUpdate(tampered))
+	assert.Equal(t, final.finalizedHeader, store.FinalizedHeader())
+	assert.Equal(t, final.attestedHeader, store.OptimisticHeader())
+}
+
+func TestLightClientStore_ProcessUpdate_Equivocation(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+
+	first := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 400))
+	require.NoError(t, store.ProcessUpdate(first))
+	// The same header signed again is not an equivocation.
+	again := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 450))
+	again.attestedHeader = first.attestedHeader
+	again.syncAggregate = first.syncAggregate
+	require.NoError(t, store.ProcessUpdate(again))
+
+	conflicting := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 500))
+	err := store.ProcessUpdate(conflicting)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrSyncCommitteeEquivocation))
+
+	var equivocation *SyncCommitteeEquivocationError
+	require.True(t, errors.As(err, &equivocation))
+	firstRoot, err := first.attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	secondRoot, err := conflicting.attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, period620+100, equivocation.Slot)
+	assert.Equal(t, firstRoot, equivocation.FirstRoot)
+	assert.Equal(t, secondRoot, equivocation.SecondRoot)
+
+	// The conflicting update is not kept, even though it has more participation.
+	assert.Same(t, first, store.BestValidUpdate(620))
+	assert.Equal(t, first.attestedHeader, store.OptimisticHeader())
+
+	// A conflicting header with an invalid signature is rejected as such.
+	forged := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+100, 500))
+	forged.syncAggregate.SyncCommitteeBits.SetBitAt(0, false)
+	err = store.ProcessUpdate(forged)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrSyncCommitteeEquivocation))
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	withBits := func(n int, attestedSlot uint64) *LightClientUpdate {
+		u := &LightClientUpdate{
+			attestedHeader:          BeaconBlockHeader{Slot: attestedSlot},
+			finalizedHeader:         BeaconBlockHeader{Slot: period620},
+			finalityBranch:          [][]byte{{0x01}},
+			nextSyncCommitteeBranch: [][]byte{{0x01}},
+			signatureSlot:           attestedSlot + 1,
+		}
+		u.syncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+		for i := 0; i < n; i++ {
+			u.syncAggregate.SyncCommitteeBits.SetBitAt(uint64(i), true)
+		}
+		return u
+	}
+
+	t.Run("Supermajority", func(t *testing.T) {
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, withBits(342, period620+10), withBits(341, period620)))
+		assert.Equal(t, false, isBetterUpdate(&MainnetPreset, withBits(341, period620), withBits(342, period620+10)))
+	})
+
+	t.Run("ParticipationBelowSupermajority", func(t *testing.T) {
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, withBits(300, period620+10), withBits(200, period620)))
+	})
+
+	t.Run("RelevantSyncCommittee", func(t *testing.T) {
+		relevant := withBits(400, period620+10)
+		missing := withBits(500, period620)
+		missing.nextSyncCommitteeBranch = [][]byte{make([]byte, 32)}
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, relevant, missing))
+
+		// Signed in the following period, the committee is not the relevant one.
+		crossPeriod := withBits(500, period620+8191)
+		crossPeriod.signatureSlot = period620 + 8192
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, relevant, crossPeriod))
+	})
+
+	t.Run("Finality", func(t *testing.T) {
+		final := withBits(400, period620+10)
+		notFinal := withBits(500, period620)
+		notFinal.finalityBranch = nil
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, final, notFinal))
+		assert.Equal(t, false, isBetterUpdate(&MainnetPreset, notFinal, final))
+	})
+
+	t.Run("SyncCommitteeFinality", func(t *testing.T) {
+		samePeriod := withBits(400, period620+10)
+		previousPeriod := withBits(500, period620)
+		previousPeriod.finalizedHeader.Slot = period620 - 1
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, samePeriod, previousPeriod))
+	})
+
+	t.Run("Tiebreakers", func(t *testing.T) {
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, withBits(450, period620+10), withBits(400, period620)))
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, withBits(400, period620), withBits(400, period620+10)))
+		older := withBits(400, period620)
+		older.signatureSlot = period620 + 5
+		assert.Equal(t, true, isBetterUpdate(&MainnetPreset, withBits(400, period620), older))
+		assert.Equal(t, false, isBetterUpdate(&MainnetPreset, withBits(400, period620), withBits(400, period620)))
+	})
+}
+
+func TestLightClientStore_ValidateUpdate(t *testing.T) {
+	// The fixture update is signed in period 620 by the next sync committee of the fixture state
+	// finalized in period 619, and proves the committee of period 621.
+	store := NewLightClientStore(&state)
+	require.NoError(t, store.ValidateUpdate(&update, update.signatureSlot))
+	require.NoError(t, store.ValidateUpdate(&update, update.signatureSlot+1))
+
+	assert.Equal(t, state.finalizedHeader, store.FinalizedHeader(), "Validation modified the store")
+}
+
+func TestLightClientStore_ValidateUpdate_InsufficientParticipation(t *testing.T) {
+	store := NewLightClientStore(&state)
+	u := update
+	u.syncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+
+	err := store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInsufficientParticipation), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_InvalidSlots(t *testing.T) {
+	store := NewLightClientStore(&state)
+
+	err := store.ValidateUpdate(&update, update.signatureSlot-1)
+	assert.True(t, errors.Is(err, ErrInvalidUpdateSlots), "got %v", err)
+
+	u := update
+	u.signatureSlot = u.attestedHeader.Slot
+	err = store.ValidateUpdate(&u, update.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidUpdateSlots), "got %v", err)
+
+	u = update
+	u.finalizedHeader.Slot = u.attestedHeader.Slot + 1
+	err = store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidUpdateSlots), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_InvalidSignaturePeriod(t *testing.T) {
+	// Without the next sync committee, an update signed in period 620 is out of reach.
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      state.finalizedHeader,
+		currentSyncCommittee: state.currentSyncCommittee,
+		chainID:              state.chainID,
+	})
+	err := store.ValidateUpdate(&update, update.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidSignaturePeriod), "got %v", err)
+
+	// With it, period 622 still skips a period.
+	store = NewLightClientStore(&state)
+	u := update
+	u.signatureSlot += 2 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	err = store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidSignaturePeriod), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_Irrelevant(t *testing.T) {
+	// A store of period 620 that is already finalized at the attested header and knows the next
+	// sync committee.
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      update.attestedHeader,
+		currentSyncCommittee: state.nextSyncCommittee,
+		nextSyncCommittee:    update.nextSyncCommittee,
+		chainID:              state.chainID,
+	})
+	err := store.ValidateUpdate(&update, update.signatureSlot)
+	assert.True(t, errors.Is(err, ErrIrrelevantUpdate), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_InvalidFinalityBranch(t *testing.T) {
+	store := NewLightClientStore(&state)
+
+	u := update
+	u.finalityBranch = append([][]byte(nil), update.finalityBranch...)
+	u.finalityBranch[0] = randRoot(t)
+	err := store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidFinalityBranch), "got %v", err)
+
+	// A finalized header must come with its finality branch.
+	u = update
+	u.finalityBranch = nil
+	err = store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidFinalityBranch), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_InvalidNextSyncCommitteeBranch(t *testing.T) {
+	store := NewLightClientStore(&state)
+
+	u := update
+	u.nextSyncCommitteeBranch = append([][]byte(nil), update.nextSyncCommitteeBranch...)
+	u.nextSyncCommitteeBranch[0] = randRoot(t)
+	err := store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidNextSyncCommitteeBranch), "got %v", err)
+
+	// A next sync committee must come with its branch.
+	u = update
+	u.nextSyncCommitteeBranch = nil
+	err = store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidNextSyncCommitteeBranch), "got %v", err)
+
+	// An update of the store's period must agree with the next sync committee already known.
+	start := update.finalizedHeader
+	start.Slot = 620 * EpochsPerSyncCommitteePeriod * SlotsPerEpoch
+	store = NewLightClientStore(&LightClientState{
+		finalizedHeader:      start,
+		currentSyncCommittee: state.nextSyncCommittee,
+		nextSyncCommittee:    state.currentSyncCommittee,
+		chainID:              state.chainID,
+	})
+	err = store.ValidateUpdate(&update, update.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidNextSyncCommitteeBranch), "got %v", err)
+}
+
+func TestLightClientStore_ValidateUpdate_InvalidSignature(t *testing.T) {
+	store := NewLightClientStore(&state)
+
+	// Dropping a participant leaves the signature without one of its signers.
+	u := update
+	u.syncAggregate.SyncCommitteeBits = append(bitfield.Bitvector512(nil), update.syncAggregate.SyncCommitteeBits...)
+	for i := uint64(0); i < u.syncAggregate.SyncCommitteeBits.Len(); i++ {
+		if u.syncAggregate.SyncCommitteeBits.BitAt(i) {
+			u.syncAggregate.SyncCommitteeBits.SetBitAt(i, false)
+			break
+		}
+	}
+	err := store.ValidateUpdate(&u, u.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidSyncAggregateSignature), "got %v", err)
+
+	// Signed by the current instead of the next sync committee.
+	store = NewLightClientStore(&LightClientState{
+		finalizedHeader:      state.finalizedHeader,
+		currentSyncCommittee: state.currentSyncCommittee,
+		nextSyncCommittee:    state.currentSyncCommittee,
+		chainID:              state.chainID,
+	})
+	err = store.ValidateUpdate(&update, update.signatureSlot)
+	assert.True(t, errors.Is(err, ErrInvalidSyncAggregateSignature), "got %v", err)
+}
+
+func TestLightClientStore_Presets(t *testing.T) {
+	for name, preset := range map[string]Preset{"Mainnet": MainnetPreset, "Minimal": MinimalPreset} {
+		preset := preset
+		t.Run(name, func(t *testing.T) {
+			slotsPerPeriod := preset.EpochsPerSyncCommitteePeriod * preset.SlotsPerEpoch
+			// The first period after the Bellatrix fork, light client updates are rejected before it.
+			period := 144896/preset.EpochsPerSyncCommitteePeriod + 1
+			start := period * slotsPerPeriod
+			supermajority := int(preset.SyncCommitteeSize*2/3 + 1)
+
+			c := newSyntheticCommitteeWithPreset(t, &preset)
+			store := NewLightClientStoreWithPreset(&LightClientState{
+				finalizedHeader:      BeaconBlockHeader{Slot: start},
+				currentSyncCommittee: c.committee,
+				chainID:              1,
+			}, preset)
+
+			u := newSyntheticUpdate(t, c, start+slotsPerPeriod/4, start+slotsPerPeriod/2, supermajority)
+			require.NoError(t, store.ValidateUpdate(u, u.signatureSlot))
+			require.NoError(t, store.ProcessUpdate(u))
+			assert.Same(t, u, store.BestValidUpdate(period))
+			assert.Equal(t, u.finalizedHeader, store.FinalizedHeader())
+			assert.Equal(t, u.attestedHeader, store.OptimisticHeader())
+
+			// Half of the preset's committee is no supermajority.
+			half := newSyntheticUpdate(t, c, start+slotsPerPeriod/4+1, start+slotsPerPeriod/2+1, int(preset.SyncCommitteeSize/2))
+			require.NoError(t, store.ValidateUpdate(half, half.signatureSlot))
+			assert.Error(t, store.ProcessUpdate(half))
+
+			// Signed in the preset's next period before the next committee is known.
+			next := newSyntheticUpdate(t, c, start+slotsPerPeriod+1, start+slotsPerPeriod+2, supermajority)
+			err := store.ValidateUpdate(next, next.signatureSlot)
+			assert.True(t, errors.Is(err, ErrInvalidSignaturePeriod), "got %v", err)
+
+			require.NoError(t, store.ApplyNextSyncCommittee(u))
+			assert.Equal(t, true, store.hasNextSyncCommittee())
+			require.NoError(t, store.ValidateUpdate(next, next.signatureSlot))
+		})
+	}
+}
+
+func TestGetParticipantPubkeys_PresetMismatch(t *testing.T) {
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	u := newSyntheticUpdate(t, c, period620+1, period620+2, 20)
+
+	participants, err := getParticipantPubkeys(&MinimalPreset, c.committee.Pubkeys, u.syncAggregate.SyncCommitteeBits)
+	require.NoError(t, err)
+	assert.Len(t, participants, 20)
+
+	_, err = getParticipantPubkeys(&MainnetPreset, c.committee.Pubkeys, u.syncAggregate.SyncCommitteeBits)
+	assert.Error(t, err)
+}
+
+func TestLightClientStore_MarshalBinary(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	store := NewLightClientStore(&LightClientState{
+		finalizedHeader:      BeaconBlockHeader{Slot: period620, ParentRoot: randRoot(t), StateRoot: randRoot(t), BodyRoot: randRoot(t)},
+		currentSyncCommittee: c.committee,
+		chainID:              1,
+	})
+	final := newSyntheticUpdate(t, c, period620+32, period620+100, 400)
+	require.NoError(t, store.ProcessUpdate(final))
+	require.NoError(t, store.ApplyNextSyncCommittee(final))
+	optimistic := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+110, 450))
+	require.NoError(t, store.ProcessUpdate(optimistic))
+
+	enc, err := store.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, LightClientStoreVersion, enc[0])
+
+	var decoded LightClientStore
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	assert.Equal(t, store.FinalizedHeader(), decoded.FinalizedHeader())
+	assert.Equal(t, store.OptimisticHeader(), decoded.OptimisticHeader())
+	assert.Equal(t, store.currentSyncCommittee, decoded.currentSyncCommittee)
+	assert.Equal(t, store.nextSyncCommittee, decoded.nextSyncCommittee)
+	assert.Equal(t, store.chainID, decoded.chainID)
+	assert.Equal(t, store.genesisValidatorsRoot, decoded.genesisValidatorsRoot)
+	assert.Equal(t, store.chainPreset(), decoded.chainPreset())
+	assert.Equal(t, store.attestedRoots, decoded.attestedRoots)
+
+	best := decoded.BestValidUpdate(620)
+	require.NotNil(t, best)
+	assert.Equal(t, final.attestedHeader, best.attestedHeader)
+	assert.Equal(t, final.finalizedHeader, best.finalizedHeader)
+	assert.Equal(t, final.finalityBranch, best.finalityBranch)
+	assert.Equal(t, final.nextSyncCommittee, best.nextSyncCommittee)
+	assert.Equal(t, final.syncAggregate, best.syncAggregate)
+	assert.Equal(t, final.finalizedExeHeader.Hash(), best.finalizedExeHeader.Hash())
+	assert.Equal(t, final.signatureSlot, best.signatureSlot)
+
+	reenc, err := decoded.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, enc, reenc)
+
+	// The decoded store still detects a conflicting header at a slot it has seen.
+	conflicting := withoutFinality(newSyntheticUpdate(t, c, period620+32, period620+110, 500))
+	assert.True(t, errors.Is(decoded.ProcessUpdate(conflicting), ErrSyncCommitteeEquivocation))
+
+	// A preset is kept.
+	minimal := NewLightClientStoreWithPreset(&LightClientState{chainID: 1}, MinimalPreset)
+	enc, err = minimal.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	require.NotNil(t, decoded.preset)
+	assert.Equal(t, MinimalPreset, *decoded.preset)
+	assert.Nil(t, decoded.BestValidUpdate(620))
+}
+
+func TestLightClientStore_UnmarshalBinary_Invalid(t *testing.T) {
+	store := NewLightClientStore(&LightClientState{chainID: 1})
+	enc, err := store.MarshalBinary()
+	require.NoError(t, err)
+
+	unknown := append([]byte{LightClientStoreVersion + 1}, enc[1:]...)
+	var decoded LightClientStore
+	err = decoded.UnmarshalBinary(unknown)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUnsupportedStoreVersion), "got %v", err)
+
+	assert.Error(t, decoded.UnmarshalBinary(nil))
+	assert.Error(t, decoded.UnmarshalBinary(enc[:len(enc)-1]))
+}
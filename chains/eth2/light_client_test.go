@@ -4,7 +4,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
 )
@@ -1644,15 +1647,196 @@ func TestVerifyFinality(t *testing.T) {
 }
 
 func TestVerifyNextSyncCommittee(t *testing.T) {
-	err := verifyNextSyncCommittee(&state, &update)
+	err := verifyNextSyncCommittee(&MainnetPreset, &state, &update)
 	assert.Nil(t, err)
 }
 
 func TestVerifyBlsSignatures(t *testing.T) {
-	err := verifyBlsSignatures(&state, &update)
+	config, err := newNetworkConfig(state.chainID)
+	require.NoError(t, err)
+	err = verifyBlsSignatures(config, &state, &update)
 	assert.Nil(t, err)
 }
 
+func TestVerifySyncCommitteeSignature(t *testing.T) {
+	// The update is signed in the period after the finalized one, by the next sync committee.
+	config, err := newNetworkConfig(state.chainID)
+	require.NoError(t, err)
+	domain, err := ComputeDomain(DomainSyncCommittee, *config.computeForkVersionBySlot(update.signatureSlot), config.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	blockRoot, err := update.attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	participants, err := getParticipantPubkeys(&MainnetPreset, state.nextSyncCommittee.Pubkeys, update.syncAggregate.SyncCommitteeBits)
+	require.NoError(t, err)
+	sig, err := bls.SignatureFromBytes(update.syncAggregate.SyncCommitteeSignature)
+	require.NoError(t, err)
+
+	ok, err := VerifySyncCommitteeSignature(participants, blockRoot, domain, sig)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	// A signature over a different root, under a different domain or from a different set
+	// of signers is rejected without an error.
+	ok, err = VerifySyncCommitteeSignature(participants, [32]byte{0x01}, domain, sig)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	ok, err = VerifySyncCommitteeSignature(participants, blockRoot, [32]byte{}, sig)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	ok, err = VerifySyncCommitteeSignature(participants[1:], blockRoot, domain, sig)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	_, err = VerifySyncCommitteeSignature(nil, blockRoot, domain, sig)
+	assert.Error(t, err)
+	_, err = VerifySyncCommitteeSignature([]bls.PublicKey{}, blockRoot, domain, sig)
+	assert.Error(t, err)
+	_, err = VerifySyncCommitteeSignature(participants, blockRoot, domain, nil)
+	assert.Error(t, err)
+}
+
+func TestVerifySyncAggregatesBatch(t *testing.T) {
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	committee := make([]bls.PublicKey, len(c.keys))
+	for i, key := range c.keys {
+		committee[i] = key.PublicKey()
+	}
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+
+	const blocks = 10
+	committees := make([][]bls.PublicKey, blocks)
+	bits := make([][]byte, blocks)
+	roots := make([][32]byte, blocks)
+	sigs := make([]bls.Signature, blocks)
+	for i := 0; i < blocks; i++ {
+		copy(roots[i][:], randRoot(t))
+		signingRoot, err := signingData(func() ([32]byte, error) { return roots[i], nil }, domain[:])
+		require.NoError(t, err)
+
+		// Each block has its own participants, the first 20+i members.
+		committees[i] = committee
+		bits[i] = make([]byte, len(committee)/8)
+		var memberSigs []bls.Signature
+		for j := 0; j < 20+i; j++ {
+			bits[i][j/8] |= 1 << uint(j%8)
+			memberSigs = append(memberSigs, c.keys[j].Sign(signingRoot[:]))
+		}
+		sigs[i], err = bls.AggregateSignatures(memberSigs)
+		require.NoError(t, err)
+	}
+
+	ok, err := VerifySyncAggregatesBatch(committees, bits, roots, sigs, domain)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	// One bad block fails the whole batch.
+	badRoots := append([][32]byte(nil), roots...)
+	badRoots[6][0] ^= 0x01
+	ok, err = VerifySyncAggregatesBatch(committees, bits, badRoots, sigs, domain)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	badBits := append([][]byte(nil), bits...)
+	badBits[3] = append([]byte(nil), bits[3]...)
+	badBits[3][0] ^= 0x01
+	ok, err = VerifySyncAggregatesBatch(committees, badBits, roots, sigs, domain)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	ok, err = VerifySyncAggregatesBatch(committees, bits, roots, sigs, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	// Malformed batches are errors.
+	_, err = VerifySyncAggregatesBatch(nil, nil, nil, nil, domain)
+	assert.Error(t, err)
+	_, err = VerifySyncAggregatesBatch(committees, bits, roots[1:], sigs, domain)
+	assert.Error(t, err)
+	emptyBits := append([][]byte(nil), bits...)
+	emptyBits[0] = make([]byte, len(committee)/8)
+	_, err = VerifySyncAggregatesBatch(committees, emptyBits, roots, sigs, domain)
+	assert.Error(t, err)
+	nilSigs := append([]bls.Signature(nil), sigs...)
+	nilSigs[9] = nil
+	_, err = VerifySyncAggregatesBatch(committees, bits, roots, nilSigs, domain)
+	assert.Error(t, err)
+}
+
+func TestSyncAggregate_Verify(t *testing.T) {
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	domain := [32]byte{0x07, 0x00, 0x00, 0x00, 0x01}
+	var root [32]byte
+	copy(root[:], randRoot(t))
+	signingRoot, err := signingData(func() ([32]byte, error) { return root, nil }, domain[:])
+	require.NoError(t, err)
+
+	// aggregate returns the sync aggregate signed by the first n members.
+	aggregate := func(n int) *SyncAggregate {
+		bits := make([]byte, len(c.keys)/8)
+		memberSigs := make([]bls.Signature, 0, n)
+		for i := 0; i < n; i++ {
+			bits[i/8] |= 1 << uint(i%8)
+			memberSigs = append(memberSigs, c.keys[i].Sign(signingRoot[:]))
+		}
+		sig := bls.NewAggregateSignature()
+		if n > 0 {
+			sig, err = bls.AggregateSignatures(memberSigs)
+			require.NoError(t, err)
+		}
+		return &SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: sig.Marshal()}
+	}
+
+	full := aggregate(len(c.keys))
+	ok, err := full.Verify(&c.committee, root, domain)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	supermajority := aggregate(len(c.keys)*2/3 + 1)
+	require.Equal(t, true, HasSupermajorityParticipation(supermajority.SyncCommitteeBits, len(c.keys)))
+	ok, err = supermajority.Verify(&c.committee, root, domain)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	// A signature over a different root, under a different domain or from other signers is
+	// rejected without an error.
+	ok, err = supermajority.Verify(&c.committee, [32]byte{0x01}, domain)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	ok, err = supermajority.Verify(&c.committee, root, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	otherSigners := &SyncAggregate{SyncCommitteeBits: full.SyncCommitteeBits, SyncCommitteeSignature: supermajority.SyncCommitteeSignature}
+	ok, err = otherSigners.Verify(&c.committee, root, domain)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	_, err = aggregate(0).Verify(&c.committee, root, domain)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no sync committee participants")
+
+	// The mainnet sized bits of a minimal committee, or the other way round, are malformed.
+	mainnetBits := &SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: full.SyncCommitteeSignature}
+	mainnetBits.SyncCommitteeBits.SetBitAt(0, true)
+	_, err = mainnetBits.Verify(&c.committee, root, domain)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cover 512 members, but committee has 32")
+	_, err = full.Verify(&update.nextSyncCommittee, root, domain)
+	assert.Error(t, err)
+	_, err = full.Verify(nil, root, domain)
+	assert.Error(t, err)
+
+	// The fixture update, signed by the next sync committee of the state.
+	config, err := newNetworkConfig(state.chainID)
+	require.NoError(t, err)
+	fixtureDomain, err := ComputeDomain(DomainSyncCommittee, *config.computeForkVersionBySlot(update.signatureSlot), config.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	blockRoot, err := update.attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	ok, err = update.syncAggregate.Verify(&state.nextSyncCommittee, blockRoot, fixtureDomain)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+}
+
 func TestDecodeLightClientVerify(t *testing.T) {
 	data, err := hexutil.Decode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000006c2000000000000000000000000000000000000000000000000000000000004d807f000000000000000000000000000000000000000000000000000000000006b56597bc7b137c043fe27bef204a448bd8888006644a47b96e21f08468b25d446c71efcac4c6ad712400069da743e25c17ae6074aa6f4cca64a633e9cd4764577dc4a97cf41bb17792aa6e1a65114265fc805ac18fcf2f9e989cb68f8e8eecae45f3000000000000000000000000000000000000000000000000000000000000022000000000000000000000000000000000000000000000000000000000000062e000000000000000000000000000000000000000000000000000000000004d8020000000000000000000000000000000000000000000000000000000000000b99c5e98473d9dd7cbe5e7047348a7f45108907616de07dd75d38d0cd889930717d156ff9620eb3511550d3cc245e8f857a9397d10c3c73ce13aa13d6c745a26753b773123c0258d49d333dbd1052390d266bd7e47fbc4ba320b376277526b42f74700000000000000000000000000000000000000000000000000000000000063a0000000000000000000000000000000000000000000000000000000000000648000000000000000000000000000000000000000000000000000000000000069a00000000000000000000000000000000000000000000000000000000000006ac000000000000000000000000000000000000000000000000000000000004d80800000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000606000000000000000000000000000000000000000000000000000000000000060008e9cde634bc00ab39e67c84b7dd4c72470b9f05474aa9469c9c09086f2e9b06115dd7ab58f89b3198863eba8170036138495301daad17809152bac675fc361d6c59468f24d4dc69fa0307edd4097de0645760f3c362a14485f69107bb73ba291999acfeeb5d30a3a07cda9496886f5281cea1905ef86b1c4ee7ccf8725067a546017740d17cd40ca7abe3fa27d7dac69aebbf52cb2a2a64a52491d5b5b117cba35ec6cc4fb1c10edc36de4380f0ef8d550d7319eda2e33a0b4cc80af36010df2a2caa1af852c8c8e6487c160dd9f96edbeb87fa51f311c6d83977318b81a8b01639bbc61378fa385d75fe9c14d0ab429a6986824b5112a02df2e2a768df0fabb5407affc39ec6741de5b99791615f0121738d655d87025a443c92b75e16e5498b8e6083f75e91a85b9a6fdfea1c048c1af8564eff4906c35b01146eb72f03e51c276893507bc0d14207946b241c936068089fe85230c87ded243d097c7694d4437afa3826049b47e04b726b75a6bc566fd1c25aef872d7f1ec91844e18407f628e954d52c68ea4a862957742a15bc46044a682e3f54afbe1eb24c1baa314ec1815bebf550e22f82a02a49555c6206448af5b4e5b05ceb1127aa466c760c968c39494ef30f312e3a97d5da5131733f6556e9e214087119369335cc928298128c9a12bcbdb57fb851f0fa9ea0e1caf0929e8bef55ac71bb236c70124abae06581bff34a2b93d8a063e2bd42711ff535316b8d5ccc403b3782d6d2c9465e53b57b687a8fa4466bbed533c1a8e95b05b3c7716fb29db0bbf6270951552de0c7ba0d4846df9e83f46596ecbb67008b661e1d4c71cc4a9e19a1a78afec6f43fd0be76c57a8672089f0e86465b44489d01754999879bbbd5d2d2a690d4fa48a51359b4aa3026acbedfabda54b87e1ee34825e52ffcc4baf701ad2f8c898396817807a3688f6a26d1f7c094f3e3666dc76cbe1d44b21aac69b178957cd823c0d444d625d8aa3b0085522a937de4bf721eba9fab196e727317ac571b30ce8cfabb8a601e86fafb2f54c0a617c3883ed217b9cd66b4d260c20d4e33c144c142a8f19344fbba354577e1da0a8b53b54bf8cb4fe0a6cd188bb3349174a0a8d6cf888c5cf50790c65efc435e0a3f573a306f4706fa46d8d0bcc9767c0a11861ad50ce30864c2519ae6f9a259a671928ebb0d279d47705d8b7065a236702b0e6cfc809f9c1219b86af508db3b026fe96422985c3128930beeb0a0ddc2892d5a50d28e0dbca2abaaa8f08f477028498a00311b3b20adb6187d8c11759da1fd19e2c40f3d4b9fb8b2829f6c68901b76f90ed3d4ab002b3bcb4373595e2a31986a34d8bd4fb2745feaa7617928a46f7658464cbb2b2ca985dcd3ec4c06d63bdf95454046d011a3379c09d733f6602f8eb4434ded90081f36c890776272667e0378d18b117607d06745d73639b4f018680b7070d0ad7308fd895a7cdbdefe1ac25e9f9b61d3a4ac4fea18a452e753ae9fa15bb5b147186e096a65fb359b98f1f144aa726ee99ad6dfc6f424cda48fda4b6f06a37a6dc5edd5980b2696ce40bc72e7e029e94e9441f14aa85b3c7cc620f1a533c8b10953c89a8e309d25261d0117adde4a470049a1190ae2703a7bd5355dbdf605492339298af68ced28ddedda9662dff36840f75bbb9bef69e9acc197df396ccc84c1cc734f9a7d0b3dc0c0714f7abc825fe2235fb60639925d694480c4f5cf78388b8932e50dc79244b517afb88ff888ffdb4594723b0171184c5b84496439385683dfed20ce590f5caf93dfc2e00f85bc493f86d83fe2a82a030b70dd96e314d7a5bd7d131aa3d7e32177b2b15468beab7f9d9ae17a98a1e3b8d01f9b61a65ab360c1333c22cbf34bb2b171757973f16691001afe09813c6d6569c01da8b9f8e897d475b01b6ca83239979809fda314f4b8943fe6c5b224bbbac251d10bc83af04ec45e75a8ea1288a171abb9ab2a7776c40ff8e5d1af95240fb0c1d9fb48825c25d0c1f0fb9384c6abd77c973d4e8bfa126c2c7c7a2577d1e5c7e1275d45cd987b1dd4227395eccf8bb1c9db8806f11d46ca143927a594f431f6130370346962578ca73e68a43dc1309e774505971da29f4527b5804e6cf3782553a5000ee3b4f8cf56e7b2a320bff6feaf80f917ec127d7fd1773a23331768a663dbc6955d82db038cd3f5ef9d87014acb020ab9a9d1be0f7c86ef23992ca73ecdc8171ea16cb47570107abab610b9ce0edb1a92c0934aee0f2aa6410b8c57fbe3363f8fd4cbfbe71d6c04cc19efb99d0e4ab6de4ff584190be1b83bf727e90cee8b8e41f1c1d88e78ea39c3733daf2534e3b1d6924bcd6b94f6c57db06a1c72b100b59b6deb8fb981107ade5f8d714002395d2598ca7f1c96e14666c17fafd373c92f3a105bda62cf2fa78f276032aae2b0b9badd91bf7280646a90d17f63d18275eb86679d9299f054104997f091f7276bfb057847101ddbd86df4f9af98ea11f3d24c48a60184881c093e80ec75d64f2c0cfc702117468f9b9833b27c210c44409927c70d9ed369e68cdbe0625a21639af0eaa0765153da209a609f408f32cdd54e6e7e79bc66de2c78c1f75fc639251ba4deed91b0228e10efdc3ba9f51f5464d4bc430c7cf4ab4a6835ff6f383d6fbe9208e9c72a4743add96f32ac145b9dc25968ccd746ec7ea92a2e178344061cb3b90796dbedd86ab8780fa4c45710670245852ee860612c3eced2d29b6cfd17cfd0a01baf47d6baaa29697c4b227c4b7acd922428a38506ca5a5530852197ecc3b84522341c9dca0e17ec69b474d6cbdfdc3fe2699b69128c4ca931269cf35bd468743d4b93d853c3bb62264ad7b47d2cfa351b7d7ddd5cec33044e2635191c39b169ef5a86a98e5a2d271c7a6ac788deab20e50f6a055862f8cab80ee0ec31c007f86f8123592392cdd58ce896b26a4ad8d5fc3c21098771f1e1a3682178c10c3808880944f56a89098ad91647cf042c51982336fa05550228e6a5bae74200dc91c96adc46350776a5fca0b6b9ba48be6608d3da165622a09b1786ab055d429fa5854c50fe5d9253ae4cf3a45cc2a114aa08eca03034394a5c1efd04addde75e5cf76594d7168835c98477c7f4eaab57dcbd68b277f645a4f9bd85ae043b74672b154193f2be1bcbac75c5d13deba4d8b187f7c89c999330f8bc09160e478ecacbe8138f90b572ce0e2455603c5692676c4fd0ed68875bb6d7fae1318aa0d7fcf7f3181b2d070fc7da956a8eae63870f3f038884634af6cf5699aca04802171118741d8383e395287b65387fdfc306a0763c303f51c8570bb90e42ababbaa03d55eb563109ccf38d260c6fc605bdec422a57e9ae4ea0af1a151dfe42ded4e1bfbecbf6374a28aa828a83589cb63854c3e0d5ec4db1853b3c18e1e7326f2d61e08eb50569ba7bdd1218480a0ba55a9d9ddddabcde36b5f9c3ab5412c0263408c0e2b56680f5eb81af967c6f76503231501858497e1729d4241affdb62d956920ccfd094488aeb87173b40e9b8a392cc483f519da4e551b06827e86f7738f51b50d1b0a76f7ee34b549e5660ce615a3a57a28959cd5826812d6941f68b95c38365330ec323a95dc5d6d07fa0f80fba2ba1310909e79e60ff73cb7d993e7bd2522374cc59a2b9ee6586780a18d0b34117ea7f8caf6ff135b61fcb30b3ae94a3de6de953a32d09c73b004507642f5f48151d1b78e3084874f9ac3a21e116d795d7962080ee607f136d4e7734756905d5c96cd5e7205c6df7f13c863508a336d9be6943a60428a7bb5a6ce8e9c2d9a917bfa17236b910acd8b24eddb73fd090af4e23707512e9d08efa0596ec5b79d45150f65268b46f5027c37a6b97121bf7310307c0563a7c60ecf724cdd04b176700c6a7576d437505382dfdb03187a79a0f8df9fd1e963fe46ff5d29968de5d73a5221c86219e3a811d252029625ef63ba4d2c7661cd0b64bdaaeb9b8af3106b5c3190f03b32ee61c2d59b948026ee961213b52d5eefceab10d539f57bae33e55a4c106aebec80d9d4d4ec453d613054c9e147a22ead1e6db0c36b59940625815180295098e9e2f42247dfdb0f1bfb21dc4fab4dba0cc0ea937fed5b1e8fd1f373273833fa164af839b6b6db924ab64036ee702ac3b4e5cf8964486270edbf7b465bbabffd704fea43e38ba8432cab9d4ddb9eac551fecd74c5f316fb9c483bc973b1d93d4bc099fbae99548dfb9b32ef195afd421dec2d86a5368a803c1bb21df7348e9ce315a0c5abca91d928bc534385f2d39274e311c9f8af73ce30e096fd62200d5718d1e38be26c9ee4f3040baaf1329803dce22d257bfa3048df0276ff92752e8a1591a8e66788de6d39b331a3a65048f00cadc43e72031baf3fc4c12ec3bd22bbb2faef1def8e9e6aa9508d1134159d022fefc8bb14c447e1c21caf1bde924ed76df1b5f384c9cca68ce418602e029e605abd28412c96783a2d12565e516f6384d3beb9d7347a08834b805bf2501a42f86ec5948cb51ec8ae14a48d09332d373e0a4646d99aff793ae999f2d2113f53d335c132a356305cd65b19dac739d687738625bac78a99b4d476a60694827e9e3f7b0ca43f38e8870b72a885c4c6e318c54ac1d714d62dcd74e5391a7dca178bc9f7a7451f27cdf8e1c594d97229fc87b3442662282a1433eb50b4058bc8dc96c4e646f0e33f39c6f4e85a91964af3e406e1429df1b94f62a152378df91efc53f41a2459f6ef33f2cb5e9b128b8571023b03d1af45675ad405e68ba9304a971c461cf06e8692e8b9eeb1dc50bc1672c3667561a1c86e5bd688789689f98a9bbe872d95c84b039c854c65bd6fe1e0c30c5d3335c919a3af8aca0e4acc327bed09384e2717dd9f4009793cbf4380c9a692247bbd3d840d9b87f3b9f93fbd8349a0a56d597dd88fde8bb45b12b661307b19962698c1303112f8b84de7c5ae0ab17d8d127b028e4e5ab1b0eed5fcd58538a9399ae30eba4e54c5e4c58cc1ba3a5de663e32676db42ff46683ce379da5ecdbf119352b3a5e81e423b9ef429d86c9782e0d741337ff5d59c4f1165266b666a525b5c2babc909b59548972061fbfc806178048fd7f3f2e17a45370efc9c460201fdbf978935d7f73e1ed923d64664b3dfbb701c64991af0d55b949ee67369ff41ce0a2fc1d1d374f3f549989df28b759192f6ceaa4ecfaa1d0e8dc8920345372f7e4b78bacadb79ac0855fc3648d7ef1f99201d0ade1d5636ded5bcaf966741e1713589f3090c4088261f63ec2d9b21c9bed1e7864b03abcf087cf843e501d0c7981008955c98e5102617836c04602078f562c595f9ffc5d0f52d338f62858ac9d046c615b1895fc21826fa5d2a9462cc7b25776105c675bd6d8c7664d08fef02bdfc770ff518a9ccaf1df6258470cb5323853c483a2783a758b0f42ad4468bdc40203b2118d5f61364db481970b568b9b60ba950c882b33df523a0e17013acd84e9c57612251178e085e623f4a4ecc918c270f0354e2b2270c20551e675b8f6dc7283ffc486c51c9d35f5abc8bc75282be81543e1bf879b71af06dccdfe53acfbf970bd3afac384f35527358a8a11d3890e82d290034a3f94edc9d9f5011fb80cb2c9930197da503ab7649167c59445a8f6348ceff53cd69913f1effb1d41fb92e0f5f19f9e945a2ea863029b726435d159e348dadb89ab22a4c0594b96ceee750f95c9ed06b76285880af772ec47f4830d78d35794cd8454da9e2eeb385420a9f1d37bb5632eafcab486eb5fa6cce0563eb34d0fb1d6db358b650e3a3d8c50ccd7d48e719f33b8e2d939d1fa908ea995ae4d66e381c16b1a897359f7594b738a912d2633bdabde47ac453165ff7c4a70ff4096caa274bf6bf301d4ee5029642e1ba35efb395def38aac88dfe07f59a7283df5a0e3162bbc9cf53162900053c38942e60792bf4221986e01f84fd3d73f7cece3aa717f82e9f881f79dbd68716daf4907d3b2c2b5febc7619065cbf4fbc6f95f16cd9a4ab7275378a215510b4bd9aa1bedae68848e51a074f723ab07ea2d06104f14afa53cce15a9b3150d853bb52815f0a67a60a5410ed949b1690d547588fbdc2363b71f90a9afe367a8c545fc12646d068869ce9f4958d861f79cdd82abeb05e7cfff8e3eb7bf0515fee46aab1a9799f4e94015f493655b0b4dafaf8b1a0832d2033e11d013e2b1b240dca0a7fc0722816569ba224b0996fda4bbd570825ae63f1f64593ab38fe15a9c2e2a7c70205bef5c60e0b0396f1cc124bc768906f59c6a40d76f0136b45bea34b43a69635d8098dfc854a9915c4468af7dba0b76bf149e443e217b113d9c388935b1478d87779620dde31ae09b48608af6a78337680a96ceee8f9c8d145c637321061191a2983f3be86bdb6a6ce387cc08472d30af77fe1a338443209edac35bd4cfb943f485776cdfd0dd8319a2592672bc9da87a88c166097e7996bd032b35705b7cfe27fdee70aed34d4cc2b4f8b1e0d49e3ea9a1ad19f211138a3f8c0f75bd611e3a79e69168c68db15131f042c61d9a3bf3a418dc2b84d3e78b186700775fbec2923e1cb5ea4acc4e8a37dfdbbfee98c0da2d7378a9f87e89179812ff8117039e556b5bfc806b2232b145f5a9bb4eacddd872d2df0cc5a858912d90b06cca1c83725cbd1d6546e36a208570b995cb4ee29713dcae538ef0c9d83f456ba5d6991fd89a6241e22b7d91b3b283866ebde992240031e93cac0aef77dcf3c5dfcd8a5fb1fdd8b8277c59352ca50013dbede8029581e6d89f68a3b999e01dea7873b09513832f79c4d8c9f1c7be1eb8bcce535c2484f6ed24358e99cd2cde4ab8c768a2b991791fc07a010ca33a16e38355ab3007f2eb98af038912eaac0d3ccfe067fd9545b5f4f7f3eaac34570caf81de5498d878b0e9f83bd004886870ec94287a7b6f998308ec37bfbe14cf65a194af3cc59ebd06377fbc03fcc5aff03583599c86c2feaf3f7c24eb7695c3897285d7622836cf262a2a79292d5aa84f63caa6f04fca56f469e8a04631fe5c1b4532689acac18795705324732aaa9f3bd7510e28e7c7838777b4d93b80308c237a3392c9928a9296671f3611b9aaf4bc19345556ac4d8e5a3dc9e1e6b0a223a6892e4f25c625562ae9063398662ba9a4117531a6bb8fb2fc60b210dc31cfda64cc933d281e0c61fa2cd06cdcd4a3b08e8badc289834e01b53be0520c93ed89cab3e16faaf0ea1330082ddd105e32f82199cc49c61c2c5f069bf764ae4f86924b2201dc0425d712398c109a0770feb0c3754ad6a747962eed508b71b6572585a0b2ca6ed9891417da6b949880c9943c0f17e7faf33b7365aa59673c616e014fccc657e27fc740254cbfe12c2d74d3c0dbd76840d20fdba9360052bab7b8a1599f76adeacb759a91b62730e20cda813442a6713a09294109b2dba0b8a1995f816cd862cd4461e8c0846c42a138aa900c0e49cfad93e195a5ac946ec712a6ca5591a1d2f7127f6ec9f63d6202740c679b5e4c83bae4b830c8b7f4131fbc1aae16f9950cb6db80e4a8b5c0366d2b4cb2b1b7ddec50b975a24dbb1291fc7bba8f17db0d44397e53ef8c0a777fdf5e4385de7c348c02539dc3095e8b8b9fe8eefc76165870e54fc0adc0b2269a2ee0b56673a0a3320106a014b477ab0a7ba7fb937e78bb08c6ee46e9c457308c961d8cd9edd0ccb5b181f26695abb54dedfa594e7345d407a79356507bf30258bb98aaac24a22220f0e5d930c05ea64305a1be073bb49ab817516d52bca42ebde65b59af4d22f36d26ef40de59f391ac45c85ba24a42d4a4209bff265d3808be56774fd6c368b1d9d440b62782f6863acbf845b07105ba988bfbc50a0475fb2054352d945bce98ad311646bc24fce5991efdd025927bce600f603919f95cf38a3d52e00bcfda6f007fb39e6088de09430d8257af77725bf03db7718b11c144d892087b311c99e06bcfcbccf863b3b900d753507364512e1066c6fd8c85f5146b008f86a350ecf31662ff99f25b71ff3c8d88def76e5e7b6eac6d973e849eed8cde5160d8326e11e6d7f6945cd05900c64fbabd83f6386221cfa073568b7db4a3a2724fdbec9208215c3c10b96e59647ac91d12afdd16ebbd288f4312a97b487deb419cb6256a6dbb62fbd56e1ccbd4e1a743af87551b76f64d5497e530ecb657200690de7ed40444583e6d459b9bfbf54ad7cea631a5f364ead3e75861a21ca1bf24136b8943efb4fd162e2363b0e2f41539c24bcd60127ce76fad7924b51d8dc9f137adc58fc6fb302d36518e05edfaa1a93bc867cb13bcce817e056ebc525c7e4bdd9a57e7bf852139fffc28115b800f8d63b1acee635d00c9a3e3816c9d36b74b290b830e56c4564c27db68349df2fbc32f9d46f10d0cb7d9406b0608fad6986ca9b7529b004b9ee1c26f309eca6043e23135d2923ef3a1bb5be5c1779b0eb17e6162e04295b41ebda46d8e0625e290cb8bb4666945b4e271a7b346fe171e653d5a52380f6fb990c6bac21868b3b97b8ca631a023811a6c7f0cf8196d7acedc5e468914f8d0c016b0c2b677fb9a6e6d1c0d649edda4b958446cd154e37f11282c8e057cff321bf3a14e2bb9407876fea01988c146117a756eb9b10dd5925c03683b3feb76d4a07589911f7619a2baf9fa7a1b53d905d6415c4b65534e9f9711280d829d20f4fe855d80cbed6e978996a54e4cf91aa948c0e179023beaa4a59bb28d0da3a3a7e5fd12c6e6719da92db6186db4e2b0f5e919de6c8e8a3cd9a83c6c0b1043d7f0edbf5005c10b9c2bfecf3ac75dfe477b162c8ec78c6d82ffa6ed9870b65d09f27860f4ef9e53eecb93c52ee52b2fd00ad8d718d07107726847c25335ea1303bc981c3c1bf82f40215de645b89195f1f429ad42826d91fd316fa05fc35d574d71ab12cbea36f1abbd960c49a27dce57005c13c03556a6b1202a6716448547006d1c7bfcd35c859d95109001a9bcdd2d80d492a9f7a1f5d21c8b592f639178bc9fa49f1a3834faef4c86266259976474914aadf6b185df9c93f5a159b14e0bf4f0466b8d9c7db8ee4f90ac26561bd8d19f6fc17beeacd51d9ec5f6756f956a7e3eb8ecb352e523c39423b342568f5e14bd606034da65524c4c7b6df3bdd95ddf8497d9cac97b620a38e4c953e5a2aabc72548daa8399c00168f498e9e00041e8579f580b1a24d7d4436a1031eb485802bb7e5d7b2b5b8601854307e260a5e41b9f401ec3dfa547a438d78e943e76d7e1fd70ee6d2841820209278dd21891ba8f0779dcde5c97ce4feb247fe067a02bbf7a14164b16248b7ce584d3f33f9d00c41d4325da226d7c7c258726a969553cf52971bb3c44fcfb6bfd289dd7879117b6b5061b592c71bde149817c997e863df75f191ce649cf367491843eb891530801026d73170e89a64e97a15a7a5990dc0e3c32bac740d69969ff0b9fb50850b7681ed76693c839ea887527138457deca15f8b6b880bedd1e7c1b5304468aa21056a169b8a896ac7de5dcf3a301eb11a73f1b21d587e3428d34ffcecf0ee3c1af8cdf3a10f6e863e58e15f9068325a9765e1fd1cfd956174dd29b4ad5163149a5d3bdb1d8e1b7ccf0976e7f0bbb2fd35a74538d76a3384aa9abaff8a23291b73d40d442cc7a1b2d6c884459998688b2b0a349888fb5af3221d8794357387df981dd787b3658262ad2ce6a11f0a47490538b8ed3ef4569cad68b6f0803afbc5f8e026b851eb8160c8baa2ea06053580730f7fd424213e5558b3d911264dd40b69ee582dd92bb03e64f506af1e172b17c863eedfe3c129ecaaa0ba5c8396666e97fe548385fc05bd028d6c600b0c34d8ace675ab4a84ddc63f3d5b2b74279ebab7ea63626152983aedd6084cb44ea443ebdf5a4f7a08250b944cffd35c2cac0a320ef163e0948646694740fae2f182f592c2951e3023dd9c18dea02d7a5024db279b0cb50f2284b89c5f873dceb9aceb309dd108f4d869abd0569222e85c03e9e2b525b4fdde1cbcb5701023a3a4205ae9b14bc08e0238ed1384dedeb19e778ac7b87114b94b96503f2f411818f065b410b7a361668d5e2168ea8badffdb3e6b5998a9f8fdd20bb4d2ef9c79d2f893399316cac996cc9cf268ace8a24d2fae43ebc1ddc224cbfbc5ffbb72076255eeb85d1aa4ec656fb00c6359cf4789ae45ea747d26da24084a7070706e8e5c70095409c358c4b5a82ca0108cfc83fe30e8b857bbf56a06cb2b3b7ef9fbf9af174d4b6c57c88a049ea09cf19a64f51e72a5f032dc307bcf051d60c145a354c1d39687c629a46081355cd218a9659be9b73d0b3263006dce24fb99e81cdf360eef1ba6d106848ea8b578ebf0fcfa3e87fb41f09f18e3c3646fae214bb0cd91325f239853455397a04e4beab9a184f9bc476af50d590c735ec38c0ce73717385280a7049a1ac240f3f3f64fe3551804c38b143b9048999bcb87a557b0c86603bbeb2f2baab71eeac4dae048f9d562f80e9740ec69ddc197095600b5bb7464acb6cf7cb82c4a30b74904c7881520dd413257ec540d18d46bdc59d1a112eca02d4654965dcaa1614724b569bf83e6db8b11555f7b1be7d62ec8760986be85b72c4e5cda328721aa2da974586a06dc4bc5e20650d8d936bb9fb3a8dd8b7f472c1c59c071eac9b1f0483afcf71878e0195457c6450144a13505123aa95ed26b51eb3cc2e2657db3f8ad3ac1a2b67d1d32d29954543a744cd63786bc6b8f2885e3674bbb103d93218b991c5006aab1f219ec2303d00b248859ef29696d28e75385a72b67c20a9c0f398b3f95b406aa5bcaa8a692abc5624fc99d8386b02f6d8d1588d30fdcf4611bc301c73ccc4028e92f7c7ab01adae13e50057f51e7383198f27835c4fe860130266bd1eb8b214ae57b02e731b08c8b962fc8e99d3461c78409ad6979e278c0fc5a7df0b9368760a382c4cce7e8074e5511b876692fc10c28dd8e4f33392b542e50dd33a7009461e04223d15fbd6a1662e5dabe58eccb591da0372642d33fa8856453d8411a4f4289e4119d0defecde4367a4b509beae36fa1326262f7d682a3d0c17acc4268b4f1644c91f049e6b20529a34249dff38232c50dd659b01c4633c042daf18771a09494887d96905ca61654a7bffba404da761ffa1dddfed317d675c44c67ff141c2e544cc6747f274a5c4f4333939da7076bda37dd04c78284af3f123bf4614069d1986cb8c28187ef2cac95beae7b3f2ff3f5a0e1d81c0866ef1cff82c1e9300d31e6fa267df3aea727061d22c7aa074dc6490e9f7447fe83aedbebcf2d92064671f37bde916c9abfa01e72866f7ef9841bbb583c05a2fdb1f4226e6f6ca3f813ec10b87b2510fb232362925be692e0bf2e14b21206700431c0213da8890c5ea3a218e43bd0e89088b790252e7aaed5c3016ba7ef8583e7f751e0b464e8c4ba264671393f934b272e7e9cb4762478f447964f97f671b4cab7fc563bce50f0b1f14321e3236699a9328950ca385f4e02b96a60ea3d5f1e204830e704c198ad19ef93a3087eecaa908904e650cdba2e7d229112c5f11df8473b33786f14d8b88fb68605464dff08e593cc24860cc54e38c672ac96250787b0a0eef60cfc5a520db9a12fd9d0ed2d3b24ef458a010b0ccffd2f9727f7be24ecab4a1d88bdbcd88507e65b577e90c63eb97ec09de35385801030a71329eb6b79f0b72714b3327cff5a33c28a3f98e5c36cd361ce82bca84859d6b4e060e1a0bfb9926f39af9027a79a47d408b5eafdc58f3c5f71dec34d48aa91f9d102b0c048ca65bed05bba2c8282c78f53dd9d59bf939bd76bba754f003ee197b71176c4c815d49abeb02ba11c9122718fabd2cff4efbc81af0639ec74267ca4c6a744274eab3628b691abea583894c9ec1218f0dc6b9d52dcbdf9ee1d71c78c2abf440e1321e129a3cc7d55cbeaf09aeb5df0b425b3a1e027435403b1c1cbc3452a35ef92b35161438d3470e9a54b3e7a5d7e39ad5ad9bea676081b128e29266f3366605c93edc4876c875a11cf4fd722532d2e77f576954bea08b344f67f1f49624d244751124ba7d512aa8b722560d1d90e13518fe71f31b644b420f654b2abd0f9ba0bca15da49953312e6cc8cc95019812a85bb5f42caed150d2e1e7b9b66e9c1ae28b00e71fc64f27859985dd7870bc5f66d83389743c638a8fcb394b62584a7ba40676de15dcd6435fef669a89712a17b16ae311d99c5c6847c8db905c6300c029626318c58896974cc0a29d71e0c664829b59b6c6bd46ca8f4798035af7f498199b42b838f7c4aa76949b2438b68e78cf51a59bf6b757a000ac3267fd4ce9448121054070382ef14aa597120d347c11cb8aaa7e35dc4d2f3b486617f2e9966c9ecb38a62300c0a09fcbb258fcf70138629584236cb8c2480e8828c654db709f6b0a5d3b9d463279235cae548ed094012e9d5ac61324fbec171ca9f0fe1a6a1c1c4c6aeeed52b1c5d442238a736be6e44fc818a80c8fb7bc9019d7643ef26ffe7cbc589045a272142d425029c79ea278661f97c6161e1584693612be7af5511f518945261ca204efd2764df5e6bea7ef65ba22096a6d7083767478547a48c197574579f8b8714165a443a964896b9bad89194d6bcc003288a6403801683ec1df7333880ec28d7a8466ec64feff9950fb188641a4ac3e8345af2bf73077bb3d9456aa5c65e101e6dc75688b1d4271713375892337f2f3ae9c05f516e9df29551cc4381bd48eb21233bf2c3b17f886561d359925d4374a131a235c54f8821bb9f2047e0a9bc7463be552e138ab212bc4d16e0580bca96d788ccad1cad89d93f8384f68b128e1815d880169d01ef24ca2840875f02d6e4e0fa96cabbf39d2278811922af9748a7f3f458f3fa764e70a736077b8c00cd6d1c35fbdff50f58a0cf1406420d3bc85cb361ea569300d7bbb905b7c5e9a54ac7fa4a1a32e0dcca2dddcfb093b7d223db4a635121e2a0bef7788b89a54851ddb11cd12bed77ab5a0e36ea16acbed517be3af4bca7b3ce51bd015aa30aaee6137c591efeb3030b5e4b1c87fab9ad3304044638c963db8a98c0a3789d77ebee5014d1d952ea6969b6eafe79193ca5f022f4d6b130287a875c0b842d37fc514122ff383d996ab141d86a806a174ed9953b290b7b2e0a83c43766eee76c8a89951d37144949fc5b3012b2fae0dfdfb38bc6164712d5cff777a5f879f9aad475f0a3d5bcf0b8d18b2bf9f94a07f2c0b2de8acf1fc756e7665225bbef7adb1411297fa72e45294d094a0489310c0cea4c0ece4ee32855dfb5913506f23a6a60a1200d40ee4b875e19d21c6a634f1c3604630e9ed418f1793103c4edccd2b1b767c5580811656cf4289c6318780fe26e98f200a9dfe15dc3ca94298aecc4d4628ec83fffb6e456c97694669047c4ec1bc943ceffd6f0ecf53258f3cbc4bfb873b8c8f31f9c6a411f706ab7f8593dfd3d9b778af9ad58646445101105ccc42f35bc5cca1d0f6efdb3cafc4f07c0c03adf99dbf95f6da88941e0d766f2bd2280cc4dea562f366a4793912d9064d1f908c825ee8433bf1a24cbc7fccb3005aa8794991b897ab995f2d939efe21ed61f055c38cb9203ea1f757cd2a33bdd3d4abd4af211cae1a193588a823856eb7d17cbd7835511d14ececc88778392905b5c4fc869c182532264dee404256f330e56db01e57606bddac146324d077418880d20fca7bd6b84f649271ff30d3fb242f6f8ad9efa70ce0f26c78b22705c02a41da670622dbeb7bf851d314abf7a129754ab6b941e485ca0067226877caa64a4d85a69513ea01c22e6e89a25d7eeb2347616a16e866fdd010c060e7e7b56e085e235a8b779d2f53a826cb890f4527051f2e2d6b0fe892af4e4a456fb3a2eeb75b9cb35037bc968b4522a299dfb67948ef0ba9c8a19bd56ce91dffa39626fecdda590f93c46d30c7669a07212214e1ce5fc6d2acf1a8d103d7a88ae6debe6a1338b645f91ae0fe1b6d6fcf364de3c829475f734d49f1419ba376b3749e4c13ec0bcf55a29f5da09ecfa0a305cb5949107481333b85856e51855fa8b337ed963fabd69f2e38b11d62977beadf8b589839b740e2a5945f92e0ec11fb04262c6ebf538bb70abf7baab9a7266a45407d9a8395caccb0111ac601938d3d535b04a947a72d04de2d74391294c51491d3296f0a707cadfa95ebf006e596d04bf92a5f72d93e255d12ec3e042cf7d255617b6b5dafbe2e1314a5da81df6d582b217fabc1b57f0738e1b57fca2d82d144da71b381921a264c9c67c3cbc9b73bea4cd3f4b9312c483ab8b34be56ffccfba5e4be3bb913a36fa5266b254d32c3c8dfee23a725dc8072814268a78e29f5c061c2714854e314bc70729bb69eae6c002b0946685e2f20c79282370a0642c053465f799d1b1d3303a013908d657bc7595ebb2c2d18fd007a46b6d973c835934102a49d3648dc4649a86fcc218e0f257fb564ca42afbfc48ec3b470620721414ac5a1e71bcc7ba7f26b8f8c19f8f8a4cf90d1cd40434b6f32a8dd2e4b80b4edd74cb48db21ca5e41ebb18dadda4d4a9e7657901d22cdc7467da142d979b22129abe0445848bf265f7892df6d1161d14f7f397550e4168bfb4d658c756e0c2b7fa648ebb49f2bc552d988dd07f84308531efcdeabd294be0fa816a02671b2122c4483f94ad88a81582823f348f682eee1cdd0b9f4a31a7e7ea39e70166f60dd3a59ff420cf05c971a1b8f081579d13fbdef180aba8a765093ff8f08cf29d249d7f343edfdb8bf5cdf3354b1fbf581ea5b6441122f5c054c087b17d1cb6f427a953757b317a76d62b3bc20f7a5102a229b96a443fa4fdbf9f0d302cc58eaaf23b3968a93cda82ed77c5a02ec8736a6e12122a7d77ffc925a1479f834014542c0dd41dda71bf2f5ef9c109775b829667aa2d5c5542cf657be0798920c901b2c87c954e3a19d21616f356b92b3800cfd644a0a24c2a90f61b8af3823eb3b03d7fa6f23b0e5f5f4c4999a8b03c530ba00d144fbca7f5e343aa4c35516c573e5214ac75d0b6f3f703c543d8c1f355b74af715586dea5f38a95b9f8eb62ff7914f644a00bac2be1750b3ea715065f2bd2e370b5e858dd33048809bd8a18c1e8b7ebb6535d2820f130ebe72fe8f317228f813ab454eaa48e526484f5ea772e9be678055407da78a2f14063d7711b78256f4dbc6b8928cad43b73db4d490facd5ab4f7951e5bc2cde349dcfe496c1468d5f8fd8e9fadb9b9c37b28f0091521e2856d613b205a7a7fbf0a2709f88f2507f6e2b1c5c01d45b91769dbec49c4329fe97e1ffea20eb0123e9992236e5ea5fcaab6f0da2df719ab7acda80b3a856d402704e606944ba3da1ba6d96091fdf6582851e6af89f631e6f49f6dbdea57de4dc82349ec373eedcb1862488f77835864ad8902cfff3d7315a45acf687706150b8885bea019c36fee8c73574ca12f752c0782a3f9b21218ae714fe6ac6a93e09de4465a99543905bdf14890a23d2e7aa2a7bc4b330fbe94eb13abc9b2849c64aa933aace9684524a1f9af3ddd89932ab77b89dc4652c3664aa6c9fe7da6c266f5a401259cf6dc3caa6ae8b70d4759321e769461b8cc2d9710d7c8ead086afe2087e45f407125694fd0c55f2f675f2ce5652b3cb3b3a07119ee3b3289c4ece50214f0427b592b8b45e698a2fc88183b93824704da59a0f4916591937eef0f9cceaada033ba1bb9e06a0d0ec90fd78ecf32e18a18c016e94772a450ccec1786d3aa5c4ad848d34d75d3903476b72b895bcb1aa4b19d4e05730d9eeb8f7588b868ec6dcda7195c80de526bc088719584b3ac0f02e9b3297f6eb6e5603233af34088dcb603e99d7a48e1413787142a3115845bda2d751c2c81f42abce30435285190a5c1f36eeda7d826f12e8ef99f35e495a485b31540b638b94c14f866a36432cb2302374791705566d7357d5256eb91c7a234a181280e8e4e4d19fafb6a513e2ed311922412472d35eb1fbe0e8655336a41e1197670bb8313f1cda5377558bc9a9b7689604f0b4d42b0d43a87050aaabe263f911f1d8bf4486acd68adaa4b352767da5a6c8364c31274c37da618a957be9a0096ff16fd7f7da78bd261fc5140048a6d0ea39fa6dc22d9ce44d657b7b7470a31f0b9a83b63a47cc6ff75005ab7f04f1d210e1bb6b518919c05e4b553575f213da6989dd373a8b2e51864448e6ce6f67f4a61aedcacb71d780706e798257a454511f8358ba30a98a9abb3c66285a47c29d2ec34d89afade7f05d2889a066cbd6278236d07dd95b4e283ee1bc888c0677b56256c8b6997c685291b50e738c4a0e9bad1c8073f65b98829087db4a2e0602ef76a40bb23f2e9b9e9662de97bc028f0766827583a00e986020e19b90aad30fa944f75cb47d8f818a82512677d9d1b0868364b5b6621b04cae35edcaf8c7b31395bd8d496151b11d4fa11b4f84d4f5b1342cb7d571117cf6aa75ef5e38097e5b813358a2d948bff46b6d80bb6b3e292d5471c79d7def2fe0b4f3215ebb3833d3fd6063454a8037c7bf007bdc3198b00e6998de7f43b333f9870cf66a560d29d268accbd18bed9e3bbd4569550bb4eee594ad9ae845b16ea799088d37cf800a9f51c5f770690f243340263c0a6c85f29bd8f3ee793af109bf7591674d2078ea4db22c2a804779e9c45c357759722b2c58ab7daf8023965e8b4d41554907a59fd58808fc001159786cefb8be5bcf11c39ccac8324a1b4910f40918c59e6eda6048cfcc24478fef11089f8bcec8a5aad8b7dbd86e672be40279a7c1c7c4a2d89a5bad8bd63ad654afc9647fb0703e69b7012c316e23905e5581edb6c2fa0bbf9d6f3e2018391487ea755acf9bab33e5a3e522bd253af0dcf751ef2d766bb8f0fec0f0d209363f63193e112186ea136396e1fd7f0598d15f61b742624635d4043b0de9f7f5c1e18b9b6f2763b6545f133c3cba70c5a140f63299863fcad858d4f9512306e2230aadae0f29317209d5c609715e3adebf1fe15c56aec88a51a1feccefec6258bcdee91e7a3b8678889ad15ec1c5d8514b8639e34f14c38181d88eb340967118f5960afefd7c43c871ecd990ed41fbf97379a765b2c9264cf837b362e725462fa971e0b0d2c0cbb428eb56dcec7e22e0dedc19e70d6922bb661ae1d98a8aff861db910f1fc0a1bec78b259e7921cb9ebc088a17816c89e1fa750d38ed181b8049809c63d2a391f2082162b095d34ec187ed7b7d04aea4f5d49139e11d1aa4bbd7a5ae200bfc0cb3ee82751fae62680dcb332c2aa46d62dd303f927365840c12406d659f04606ec72f816c89c3eafcfdbd595c947546c59b421bd8ddad2fbbb2073379a489a6045af88aec53133fc0fc514fd46017c4f2d112adeee456efc5cc8bbc6dde7945288f96f49c68a0e0926d1750e73445b42b582bc71fe3f89cac4e305409070060511762896c4dfde364b93fa3b6a2a17852946975ef7a1b4ebc763d1097ababe2853fbfef6e390dbf6708ba42fe4153f6000c57913f8ca79d9c2801e7adc316610ace9f4519a6d4cc36f426b0b78b8ebfc560aeeef20af25c6f73263cb5c0eae5668234b2d1737294206a5c7e46fa1c99e9cb2b8a3414e012edcb0ba9bac6ff8bfe107ec34ab47e349353b62571a85d4e6303988309f03c7f751762038b9fa43f19d7d2564b63cab984de7962e6c6fd786bb4005c336b25f465eb0ba51235c26c53d1dea58af80f347b0084070533dfd916201b3b22ef95a04f84c6265697e479bcbc7a7bafc12655bd1e75312560513ca93d5895c212e8ba8c1858c90bbbdd1389a6de2ce12359d4fb7ee4d52350e750284ec233444ec30edd8af234b14f884b021c40912a680be88744dfcf57a4ce224d028dbf31008eb0bb396f41255b10ccbab24aba6b66592b96d272fac457b250512d6382159d03308064c66d792b37778fb0d41a00f350c4a9cb1ed73c5add063f41b56693a483f6d880308dfd03823cc26b2daaef0d2d6e73bb89cb729a4ae489970e176744888167986312c859e90a9e1cee892a039c744db72f19014aacaaa8c29e8240d46f89690cf2b671350091673eedb5d5ca2bd38cece110cff0d452da5ea12db5c57c023ca891edecd52945363236abfc18d9077cb6e05a4e52313998f8874276602d868ec8a75ec37d4b580e19f5a5b088f56357a7f6f5d883780279a8e7ad06826e0c1f9d2e8b436ee216d420900b4e7fd0036d3acabbaf79470fea869721ed59f90aeb0314d375d9c202eeec11ac28e98458fe30b3072bae4cd64840744cb95d8624e6bb5c0b1f55404e4f02a5d5b0043fc19b207982d9e44af2a70bb597669293f6c9acf1497a6d83a469d151588e4b9c0d61b9fb9544e5efc6f6f619d777e91f0a6365bb4d74eb539da1bdab83333863310362dd35c71b4426db35f387e733ec00cfcf3a52e1c3dd6ecc4721ff8a990552a9d123ce0447519c3134e89513e95726b87853e080d3c7ae22631fe5f66288bcf2333562b0561c8d98ffd3c9773ec02c96a6d7eaec5914e77e31178ccf9b5a456d6017b471a3af0c8b113f0a6a3c125a2d9f46cf54a2a9d1f195aeafd39866b4db56a471c3b790b161e2cdb69016fb1e8882948cb968182ec6af61c5bccfffd8a7e25629474cb1a8133fb5885786dd707a7ecf8b85a11602d3793f8ae53aad15969a070cf09e34f64eba046edbc3556ec6c8a6b61d7d17267c6ffc4add66c22a08260dba9bb24067eadb28af5f08a67ee252f9d228e27e98babf18ccc3372510adeb1aebfeee23cc49d848bda5da35070662f4ea3d5b3299812301ae1d0563adc9027cf299e7c4e81080e1738fdc4793148c036117da10c2de17385b63d20324892a69ab5970b8376e19ac8a68ee6fe26e782220b76ab4ac83c41e3973770dc28d4395c897d4b9240ca7e307edbf7ab8e09fc068d70c582aea2f04b7c1f3e0996c5612df221025af34ede375fb3dd7d99c02d96651d5adc73b22042c1f9da3cd0682805df606f2a9a5a1edab6420b7d6c9af607efc37e233a25c3424e1ac54cbc8c3b7907ceab608bc0f3b319fca35b33593547d5e614047840ab895440c33f878b1471f3b7d472940d9273bd2cb230729b261411dc69662daad4956a175d1bdcc798ecd094f055056a4a586d3306d17529fe01268cae81c37aaeaf721d3a22319285f9c68cb0628a9fca389bf001492ea8ea34335ce496f00a732b12c2e7fb5d4b5b487afcbe33aa0c674bccd654d8788aa87f05543209679216a7842c35d66b0b28e61426fb6ab2ac6d4828105721b319a949752faca6f255a52f5e693588075f4db2306a19492cf6b8e9abd0768b517937687b563759eb153cbaf68a220b7ec84348ee7c9d4cdd5b27435626e310db5734f63935b7dc651bd675853e6e8431c46e57eb6e601fe729f60b5918c708f5efe66b6b40034914efdd63793ec01a8305852261b61361339ff844e564fface1ee36a0adc7cfdffe7b2ba86942ce104246a162f958f7b1e5d49b227a4699fb2346d8c61d6490ca430763539f2e4b82020cc9e3ecf4dc580fb0e849965923de579768f3ea0e5756b1027abdcf3af128b99394c9447574f79af0cb032663cf1e57952f8d5a5cef8db5a8242a38889f07cce84d3ba7f030faf4817205dfc63b11d826e6d84d2e0a2bd9f8b8b273a75941cb8d2161f5177963a6cab5a89694c01904a2b3bb05a9ead093e57e728d1ce908d66f80d4b4508e5bf545fb63a5002f5b92b56f886ec382c2c3f897b860d6f1acbd78bfac4815e9f3b223188d7b071397389eb1b101d18e60b467dbb6241ead61f31647d7472043e9b5b82e7aa697ef241efd99bc26515e35717fd86157ca62f00ac00497c389160a0b65ecb7a98450416d1f9a638fdf6f15c362c108a7b1348fca78dcb660133ff826f9af0d5ec3cbf1022aaa432098e4df6c6878e242cdd7aa45db6e14e2bf4b23fb157d2831621ea1d52bd797458009d464d47734b054311bf35d337a1809778790caad6712c111d1595a8c06964939a33e389da90ab844a6355670463c8f79aaddae50590eef011400361b0398af4c41e3e37184222f8f057262fef374548e2f542ca49b9aa86e44287bb5c6a065680f14805e1b917ccbc0f2e5001ba8d19228f317c3f3afe16caba739bf0fe6f2b4c809235e0a3153d1430b84d3d7a010b4b21455339fde8888364682aba78df7f4fd4205ef6c0cc24e152448c6a24c419b5d0ae710787c0718d7b1e908fdde1b5e61e58298ee67359d01c8379907503e1a18206e672ac02896c10c57580f41a2651c882d0efb19d10cf61fd31605a4088dc9127c5e93c2478427a5ebf7d3e9ec26b68da450ab0b19718dab27fe544574c655ab16a9fb2d68e054d3db5ba700f34c197aa3bfe502f055ce30c3fdd1e7c88ef3eda52bab8b91fd1af4eb24f72a3a4c551b027fdb1a320da2ea52ae6672c522ce301eac595b254075f74dfcaa305a85752d39f02c27c9ba6565f5f49a7cdf2283b7cda28a25937e9d12bedf87722c9563669c89c314bd96e52ead76e475357cbe7667ea4b5f0ff4a1437ebc92a347cde3b6795889554066c4b847e1ff0275e366e5280e7af5252b94f62100055edaf88c332f154fe870860fa764f3c0494cdcd3b5566ffb38f0fdf89a6b35a3145b70fc3851136b1469d053c567e764ff163b6565050c30bc3a90bdf00be4defe37a8285895f2cb0b288de391aedd70e0668431262de1e5c303b1f763cb09e01ac764a82090a771eb6d6353f404afe0609b2d38e1bf2d4aca341c5b028f117a059b2e52e72a42f8c4e686179eddefcefe403b2a22eef4c8063bd20243663329445a7b44d7192cd81141f34f515f74f5b8cf8bd6ca566e0053de738ee68f2c4cd437c1e63198597b396338084b668de9a3827d8a074eeb9a0c07887760e41bee1ade2f10346bc7543f4df386c816cb430f5b535ef929d1f4a2cb35e81d67cb78141288a04471d2582114262a5f8e7cd7675d4702deb8099662cf1a2d74889a79fe4deb4a7193ca8242e28caba73bf0711218feb3185dd928b1383953bda1de51c621b035d1507db6fc2dacce262da090a1270f7247e4da061b1bc45951a19606141c856ad6f1feeb6854f7f1bdd3a549c68d93c864c6cd8b9b0493f793d8b8fbb0f545e71790357a9cb4045ea8f8a3eab3a46c90c242dc991ec99c5c845ecdd46fc586c4eb08296da010e74d720e45908eae278965447c3c08089eec9abc56c6dbfbaac55e1e9b4a22725a847f130e4129ae8714f05cb608cbbae07b1dffa62cb488c7c20ef295e26e060021682647c8a9327f893f0557db54b78ba94f4e00dba9f657a26d4525a77923afe28efc1de437e36ae7c35a605086c563babbc4cf45516d5a03a7c6f31b1bccb4bdc1aa6d48157ee2db782b794d98d05c05c6024df56fb512ebb0d48c2aff51191f26b3471a4d5548f61881c4e8d30e86d0983e47675fa2248f084a2b1888bc50da9e92d6cdba338dc81f5f67ddcc5128598b21cb93484b65a0d1781aab68cc2db570f4c6e799318d872426e43b79e61e24f3958d3a3375fd19c670f2aa6b64e7fb15d2b7619be1979cae2465c8de6c86aaf918ac6b7131f1721ecc83dd7a76774d309f61c79de8924e20299f8491b37af9240d04726ab8f868ba73102ae27f8eb114b0b07b10b6a7e3603d029c64d70cce8c9f91274aa5765788dd6049341c91c23fbb953547effb197f5669b9726afabf24b573a1068369da4d27746861ca070337b29b7972cd0d16174418b752c8562c16cdfc25bf710af591f248da40ea1bbbdb037550c4a881aef17ca57598d1d8ac689eb66095308e9b550ecd921541699be474562fd7542a8c1424e83994d39ab7b840cffa5b5df15d3f80834ff0994ed725d9939e0ee58985a499424902376bd69488e6d2eb41b77dcfece269448d4183da9ac75c9ad07759903f01cbcba3aff6aa9616de528d5f070dcb91d61f294651f3d5a4dbf81e86397d06d0cb0bb7dc3173a189caaa9dc353eb7fc133eb55cd8cc39d9d28bbd854e88401894b800973624563ca789979d2d6ca0b0de828678593fe2a67d5f7d5a0db43df59a04893a8d01b7e7b72000eb89f30ff923272a4104d50346b533aaa65354d993d0a6718b2e077041282d9a91243f69b0619cc641b26425a7670726bcf8eef6aa389402ead8312464b5beaacec0b6951ef38d8284a38a7f66e1f501a9cf31b9217d4a0d05be715e43412dda7017d2821ea06ccf23322a55294f68d4bfe770cfd29c99040bdbee4a4afd811de5d2c865ff4fad3b6bf18955fc52f98475f00b65f71574e5b37b70a05f137987e38c5bf8bb6cb5f0e5c9a064a9687d78f431756256fd18ecdc894c132ebae2e69d70392eea8b778796ece99ee8dd521ef9b58a042a07abd6c0828fcdd33ce17957c9feff13be9a5345733084b0bb7008427c5dd88385d1067f8919231fa46413194cd24b1279b931ccc7a55f3802fc915062bf2c59ad40dab84b2ce3767394d803598e0d7a6c0aeae888d541e49d3b157610185a55c7a640ee72b2d72c08d4e66de9a87a7990ffa9566cb73f2bc6a38ed74d59d474df6f3a554e018bb02352124c89ea315e1a90a40b56ba20fddfc94f106fe7eb92f13b90c8eea77701d3505a581fa3bcdd72b5ae3b34cafc463291cc81c35012e09eb2930c05a3f07723f15365d2eb203f4027a643c51dff17fa5eca764cb91d222c2379fc4e338d21be766a87cf00272498aad0e26aef4384cda0c6e54f8f289572ae7d47425ba8adb07fd6722e7537333ce59dad06d47eeed0b360728d1953c080b974d2d8057c5dc59897cd43aa4a641e9e90e109b8bfc9705817c4debc1765bdfc60cfe226d060de8a1d34db8472a771b7cae7602c6556f5ecdf43e4efdecac405b86fd7c7f2149aa3f60c8716031bcd1430bde5fcba2653858db64f78a701b7839cab15c37be7157e8e8a19362452e9d9167ec25dbc688a8692e0833dc9be8b10b76037639ee94ced996e47087efe40ac939de31254b2539c79b9b7da7b3d7207b55a0697ea6fa7926c9485539e3343a4bad80acb303dc18f6992dbb2c5df34b791cb77ec2e4060c504efec813a52b169624b32b0e6f4c164a610d79241f04afcb306828430df326687d8eb6ed119d9b29331d3881cf02f2b319a43a78312c477bd4369aa8536277764897764b5c822f0d523f868cb1b91edf667b8063f4fb5b6fa93e07a449075f380c43f0aad77366146bebdc2449dd28976e531c02726312d9397bf3e978635a41b1d5a10c5eb3d8abd287006c8057b55c8986c6b04fda993b0c3208ab4777d6afb72f2c8060e09cd99992008c1f062d087561470a5c012b46da8b167d9c558dc642a9dbd378f20f2939302f2d19422b392679cda3f0cd7e19619c8cc6f6ad0411cebd7cd76253eae6306d0def5f2895bc49123d31c5ab1b8c6a9ff864b8e5ef4408a706dd3578ab895217b5abcf476402da903374737d18d944af798b91679a784b41de0c527fb7fea0e55f83548d1c5395577713c426004b7eac2e274cf56a6730377819fa4adb1e0fc51b5e99e3617a1c07438cee6bf3d67f58e0bca54abfed21ed5944c3b6695927d8662e996a6bdceebfd85d405408904c1243aa792d1f8c5ff142e53706049d08aa940bbe9f0e51bcf81bd474ccd7a1dfe6ed410dba8aa6e6d01eb7a8008b66aa5c9ededc835514ee460c76e16cc66a5809ca81378aa8f94a13a0ea61ead4db65167ab421846aae5a82749badcb1ac8adddbde232b783241e626e66bfb7e7bc5a6a42d4f20d306ea9b1158a7069abddc3aa186e881a716a661b6aaf492b6aa67ee46124247fc747b6c4d5c4c446388eca5623e472f96850d79ae44c6fcf2482be0027cf5ca999d422e37fc160bd0aa95b8bb1a0e3214c4539ef6e69b413b9b6b858e837a1fb2093578e6c3dfd43e92a9ddfc4a764546d6f6e901af7c1a1282907ecf5be961060e30c3060c83129cc2ed261456babb3a91bb628c40496a93bc8482e90b7ec7bd5c2855234daeadc897b96663139d062f33eab4242bb7160cda60a4c7f4f8186e9fdb018e05aedffcb1b0bf1cd51c5ec24de26f98314d55e290a462e1202b763f0ae4b0975de69ef8d3bd81886dd87c2b1ca2dc225e8fc483d7ba0c29c66025150e4a70483a408ec7946b1db8a725e242ab0e56f401dee8fa3aefa738c8ed339cd188e0235689097e04b7caf7d423b7959b163ba4285df54db18e77aa09bac61f4aa0bc4ff0f8be477088b5671ee13e9630572c1efda37ec5d3c4e2cc8692da7a976d6cd8351ad4fea73fac0910fdd6e1858b7f2dd402ba037f9177d9c5ef17340e4b4967db8664a889f03bad55e9f30408e7667380895ce381fbf1d89c29f16e8ea9cfde0b8f3f78f582f181f5dc559573093f78f69122f41ead2d5a119c000fecd32760c93c2b138ae4e429b663692dcf10b2ff4671c77bfbee911d46f05d838617f236f552c7287db413e78f72bff30555be952015a6c383ed9f39c64ebd46c31cb8e1ae4e5edd85e1b07722809c895ef32580d366a6e341b12c7b7ad1f8fdff22cd1170fd9cf6b44edcaa2be7146671398355f9e8d7823cb7ae249498ffdaf0e2c78315a253de49f51c375e219e8603d6bf24eda5a845962f9fa806bd49f3114697803e758706911643f8e8c97b8a0cab34e7b4ed74c1c3765add4e7746534012003f82f83c4390566f933cb1afc4a17c2443798320834b824699cb50334c642a4401222ff5189780ed266f32a9fa419abe9c2b3402658f4ccbbc869446b57dc975dd18082ef91c898548b4accd67f72ed8202cc9acfc3c12b2d846ce45622ba301ce7ad55c45a8221df703f8edc9660fb29cc8a001199edba6bbd8c694e1553e3dd8ef4023776cf6e95417d9a5cc9466406be79069408ba4c1dcf37b1e5675d643c419f67b53f21f5a0b2da6481241973cea013217d24c24d42a72f7d9945e467aa84ab5b5e59707e38316db3dedce657c6962c0820f1d427e0a2823d56a1cfbe646d1781a4f0536bbc3dc04b2bd3388a010d04fd0e380a2286dc039e448ed5c7192c13d5d8bef0e2ba56a365e6103743df1415269e4e7e6f68ae26192ab715e3955dd327201b25d419cd6755a2f15105b30220c0adb5e2dbbdaba98021a760b0901d9d128f84f967eb7572ea42bdc3f6411c95125b2801b16c16393f9a236c3a061780b73ad836a15a814d70361fdeace991ef186b8825a8e56fbc955edb38f4363a05373f1925a3851ff5ff59bbd002e41e31628177c3900278b3d060a71ce681263e3f5c64f741d37c992674957f3c37fb1753b238e3eeaf4fd23ab835b8f2d6e8e59351079609aff230a52eb05776230273eee0bc23005fd725263ead15cef6cce5176c4a76f2e3c502061d770700ddafa5b1b8644c26f09a5dcace1711b40c42157215e438a2dfd4d625a042bf0c43c68095dd9a884c2f43acac179be0732465d0fac58baebe650bca1c4e33861fdedeb1cfc7fa16d7494dec0cc0eb7a823536fe03900b0cc6de19d0c1b54fe2944629df544105a668032317ab33cf2edd3be4fdf9040f8a246b952c0f3eb9a431b723490022883bcae05e938c58f64f83a5dad61c9b7da8c88659987576097e04832e0aa975a648b0164d55bcaf7e464e67b95f3e587b6e7505acb98effc20f50111890b2a0bebc563d4ddca82517c6061f4a91b2cbc902c6b58f856fdce0c118bf5b808b6f394c6d3aa3463e081ef30e08132ac84f455fd06959ce6913a1ce7f058cc40d98fe8ca239f731d1c731ebe6f4af243eadf9767cff512b00588fc72511bdd95d0d64a0241eb911060955f7736bd9fc2d73f8ffcd6714588366ac1e920d9a676381f82a6e8de5294731811058379e413aa66b0721b2f3aeb9abedc2461bbcd096880441b6bf29488e1b9219638847c9e496ca1cfc62f336e099611d8f3b17d8028a2b5c37082b1af1b90e37362eeaf88d95545cf9bd92e3de850b8bd37af238bba5aab46e443c000447410090a9787c29403368dffdbc2ce89cab11e5a5edb1c0b099b062abba4f3120415e48ada2ae66c47874609483715fc5942e42ac4acff6352cc6f2ff286218f520ed9d055de309bd3c4160ed7cfe9459c5efd0b9c83761b96918c3b8b8adabbd72cb83ae355b3328af9d3de68b89616634f580fd51a72572b53ea71055938b12fd169519539836c31b592d552fc46ef43ce7ebe0e7a3e586eac060a426e242f9875fa05ff11e54320e755aef6ed3677b0d9cb5993e71c3018903aa91a1820bb5e1018d7bcdc1bac495ae97ac2c7778372a4c1ed520a3a75e87bf7fa6c2c928661879d8aaad6c8f5b89186223803551245bce53357a4cfe02e4c5c3ac89f96f0d210a78eec96438765b678c5d6b3e35e45bc06b8368c66e190920037fe7549fdcb534ece5693c24a43a4e59e723147a9a9a6c57c70ffdfabd2765612552ff6fc24dcc22c283a3ffabdb4e0f3ea7b4f454140f20ef2ace9a81c1b470cd58888dcb3af64de5c9f25a1ec7385632916e14babcc9a7658e0b73c7ca87552d46dde1802ab3b3ff46e48db24a2d2df69b70f6bdca6093d8a483b3464fd863c5785d920ceb66a82f66a2160a799a274a6f30dd58ecb9d9e0908296e6e53d1ab92538c9d312dbc3d22e17b00841fc120501ddac6073a89cd0045cc9e768a88b2434c16337f95ea32abcac7130b24e18500338b6dd176fb354781bea2b8d4566325db9fe9a6fec81e6e5facec93845f4ce33623268fdb375641e3fb18df820a072161a7d6254c26d21c4b96b27d130fd41e859c9448064ecdb33dc817ab8fe783538c39abae5ddb7831429a1ce93e21bf7af447d2898a55f8a943605ad123027ed3ed09226e395a0696b14c7194b01a1bda078acdcc4e67664263a45c66643343438238307c2f9aa7c3f100e268df36644a9da1961c7e4f816acc27d073b4b492c50695c0a3fb8e3d0e6ea84b689e28c73b2dd91a2e3031393ff28cdb2409fb9053bddf35fb2087d2299486da1c970a34535eb9b732ee4aa22e7be709f679aa3efae8bb51d17c36aa4246840c51ff423babe6266463cf720b8d08aa1b6cb2b24f0d524a44aae4c0529ead20f21f6e7b938862f2858b0de33cd68f065acccbeda88ac38ebb7421e373caaae2a98c8493aa267551d95ba35da5baacc910568f2b142fb93201d4f0e3bc45b01ad159a847f61403dbdaf81572c1dd30e406d0a528fd9f0b5c7accad7c83489ed0627b3c7b66acab1bca2762e314d88087bd95c714c1f086e2db91e22915eaa3a5edc081bffe2d96db8654d8c40e6eed131a82771325065d6414fb174ab95995fbb7834f80dda2dbdfc077c74c16011911572b915002ac1819744cad5c8b7ffae2cf632ccae373bc2c290535d9d6b7a136bf5a4be895a3ec0127302d67810307e359ab90cc4410d92c5ad6ba13883995e9639f66d87f66f88108c4af7748d6396d517af5d9f3b67e327666539219ad346d9e2bae72756ac50eb931096118902a0c8f23bc7d5b42129acf7b5d2bc72f6a2d4e5d46d7b047c979752fedef535cb44aa4e9b13ec5a3965503d5f2385dfcc1a7e22bed8ba919b31cd069e811a2f8cbd27aa3f3c8808b959a48c23745d038ac030fcfa60ade5028f3779db70f77250f431a38fc21da25f1939c7f44c60390db11165b96dfaaaf5aa222f10ea94889acb212438abb7c2fecf211c9dd44fc95aa4abea2b1f20c7d84ec17e16ecb651de67a211ee435a565193a565e8d045558cb16e6e8a9b0cfebcea85a17f62a74ac486c00b0a968bc2c283226fd72c9adf9f238dbde2d166b7998b533ba1bfa305f579b345f835bb77c3e992ffe1f4d2945d43c74800430c793ed13a1757888314d6a5ab6156c0bd51292e61b9909f2440b1c7922e491c5816e0798736003060effb55a57e3e69f3d564d7e93d75caa90b0a2f73c1831cef1e6159f796453d66e870eb3a39c806de11c91038f895f3d94fb18f75260e6b435444a05b154fc2730fb34ae9806c46fa5095aa8c915614cd53beeaba04a9779b11cdcc73d2f1a03bfde8f733fcb4cc5d6c7cc0c59caaad8239ff99616370a4947a77fb1745557ec40b735af864ca3710f0e5989ce6433cbf08af3945589c1b56a438e46db263ffcdce77ba7a4d2d4382ef272498462005ddc0ed50b0852adfd8b25a9791bb6cd6c21198e63c64fec993036c5d5ae08ed35840fb55396d3d1323a8acc919bf678b16251e2a5fc018a1d727d779c155411e00a4b0785c98b0d8b658f2085499209e32f5438edd168bc552cb7d9f9072e9aecb099e19c7c06a096ab5d60ccee56f7398edfd1f035788b857b0d6cf8db8db950b289e5e7a264fa6cf455508fa7d8aabd0017ab9d7c5491c0a2288df4075c77ce18dd280c4e31f711f956e7fdeda3ad71949441c2dfd58e802c39c4efe56e1dafa5e8d6ecc0ebabc55723ebacfaeab38b249bba2a440f6ccee773f5d765aeaacba3b785c68f19c47d227cf4d4f7f70b0e676c1cba79098d51829931a88d9ef8d4c5fe2f362958fba37706219369dc4c4f500d4b1932c02d28878335affbe8c26db7fa941e9263928cdf2630bd1a59c05cec71aaf714f6b9876335acc8f77bf78873cfd55d89ace776269b54ea9ac3349df6865847bb50923a036802680ba8b92930aa0c501f4fa1f2d85be6b404b50648b6f42f6f5b8de9b58f7b4797cb12eda2c5521b486e4687e5440a94595cea32b6247b624f344f96f0336dc19ab2e5bba99459b7a19f9e0d0c48f674b4c9e571d0c90b42c61893a794d04750743e4ecd38e188b88d763172d1d61d9379b4ec2a91aca23965bddc7ae946349d4529fd9c73defcedb83c6c88033acf489eeb0c67685722cf5f6d5a4789402fc3bd94b93a64c2036eae8519aa27a530b15d93cb7734072757a36a95ac8479706671a8c63a6ebe0b3aba040e8727b3365132f2d3da5c5b270df7fa7b3fb0fb16b206f1c6eee19279457b5e2bb6408642f6e812a5864cadf8ecca48ef02df9d4e2d4ca0ad77a5beb1d47eade98546fae39ebbd74a978aab57daf22d4c9575cac28743b9d016e700797684ad18829a44b0293595518736cc37065d4d0e33135263d2bbafc7f983e376585cd25e917da8d8cc9a88b4c64075ffefe65eb1f824d896be108e3d0fd92f3a4044db0e53c1a48b1b05a356a0b65df0fc3da663973444add01a024f193a0657c2971fc5a5f41c8077f4896920010799c7d01373a54ed61a82169d0608b9f19e8d71cf4789faff56c830223626af30de42237f418cb9025eee83230cfd8e3402bf8af739570287c0cd939439dc06d0095154239c94a15cec40f2ad10da7e6d6e60209e9e8b6ee5c1311c9ae8ba9e00e25d85a76940a4a10d592eb4e6c963bb72096a92b183fec016e91fdcb982381866ac81be1f64e5d20a680fae27c2bdf93d5a12debf387c617107316762035c98506d2ff175a16aabce53c264f9d792add486483a3348da9812dc2e9b0e7559b86b83225a97954f4e5744dc59244b56377a63f1182cb71d0cab241fa709bd7065a6eed5a195f32f8fbd7572d5e71f17ba5ab9732635475a457632bf9760d4bcdb1d55a4615d81ee0028538f84ec9987b794676b75c1da8b9a14332937981bb4ba529060723ade361d8d770394212be9382d9ef3e10891b2f5ac0f77eb475df2a416c450885ed21de4d6488a374a393c441c89b9609c9a71c019e75cde3af00fdbbbf973bcb5b6f13200e9cd5e24f5dad2c42a9e3e5dc22fe0d743ca32cc5d85458ca38ce6b92aa49866b4b29ad37999362c1e1c2d6c960130f7ceae1893fdccaf1ea71b632a03f6115713a0f173f23ee0ef6a5ce58c50982f294b5c74463a868c99a29deba6aa688343375f81141b70037cf6af3181660ae161b2d4ad2ce8d2b447936f103f900e04996bb750152fe454c91a20d036823bac9bd63f1c8bbffce547dd19392216008ddcbd20c725565a3952f211085776728cb52a8e9cb0d3d80170d6a36a18b54bf9fb1f35f5e2439b584ee9457a2f4aaee1f8bffe5dc97f91ff0f98999f94c1b970a5ace22a1a72b33603aa3cb8fb863611fd376c0cf6fbdc09efc429fc77f3c04840ab677786d9810351e688f244cef2c49bb4530050a97c83b8687bf17faf1579583fadff5062d849cb9db5c7f60f46738d8c5e64246f36bc2c60060e1a195c264aecc9a550670ea0020a977c62b5b70f19eaa0e466dc5f92283f1219c0589a4fabe572a681c384f4a98248492aa1668c4afcf5078a95a01887b376dbe9ac8b9f4c6424e2c1aa4cbaf7d759793eac5d9e14dd7e7de5a0f74caf0b9f89168a007664b65e6f61a816f35948f5936a8be592e4a11a2798c7d512968973dd11f8e9b1ba37efa37b5d9d005e4dc0045a73d916274242a4477b7b69d4de6d3827acf99dba262fc6090efd6703e1b4b8cc8fba2a2011132ef146e9f744d54e2ef9826885f8f0a1dd54bd7f2c848fd20f20a1a7974b7871ebc432914db053df6a8c6a12330394af0775b8401f89431eddfe443853cc896d8a52ba280693b11686858aa20507690902177e1289ebcac0c056c61c9eb067ff41f87f52460f44b1baf3708bbbe390e60c9fcddcb86413fec2a685b04ad172fd5972275bfff1a2480628b7899d6cc20061018e216e3f4aff92f465996b9395c6b40b7a70eef0217ccc8e805848a4a48012ea2dd1d48459b09eed3be4e088f85b477ad6638f04c42ec0304862a2c743137e05f5e61b74c3297fc2b52444f7d5364da09e4bb6db1f406568c1712eda4db5eaa257102497f8fb46661fe7bb5093102691617441110717294bb50fae2001957f00443d022c747e20bf3caaf1f9d496f83d851ece638fd49680200f478856094f657af430348806cfe1b3b1057769fd3f77e0b7719a9e25cd9f4c225e0b3429b612da9bd74c7cc608dffe6f72e0c83ad5da90f90582a6d1dc5b96587c277690cf5757d556f1ed5fb93dc1f62336cde9c1608227c167efae5778c1f5182e6a8b77f31ae448d9776501b19611afd9c449fbd2e8c8a45ed63bae5bda30ebd53cb6ab3c698406acef7cc8669f7fec805bba8c94afc802e58f84a17b909cf23ea090d9e53446ef9fb2be4f5372931f5e5f79395283cc4dc9e23d30d1d19c7c1808b4721f2ab846468a21762d821caa2ffaeef1ae3374d22a41fe65a2dd575c696db651a6583fe29a1b7ec663fb7d28c09ef68d23ac505afb79bafd4d8e420c85917fcbc6995e083f40d1c33d23f1d0ad83f730571379ca7884e17110ef8443eef54d35b9d22f198c107dc543a4af0c6ee9b1060968ace53beca8cf4a965d28a6dac300d16eb6b359922c9ddecf36894ffaaae59c8f58c966fc31d5f5ad6f6042dc2eeee72fdeb5ca855d1562f0dddbdd3710622e121cb3fb297d8bd4803b046c36d5a04e121f4ced2a33e617a59a3d01b5dd76330e2864e9e5653922288a965acdb04634035ec6edc9ee5ec4d418b292d5aa66dfa80bce5d631650e689c4aef585067f5e4333b8e1e423c5608c6766609b6d1d3c05865a9c76511f12cf0c79ad80cff30b8abe9ef301c7d2b3b2f71516526383a56a609d8dbec64440b26811666316ea8973045d94ae41e00dce873add703391bca9d546f794119df589f76768d26661681faa4ef3fe8c72bf26e663da01853e1da5c691fca2fce54048c0d22f840460bcc5f010f6a0c30251a07df6ae5edb9211b3aaee575d23894935bf8125ac6dea6dddc8893c4bd626da2458676e20e23dbbd55b9583ee32f163a1bc0c9b35fcb507ea1eab96639f3045aed929c473b69a524e9bc4fa365734206ea59fb9cb58a69e6c67cefba8e95f1eb485e8641c599bac7439f574e1a25af35c6bb3fa6d2eaca94d386b4ccb32fa569ee536a77f9392d7c5b89702ecd4125f9954acdb018843211af75733fe90901bcaf24408d1455a32564dbd52affa9d82862652d473c5401d3f794f1d9d38191b98fc5209de023dcbd3ac3c39bc1066aab2dc37551350affe605c6c5c9e431ed70dc0f85d69a9181e3aaffdfbaccd57ee87a632ccec217dd82d822191396c19d80f98b19f8533debaf27d719c5ef1cfe82b272dc92e49390d6085064fcb48519c8a7902da894a3ad89ed9dc5e37fb0d0c7a8a047cabce0bdf778cf851d819f605b9f48e13eb15eaa6cba4aa71a89e2827a4ff29a8321763b6d893629cb2f4b6176bd1978d227d9272255418996904d2b51b6c0dd52d1eda7c3d174849651126678d0c5f01846152d3b905b42a6cec3930d4f1f0b9e31eed06a4a10d32499dbe89c916298aeec1abc0b53255ea9ce6b051a9aec16396e208dcd60b74541ffb61436046d0cbe48dd1f02466e590626494863cbdbde6dcef94e7185d4e0267d8a247985cef3ec68d5815c58a63c27a32525e76dd381d2caca3a58b46b46ffc362b493a41f1866abab5c8add5203e61222ffab80b8bda72588e56ce8f5f751b66fa944aae650fa2a977aa8f455b3bf466439a8cf3b52998e86beab50f173a9e4d9eb8ad54cb4f30db39b27606f2dc94809ebac5bbd72a99bfd576d933f0bcb676521fdc534a916970f15c0cb4538c1f1df134ad2663b867bd0fda655aa083ca8f7bf7fe0895a77e1b5ce55d1ab1c5b1f973f4c2a2b595e69a2b0fcae100b5b1f515c7a1f6938948895e847fccc196a90483627f7ac14e05c4ddd679b27f21e6ab0a070fbec0081f5678a30a63bae14aa8a4c596ddaed47bb49ada0ef27d31caaf1bac8763b2789b8fc83d5381bbbd8c5a62258ff84081f074d58b81d1bbc0afb17c4daeeb3c36060ac048c88e2becd25a84f2f1acf055d09b5123e287d1779389b63717a32cd67684c3f205254a34b8bb14e8b12c5433f83f99067907eac6881e72e796be25977f1f69a86bfe354f2a909b705bc57c785903eee88e312d0a93898aec885e5fd40cc7e97aec7e8c2317027d3f4fe26ca196943e559b80cf3f62ff2221f054b962d7d060fcb494aaad2c159d36b1e8d631755a027fc2755b5d3221a70e9bbad59dd348e2745faaa0f933def4c5d422e5386371bd57a1626527a17522faa36f589a66fe88cdfe5a44c3615ed9293d902cd39d1f1c9458e2c078fedbdbc74f0030189fd19a87cd59cf122f5e886eadaa7f42c5025c6eb6f93c04d17e90388963b67eae58f0392db0cc84b6eeea782b740e7c7b9a7eb93b94108417a32c688377e6c8c39bdc5069682f40113f721ede690b4b9ce50ada289aa302f4cd34736ea77749f0f137de162594c8c1829a48afd62308adc2eeb54105e65e3b5993c0b951c5316b8a691a986f93c9a1ce194242ce1a447c364565f5591603e38c3ec797fd364a399179024ae4f0fc7b620e0d6ecd1297788c842ef56729d5639c2a061831776fb11c3d8b63bb8c2bccc906278fbd783dc7582b00aa579b7cd161ff99e07866e4cda222a9e6d5cb5b885c366264e8f9e76acdab71b344eb904cf959bca4aca2b5bd6027b4389377048031019276b791b84fb407dec08ee0ddcff3088b7c38c6c9d031f978aeb4fa14365548a9831ce4c33c336f094d8c19f7122c5d5483fb1e4693e22f53f44a078eb0cb0300e7569b72c6a52e60e24b028d1d74d2ed9075c8b4f7c858d3f539de9509b2e6c975bd2286b7cea0e97b4d60f59da26462eca1e6349641348516a46e932c43694a9417b12b549e07b5f1fc6f84ea6902d566334dbffef82b18a4efe50cdd16070a28ccee5b0829dff299c5035168aa58c5b83e16638a7abe1fad46ad817bfa252501b93127dd69544287c97faa6c0e026e351e319451011d4bab7ac8ca8889616797f60ce8e77d54d03188692d3ce5bb8947857559f8290308fcb947e9d8ef7b6b07694f415673c6d76129054fcea160d1a0a9db108a7971899062e0f7c4beb58ac1e151a0bb8f30d1aadf998a001b16fc281174fec48149e8aabc76b0937a0a538fbf9638b3c1941178c553c68c57a67b2fc18d52f170c24a702c77f17981b06c42efa3d06fa73d340f0b2cd57718ef6fa34cb246d844363451f9f86f59625207f710e23897fc3788ba92560be081ecfc9c48dc02fbd4717b8435edb6442b270585243f715847e21fd96bff1061050534551a69a5f7d111af3e3cd74a32b2c10d26e7104afc02c42de23357aa4d0589a685076517cd21edf47cd19850648bc7abb1a16071279c617db38c970bad9b70c4a8efd013e442c8b0a0222b392a02ee6393fc4e6abcc5475eeb1d636fe0716770d39aae13128e9b6f4dc216513a83ba595e0bfff5d04db68604a1080e20717c7c87f0c9b526e648bdb5987a6d9d41930db288f0bc17a017417d3ae95dade7d4563917ac8d60e6d3c23787e532dd17bc73ac3e98fd9dc3bb1641238fb39f357842a2a23f0a33848d109710f283cbce21632f39b6f9032cda78cb89ebc3753536588253556022b09877b0de3de5a0e4b3c254be36f200dcd6ec2a285ce0758b83aae049a54ec3a73f5fa80d2ad84cfea3cf94302e758b7fd59138e16e5605f04fd40464d774acf2568b1e3687b15efaac09ff9a49cf5c5ce188a3b5305da71f5e8bae260361da55e9e287a3b0290bfc026b306c5f476a22b5afbec11ac242ad77e2be94ae9c9090f2454ef425eeedb49ea1994d5dcfe9adfbc4b9ea696cb0e22a3a1e9a0952329c3f28ad1df5dccb231ce8dccb0da265d33bdf11655cb9f0245055898e7f2442c3ebb12501e2acd7a5d52f94cb4e06cec9a9201e10c9143f498207d14d223e7f837c67839556a7b65a35830000000000000000000000000000000000000000000000000000000000000030b478e20c1ab5d3104df07dab811a858f9576c0d3eec7a2ef060dd688b89c648a43b8bbb7fd90f982833ec86ac719f80600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000521a8ad933adf0992419b1d6c9f22c036792655bd8de07a3a81df4efe2cdcf0960f905d098feac2a79aa064b1f7f963c5bf4176b70a222e7268656bf735238113e7a47bdc0eb21b54046b01b7db7047ee6ca2499db41637fa80397735b6d0c8a2a2fb2beec95d29cf942f382f1f9b87c61095340cecaf2d30bf6468012915e4840699610f2f8ca627b078135954397363f7fefc60445b67134131835799a830dd0000000000000000000000000000000000000000000000000000000000000006016c020000000000000000000000000000000000000000000000000000000000f46b67b13b27e4d11d580b075c7a9a2e07cc5aab6b8662de3487a059b3af80cc90205b10c69c1ac04c9aeac664d32219050843aa6dac85e8666aed4a86d0642809a867eaf96d9297b5190548839091a3863c80fae528f738aa85fd50270a509f2d47cc53b35caf56bf6ee853bdbee7720c44b522cc41d60fce15f076764c6c58cced0fa378e1e174f3cb97301c10574a99161636e64426e715714e768212fd1000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000240000000000000000000000000dafea492d9c6733ae3d56b7ed1adb60692c98bc5000000000000000000000000000000000000000000000000000000000000028000000000000000000000000000000000000000000000000000000000000002c00000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000034000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000f2d3a90000000000000000000000000000000000000000000000000000000001c9c3800000000000000000000000000000000000000000000000000000000000ea1eb200000000000000000000000000000000000000000000000000000000636831d7000000000000000000000000000000000000000000000000000000000000046000000000000000000000000000000000000000000000000000000000000004a000000000000000000000000000000000000000000000000000000000000004e000000000000000000000000000000000000000000000000000000002a61be16c0000000000000000000000000000000000000000000000000000000000000020c3a1df4db9777e14f5bf8f7d8e58e3443c0e9a7b9883f463ad956e25617dce2700000000000000000000000000000000000000000000000000000000000000201dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d493470000000000000000000000000000000000000000000000000000000000000020bdd8924cafb2ed150a659ab8ef576649516e4310087ae06c8e7702c0b2ede217000000000000000000000000000000000000000000000000000000000000002089cd5ff8a07573556ff1f3e9db5de9d313067710d0da860a6dbadddb9029136c000000000000000000000000000000000000000000000000000000000000002040657643f917f68007e4da3b761f7cbf0aac17efbc1fbbc89e0c995b30898efd00000000000000000000000000000000000000000000000000000000000001005ba42396f50a10ecd0905ae183557cb68766be7cbc570c66e485a107dc5b3f351c16df7594483cfc5adadfd28b37c3c51af18e4d8bb66e73cfd71bc65479e870bd019570f129852de9c6f649f03c64f1f434ba388148baddec3b1df5e969655953ad02a99f3a81c3e5d21a800a1519fdc6269877d839440145cbb4dc2d59f8eeb3229b64ba02982894ddc349971b0024886fdfa567e8804c6de056e5e214b725eacbe5c9b7467e674eb3deea8151cf5840c9295b68b336823c3956be27284c5c53fb9a9f3ecd31cb17c10bb1865a524436e3dfca76490a5c653d39ea483732f45232299acfde2e830a4333d474e1eb4bb019bae0812c3a75590d19b1e01bdea4000000000000000000000000000000000000000000000000000000000000001f496c6c756d696e61746520446d6f63726174697a6520447374726962757465000000000000000000000000000000000000000000000000000000000000000020c6513148101a675ed156667013560574cf6e0c35b5bc432929a57d1a8b339a640000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000080acfa54b37eb2efb485a50a5ef71ed29cb6c2ad9a559749bf2e069f9c05839cdf5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4bdb56114e00fdd4c1f85c892bf35ac9a89289aaecb1ebd0a96cde606a748b5d7131db636e785260ffb81a1ee2957e8381859ba29689a972ffb05ce6f0feb6baf3a38e255467853b8e32d41c5839eaf1c05a715f005679ad17c82c4d0b5d27e680f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4ba4d580ec2162c4970e32c6e8af3df4fe929809ab62583229acde855cda5227103ea7964577bb1a43be702779312f8c9b0f0b14b26537fdb3ec94da19ec99b225000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000040fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffdffffffffffffffffffffffffffffffffffffffff00000000000000000000000000000000000000000000000000000000000000608699c3aa2f99795c77ff49318b76b09ea322e7b2cef8efcea290063a110718fc3a3e53993a284f6746f22c11146877ed056efcfb4f33203eb9334afb3e3e7a9f0165aa8aa1c4c221ad47eafb117e9b1105cafdd2ef61715311e12f32e9f84f2d00000000000000000000000000000000000000000000000000000000004d612000000000000000000000000000000000000000000000000000000000000333eeef85744d83dc9122a9898480884832fe91ae69d82ef6e6ed906217b6aa723e35a264c76b5bb9b1523acbc4fc085b4de5a01794afef4df4155271bd47fb6c1ef70486eafc2f90d57d650ee94322c184c109719999c975bc377563d9e04963b4ca000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000061c000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000606000000000000000000000000000000000000000000000000000000000000060009889e6ebf0adbde68576e419582e630f72372c68157697799370a14b2c3368a86a8e5b60070772e66cb8c690b376f105b83a22ec3863714741c75e804658e9d18ef080684f3d406a6e93d18d3be688ef53ce3cce90c680776a82a2f3e8fd5aa49206b5b9faeb9cb383fdf6042c01e2f304a5ee2127d973212223bda9d6e9cc4dafade80745c358cd1d6c7520e3b3d9f9907a4f81cf10eb63a39d8401a94e30178b0a38f7c4f89e6e125841db9f125257c1427a698c5ad2cc6c5709a78d3e7302952599bcefee1eb44e280244ff7fcf977a060405ef87de632d64adb746f99fcb958be62ee02fb71d23bed5b7ee378dc6951e83400fe00323a49fea65a2b2be54be9d28ab84cbb644add5533a4e9661f59d0c847e7dc65c26a079b28934526fb8959d04a09991103d7dabab93ad1c69ea04cc1ae9b24aa7956b275ac7e40d504f8ec088b116b60a1bd252d7913960d3099815a3646d8ded39cf1e26e9d7c58c0ff057f819146facdff75dfaccfcfb0c545096787078e90d0e395043e1734b7825991087abce46ea29ca639cd7ae5a7b25abb891fec52cd65a983bce61c9e42812a11b296f590c85d14af910b034c33908a89a7095193bc3db4c0e5d3791baa6b4dce5abd5246f747e54470ca80f606ef4c04cab58f823fec556b739531ebc93d5aaf7f89f5a2fa59a8fff76347853d98766c426e7b6a3a662e4d6e08f76dcda14e9f9fe261909ca0c4c8b9b163e1f71828a1e4031676726b91b8bee4b6cb2a6f8ae9546eee610052b7d7d3f421f7dfb6f2f271a8940f7705a10eba1102f4199c081db287187a3eccaa6826ccfb24643feb42894cbdf1f87ef74885bd8856fd378b46f7bcfd0da573c0ecbc6190e228337b5eb3d4856cfa085d27de3e0c6186bcf7479ce651f24c57a0c49a4288eb390d1cb29bdc6d3a7b75e20a118af81a90954b7c2fc2afc5175f28a947440ed6408a4d1e8f3cf90f6c8a01a307adf184b4a9950d47d4ce2c08de9dcf04f932054a23397327fc743d49721c826b8218f10d0caeb8bf328814bd52d2aa8e004ea5489ff280730675024dedbd04220d42f618f9898752d2d69d80dbcc857e1da79ccde74138f17495ce8dce3393349e202a8bce3626395fa52133a08a2093ca32ab869438b4f1eab7aaff60da0800c845fc503daa8ce39572fef7e6ea17b429718923994c4c6d4ead4b0fd9514eec9aac3c35095b80cf1601c120f9fc7352be2c20bbed245fccb741dccf4ce84b3245691287e8b0fe579a9353fd8355f0c900d9db6ef6cb0ecfa5e9217777d08323a57dabfaf483c916aa8564314be450418fd028901a5b7a431923352f91e034d954a4cdadb518d65b3442fa6e28e68b4e8bb3bcc2416c12c735b2a69133204be663f19ef5849d06e3565085cd3c8cde1bcc9bc15269d979654e7739bc4f309ef3515e28a74d97917f181190bc8a1c1573f54e6b094b6b87ee20168e65d0db5ad63fa51b68f68a57184758e0b96d3243b53a315865adcc57ebcb1f8940b09605d4f9679535dcf6998d5c4c9e0c30d7cd3f918fba1507cb252c81f70c2a44c9bea229c0acfa102c8b7e6a186b73644b8c7ec80a5871b5d02ae870a620ca86ba9699a34b7cc0e59b0123754e1880e9cb6b1531c6fb61a4bc1d75f10468577c24193144eb7abce41c56922eaa46e3b9f4210b2eb0a72c1afac39b3bbe8a077d839d591ba1e1cc2f018dcc649a63b243bf4d879924c04b263d460c506413a7030cc04fde9764c71558ea244833a87cc8861dca6fe76948d13a276b2ab8490124b54c36d78e463ca0a67441df201e94c6dac013e41aad0181da25fe0c194f714d1a0e047ba5e4dc76688c966456335241aac4168ad09a4b7ba3026ff3366da3a9b5dc02cc408ee9ef796f261af6caf08226e17750af2b8945a69812a634d6707fc5a060ddcf47eb774d21a0757a9c5c8fc65f8f405551650b4969565d295e872b21d9dffeaa7483e0e1cbc83331f82782036479db3b8858dc4b3f187757ee2eda6aef0d637c75c2272a53b50062c6b041447907666722391e26d031ea5fcd25aa641bb75359e0c9360cb0d4b6d9294cf93d1d91678dd4a23dfa3febb890237d8fee45ffcb7e25dcaca6ecc7fba7c05a3390a33f8f4d190ab853ee05d3e8d4f68f7fa6856918f153157953334ea91b75194d65873e72ba64a9168b28df71388aef7bd212c701cf3eb0779fbea01ef6e4f67379ddd4abe80865d8ed16e9993c8ee4ba325caba47250ea08e33469e7fcbd52f8295f035f3896b1b5cfbc37edd162bca8dca29af0d633ac1a40acaf1ebb063431bfcfe54e2adaf6ca7033dfffa55dd63f56f6b621061d82a20a7009c6a3507521cbdd1028d0c390fb6cbee10ceb5e7d2ad29b873f0c171a70685bbface4b78c018397e05e89470270f72c6fab674520f32ca2aa89f9c04de840e6eab9a74ef15a3bcd2659ce3cd41525c15033cd2d483bbceb3363a2ea6a05ca9c52388ed642ca2975253828ea26db2e7f92d3938301ea483703480428841c061f846ef914a8826373a328f673628d0e0b535d6871350d0e12ed19772666fb6d4e161d5f59aac924a40e72dc1896425f6a07347d1a8d0bf162469cbf844fde4d99c143b4831a67d082fbc9c3995aeae20fcaebff5b084ab80f06f310754572aa12d85949c92adf13c1a708587a97262575c4cda43a890a73116f97138bcff897adfe80e2ad7266edf21bf18511f50617fbeebd8c480a75ffafd920c5bb717878dcc8c29ed459b6021ccc2a3f110e6ae5dae50faaf5be68052d662c4e3a207d937ab4ad788ce87c5b2baa47d06de0cdea015911d746942a7436a7660350edc836c8b047b7aa640bb40c60dd6e345055036cd121b8adfc02bae5bbf824cc24a1337f31e1c74e3d1fe126b7d2763a39aa83950eed58fddb5100655603e84913b6eb288e87da476c48df9a4e6223f5a3cc220f2a8a0c7a879a85e1c7b6923547fa33112a47808eff3540ef30e7e63874aab96ff5d91fca9d7ad89f9a955cce17e9f5d982805005aa5c422574755d8f3d2b383039174cef15aa8308156242dd77e1516ec24c16679fa87c269e458a789de01492bffc302dd729fbc231ad2f14ea98c9d22f19236eee7ac059f3aa9d8ddb22924dc2c89f1aef29aa0819d40bde760745fd67aeff460bec0a9d7916cd83e118c33d505db802abbf92081eb0fa8bdaaba862397f33d5de4e9fa55aa14238663d6717652bb511e0d606eafa11849b1bc90f35c7cf0331864b537de96488fdb6be72b3f22fc8c68813444d6fee12c80a38ad3fee08c533dcaa3127a71aeead767a904ab8ae0fcdd77a4b12b0764e6b9fa4e6c9e842958da093486397efecc19c5d8428929589388f3d080d461e839e622b304036bae1ef84dc1df6940476bb5462093b98a0071bc5e3805e83909d99c1e7ce14036abd5bb6f294a2c62336fd3aaa96dcc6d8fbf4eefaf67b4f7388538c9d30ad93f155fd9b845debacc732c4cb9b5678d8a5923d8f252bce48fbbf1269ba0186823a51854649a8e7a5da73e169a58e8a8c7a6243f4d08ba67d6b7254849a945f032dc6de1fd178bf08833caf3f5a787d6f22ccbc649423fe5fcfc2c6a202b034e980205e3425a982ff47f1eb59a4b5de5489267c688348982e66321e6b5b52788625cedbc428777a3abf438fc6bb25c201b0790849f24184bbb2a6946dfc68bee2c9890206440535ad09eb03f4b91ac5c8f1c4230f9a76e7b13918364f24cb5fe1fc7a3cff8c80c9d53169daba45bf149a94b22dc1706be44c7b47047d3a140e9db8ebfe7071cdac5a124f1ba7ad60c620ec68a6b5a47a9846f0fccfcb0096d8e5bc119300db2b461205a5ddc7ab95df9ccaa7c7d3d6cd6867f201beab326cf46e5784858250f68936a378fd9825e914e09406a5bbff2bc661189484204b054a77af7199b8c6c6956ff8d8bdec20a56ae9f62059f90b2a726380ebf0e8b93a25974536ded67523f6bf0a837c28ba11c3116cb14a2d00f1c71f5d2ea130c1d4af271a2c0d068385fadb2f51dd5c35a8051eb6d1f0e624a4c56eab80dbe549835b358af7c5e7a38ea7b6c10f01fe901d42ccb0a7b5e0bfd38c0085198945f91d1089cc0dcb9c42ab3268ff4602f42836b395f26514828a53293c067e90c4acf8e6cc4c64a54e697ffb031216863e9c52b5a0fea39ab10aa88d2a24a6fa26fa5137be410ec760ed551fcefde0cae7cea7d0a813ba3360e3da1ed0d84799a1a32a05c2c69990138e41e8e18e6668338ace50d8bac1741c7089a1ae1c6fceb46f4793f351a530366d530bba26ccf40217e9e0a5261334d1e9623cf131673960faa76ad3cadfd7dbe64e2fb6d6e9ca4e92fb31e309bd34256ef5e11af0f6ffabd231b76712f77e38b3f2b6729a7a11b51884ccca0ac34bc65a337c8a3881a05e180b1adff2c7be955b2ece53b92f6afd12d8e6766345b86ba948b1c39500ff5f2b70a7880ea4cf000adc2e66dc13110cc7f9c22f36ad27bd403da24a81d07c0351fc939bd0bc39c453269edbd3f1ffebaa3fd3533c6a1345f57821958fc068954c1338a4aedf07430bf1e9e544f376cbbf132a093bfc5897fdb17a32aec925174877b4aacdca0e79fadd34d04dfd9758dd2eeb2c8cb1d61ad0d02ddbdad9ef96f7c43db563a60b8fe769048a888ae5696a633b6301f3b611ef429bbd14aa93ff1063e4c20bd0928c7a386323c0ad0d7ecdbd46f3f614f7c8b46fde054aceec3ca8294973ea8e657ae840c7b6d9c6eb87f46aabc6b64d290e9038d14baa3db7203cc50b65c4756a434a37bdd413139eb09af094e8f552f48465595a81e4bb81d33b36db0598f887a4c0806eba0c444f19d3d907eb51b7f87c88e0269aa02ab4894a374c230cb1f74615e0dbc81c7bf8857971585a4a5538ecd0e37f50920a70f52f20ddec50083f6acc499c7aad97000c38c7d385080263f20f5a7ec9f254d6cacf4439bb6776f7fc87d735f455f27cf5139dc0cc907f56a840355238f5a20682c96e2bb21ea6ec941e7675f3986f4f6a839f2e085404193d0d9dd61ab89754483daeac4a9098691e039ce6e8227de4311ac54617becf821d3f9c9147cd47c894584f3f849e2c2eb05509a1fb21e6252406ce4c194b96e478d77c609fd091b10ac91276362b45f9932440f789e6bcb6dbf5d0c4735fcc52b7d7e10e6e6178d24d4738505f6911a50baeccd7f3ffa93662983f6d1534bbe2dcf861c8b931d343bac76d6c343c2cd222a01274aa9fbdeecaa85168ffdbe40e09f752f6df47fb1dc1b8f05bafaab23a5a3adf49aca88acb70753ebad55823cc0f593e8ef12a90cc91e93183ea3b9ba61685e35cb665a9708ea8d7435ad5e9b97b2c8632c02b7f2ff77cbe0b9634d422b8bc9c69756304ff34dcbe4a9e5efb09d23d51ea260a9951d77887a074e680293a1b9251d9039bff9b8860a07e8c932239ccd5f6d7df026708c51103334a96ee57ea5513217bd4fe5cf821cefdabcde0e514d25ebb811e055e691ceec5d9d2a5fd28de0aaaacf5f6ec39c1877a30ac948840c735a6a69934d9a91b92af1781da257d3564a03f10c1f3b572695e1b4de50709096cf960260570768c17cd69c5a4ce6be9ae7e7f8e86f1f96db8eea1f6b2886d42ea9904418a5a7d97835f57b33637a562d91be90f0af5219517f772d9d108134f065bdb0005418b25884cde6abacb7a794d1e2fe9705fb22f1a84a0474b1ef8f2381a71648c7a77533375a4c03de47fbd8b27c56415a32a93c773c1ccf3d8c794ab41e2d8402f1df6c68dd2382c4aa80c68c99f2b41bcad722fc0bcbb399edadc7a2481ddc7ab1a15c566e25a445d0ef04bffa183bc26861c0ce98ffd623d778e92c7040242659676a01a376b1a23c4e4b9fe45dda8667b7f670f8126b69c6b1cc857d0d4cb50dfd8054ac68d96db7af5af89c5c6215750fc266723d8caaba8fa49b2d6dc33038b56f51702c1873d9b61ebe3bff30c076de7880d7f88ce772a5d194ab0f2e45c6e4284873dd1f40595251be4aeffe9353a66e915f895d8a08936ccb835720d53b03690d5a50be27b688c5cdf24362150d8a7672d385217f6c98961a0738494e61834a686d50c4869ac2e08542f8201528f40fa9b797256ef81d2d84afa48ddf7e77dd1478ebb00eaf2195165c3a290834a56facbdebfcadb592b9e3b89b43171a683122a01c20c9f765d6b3490a8ce9793c1aebf19792b226d2cd2310813badb6a9883954668f23c8d23ade6c70f3d2d135eec4f28292645777b325b9e074c94d223ff545e00f1e5703311e31e6a9a2adb4ba8e35933b5d757fa358eb6b55de1e86596b69f75193ee0bfa858bbcdfa1f1d0bed47c7bd159303bc71c9432c18788847839cab25cf04d5924dd04b30cfbb88b7b6a6e44de7dc4efb4b50a241f3cd763cce9488bbd13da290e74c18e2f078785181a9c7c2c52883a41861ff108f6d233307a12a931f297c0b20a2811d41c332863c06b9d2a9d28b61ba4e88dfa72ada344fcd118458f6359f7f78dceddd8c2cde6a3491dd509897502a0795a76a9db28651b321ec372818025e2a687b57258924355cd6e78c0806bd0a5d2cfc04b0331847151cbac79ba1b16fb567d802f9801e282729759fd28e02d90356fdcd8c99734b3df5a71878fd7568d4679f4df21c75f1b51deb4bf620083c82b5d5e922fbb4bd198b425464586f1eef6b6ba07bfa55dfa877b45753d72c3d2a043493a3da496ac7f003dba847f1391a931c9deb72865abd734e3b369062a75a0aca8ed4e88bcb7905b5070b8008b8e454ddd18da1ab4d3af2924d65a7626a9c02229c4671509a473a7d0b17541bd5dc2f8e1a0a19374434251c8c40da1b302cf55b652a88b81fce09471cadd498ee3e416e184d658433fc676a118e216dc4c61bf7bde719654096dc23675c73daf3df0f0606851a794cfc4446303a504a16feca91d0e2255e21570db3a5efb8b71e219f10671e6ab692af50667d56cdaee546e73af396484fe0f0ff4066f23f53f8920edd3ac573b46946be4c962ef4621f303fd2ac454b50cdfd1874704109cad77dc575b8d005105998f5418349dec43d22d981fa93cfa56296ca364e3a6d00976a5686295cf8fadb0ecfd4e79df417b4002a857aa7bb569f2e1f683538d10c07f74b9dec8b79b8a9e125e0e9b236f2369d4bb3cf3da80860a9c844a3a11dd580003c1f24ad1dd1359d0c3e4137bd1dedc20f1385681da667d2ae2fd954e371a775f69b427228f7106e5649520fc59a996d085823fd01f54e324ac0864c8c6cbe3b397e49ebae0aa9f1626dfde5e28a6ede698d4d2f9a7ad4c509a6360e975328e54b015d07b79c03b16f648de114f01c7a701a4ffbac2b508e90fb1d1bc433b21f9e33b44828ce6d8a5a8952cca8fc0dc1c4a1e4497689b4908b0a60e74fedceeddd0c52a99b833217806a53b4e0a12a9b048747c0a8e4df33e4fd34c26e898bd88d5262f87e5dbfc1a7aced86aff5ad2cbd9e6fd7a9ba493cff3f7d333b9ea22cd48c1cc95936c8fe2631fc5544bfe8da61461704356f57a67769d25dfce58da23eae31fcdf7da712d9d2f4a1ddd92bb493729d380af4864b4389d7b6213da873fc1d3d93c67cc1e495c62063a9b02da5e54275f5da26dfe730cf3ca26556db70bbfe7376398f44d8159958859e94f709688dc703348466e21f7067cfb3b3932eb364a2f967af4b3e37869393d7402b08a3e90bc0ba486d805ffb9992035c4796e4c44445b020cefd10338ad439978c21ae7a87611b3c378979e1f56ca88ebb92035cd9644a296b4aabdc5d2dc6ff64f550c936676ef81914f18d97fe1ab0d15276d1a6f079404e1650cfea7542d6107a5ac46594497504f5af7bb1c2b01840fac21f62b522b2789eb5f510f959df84039cf3d0e4f40389171b8771a5f598b971de2f2ee3cb2d759611257411d6f042e54c83a4851c443806cc4e56a066a3f77551ec39c162cb58b04e84f07201ca0ca3afd27ada1a36f6816c19ca5a8a0720067ae32aba39f31383df3e2cbe8cf1f181577e926e62933e398b3f45b3efe749dcde609e230a463e131f451f9e2457a2b6c14ea780b88424c5b0cbe4f4c882846dc7aed502f99cb5a2e266253645069d16b99e9406b8cce96a481a1d2f8712d93892115c51efb38347bc0f301f5303b17f0d4f5d4c4b44ca8b317a584917059cd3889ffad2586800d72b4078b570f27c5c8829f1cf1f9af6c44f854949d34505dc854fc023a445b640d6e4c68b4a529ece58bc61be4962288cf5e3b5c4e400b825f1ac857e0c384c2e2c57a100e3ab051ebea1ef97184ca3b6e0c49357a709327010a7af04d9955477947f689e5e2425d0406da0c044df34e9035dd688aced5445374e935bc341fe3ef5c6328ae7fc46334ce394f29915b6f2e540b4796d8937880acb27c33f64cd09cdb919bb5552192ab3855ecf450d4d79266776a74ecf428db3783a63ab03f1515d77c055a973154e1ce56139bd3577791ee64d02081b7efd789c8da32c41b357fb26e382fcbaeb80eda245c99b58ff15b95fc1635dfc9ad13bdb2618e9dd168c58bc2270c9cf5830e3662ddbaadfd1be2a95bdb7af35354f747af3e9fabd27cbcda5a1a802aaeacb6befa51fc486370477b71c093d8b0d8c7c0b7bdedbbcd63b72e2dd48a2d55253196a279a5876661cf4677b2e5dbe09e1572a189c34e4b229f1cf066aac581147549b16b572ff54c0297ee04335df5bf2555f94725ac1ebc5b5959212ccd4da156df3048112faa10aa251b62546385550d86786485ca8f63c1b144390080dc8e30ae9a667da43cd4bde7d4994fe083e31d426c927b1b3fe3b94171c7c3a680584307f4df5eed9723428b830d5c6185b0ba1988d94dabf4ebbb59aace3007ff8b67f8f12345879adfb9cf77b520c6e74a4c09153d573daa6e7b3dba4db070443e24c573881e82ef20a2999d8460a02892a2d1d2cb40b308ca498da77a243e9902ad9f2f44ace4f283b079fb93a7af429334774df6ecae6a50295ecde31c77413f16b4b90fa4b71b7490912ba6163b4378322db334ef94aac91a7dbe2071c77c3691492abf11975d00979d507083a1a9962e77e9db4260d26de78ab9ec94f9fb0a528dbf99fed8b136769b99bf946fb650b728b0d2bb8f611a34e25d2910edff616630f76c06f0bb043a33f393f36f0185f8366ac65a30a0d81645fc55d8266fb761276dc4dcadd708cc68500eeb3879ab634dd1319f8f9a00922601aea6273db006ab6c60200dc77cf4951b7ab563ffffa8470fd9fb8bdc7a3d96ac913e8f0231f2c3935b710d64a2c55f4caff1c26aa12939aee87beaedb86da6d30c4396e81a9f024f21eb89183e6c7f9e287b7d29f11285400d4eb00cceea625e3c2ce0caee70fa373e48c050ceff59e0ffa00a14a2b2f43a8839a5568deea9dadd7b42e624173515a6e9950ca74ed1d01a4acdaa7a3334bb5747305af728561caab8c4b31b0dc4d94129acacb150ac1730b8ef30402b6550aba654e62a75d97335e317f0fb54d76ab75ba0eb15e7b8ffcfea55535447e764b3ae83f3804621ca3f7efdd85d9003f7076c0f1b45048e4d84fc161ab8c43f3a78445ad664b7a256b0e87cec1f4ac34df00ca60cb757863af350cef66096520a8ea2452cd437e18194fa078e1c78462fbf84588bb470386abd3341194ab12d5a52a691c776a426b12f36539d9721dfd76b808939303e47ae79c1f6f87dc07edc3acb859cea1739b601bf87c4e2a4ca95c8268acd43dfb815591f7a7c381fa0f8067ad004193170e5792f66cdac225378b19c4ce9aef39b870df257790e790c8639c9ab853f9fc85ddfda25bcd12a8b382ab8a5a47c617047ba0288e4f324c8a93be1bd8401821d16466d9d2e44a032cc4ba6aea83e3c4b02dd9f650dd314c57564086ad1bd599a31cab3e6bcccf98bf3603b55c57f7460b49a487b988ab0165f9687a514a5f6548be0c11cbc546595fea8f68620634cd67ea80854abd16cb036639aea313290bc84f4701600d018dde8ba8a911ead0eaea9b136c941614440b403a1a2422e00fd068561cc7c804bf01955ea2681019d26a9f9f3988c137e1a9b522c8049167ad5cc77e2f37fbd760f2749e10cbdb84db5353e64783e517de2b10c51793c7ab9619d2b7b43195c8f471318d3b8f8e325fb5fe49afb3e5bf3c268b60e002a18b8846b458eb09ef7ebade747c8337b3f3fc3e65780dcf0d13f4ffa282e8b2c6d16d7f946ae89a8ad015cacda7c3bde12596332fdeb6439a8aa47fa53ec1afe91951d741f684471056d712762c988feaedf91f7bf68dbbd8363a652a8035413761c0d3249673496cdb21a84edf97c2d20969106720b4cda907e06d7df23a04eb72d119ddec0bbda1f73af5e0d27d14d2013493c7699f066dc6976fb87ffbe8dc65a18c9ed2b1f24d92214002cdd83787058537ab2eaffbd3cde093d4df7c0e586b65c5d94490e1ce8803a05387ac0180bbdc8f6fcdeff9e22daee881a53b5e36a5c693d6be63b4715c05b06cbd623cca981b354bc13b1374c2da6c29d7482f6e0fc0bdcecaacc81cd6d52181ec48d44f2fd01481ab70f8b40af76779c95c38c149a57301cb194a52032caac63a210d326c26ff99c12d3242eea6a2b5fb9a4ee52f93559df43951b0d293d889633dce31817fb865df05a8d696ed0b0ee47711d790f53fde9e7a2750d70fa043b1a8252a4956521f58c96ca4a09fe4c9a8f8bca618ddddd7bf22237276da62ecb385702382046f52766852d1b4e625a666ea8c60c0097d9159e0daa40b3e2ecfb2fde3a50e121576816cf309c475b3ff6c0e30a2617a88d5e2b6514654e658880f98efe4aa122b0212ff0a6c6381c0b87affa1a679169c1cb512da403f703bad543fbfb3c063d80fde3d1b8ca4b19a53f84b082504c99178846aa0853a632913ccb9d35d11ee04646395bb3522693e7bd93bcae11215ea68c994b85330408bc7764acaca891d66d704fb84d60f03d42e0ad321c583b664445c0dba9ced738d3abcbae5fe4dae2f25c68563cdba745d8d9faa266930b8d472fbb5655c33103c852e0a78994e44e75af3e01750fab5d3849a307df00fc26466ccb45609ba8c4890a5ca4f03bec99e22f9fe3dceee0de47215f517705ed3ac2c36b39c8528d168331a50af5a65e3057f4e7e65d965057be1482b4fd4787691c1e21d0c38116100d47c8add2f75afcc12b7cd84dbe6ad6fcca220e2f26f77bd5e78e7c4b8b917ef3b4fda4eb4bb831f61ad5c909f67320ab97847cbf87a87d88aa1d30997a08686d1ae3d3f03754fc70a2e4587ccec494087c81ac50d81d1f9134761f5ec4079a015e6b2f03dd3bf0b224d1a920fe74ae0f7fc0877d82ffc5b41ac6f33440b4c382088e82ea841ebfb4eca06dc3881409f50615d9aecf410457507d1130fe6443dd2343dbd6e89875673cc11f66d63dcb2f224da351d2ef5aab7c6a0ade315f79eb688342788375ef239b53742e9fe28bb0a83d412d18d6618d30fd4278888e2b75fb55a00e8e42c9e09bd671800f9ec1174a9682bf7b657862534decf3f0ce9bf0e2663a18a8bf84291a21946ee6ac3de952e49633a555377315250ec30a376924578070aa7778d798046cb833a228ad8e1c641b982ca84d2758671eb35f7d3ec9ceb5b80a449665b863c207aa42c70d68077f23a0fd85e207db9221640cf7d28999e2ebe2abdee4debffe74558d32806c61848601f754a31125bc07306a4639922df73f08c3494f5c8d464508204da6ffba4bfab900fc4bb0c1e456ec36adc15ad393b8bfd528171863353d22f934ab78c29a5adf2c65056b3a5cd92eff173c26049734210dc502d730c394dad529827020f38825c923c8126a08279b2aab497e084cdeec0a49be7809727270fae4706cc66b47eba63a9679da8c7a7836bab77e89cb8cc0bc4e1236ffa4efb673c5a4648a0990c8f6ba8631fadb1697ff5f5d41ec5958b9a379ad14a2dc0f37dae034f05037a2c9d3384dc7b6b907e10639a63d482bdcad46fb1b7977230618e1f8e636ee95de3fa042d3185b780a923b8c8aeeecb6b4164e99dd741aac7fd5a6f9d5788e3583b8e225130da0a74be1b99d3e3354123f567a2cde43eb9fc2ec2dad29ed8a6e8753985106c92f27be7a0222ee95f87966619c8257067c669c7604df7bb64e640937be1ad562b83687289b3c760a8ae4b2664fd286bd62c60b8e2a7d013913a8a3cedcc7d5c75e898f015c5b5129af22d9dac56121fbffc03efee6a420e507d393c8422dd46937d392a66afbe7b0f93fc7e4547ab9b4c3168ca413221611a343d1951d691a2d45ab8abe14f451e2f3a88ab9ac53cd81bf6a4a9ef5e675d1d45157349b87f794791d5e57b0837e5956d6d76147f5941d4b9b5542eef5a8c6583d97cc4b3db037992bbef015e1f49eb93ac8fa8e0fe207a81832f67efe47389c00306afa7a43b1b6dc66546a54b987a4a486bbe3bf8d2dcfd167e1e85e44b1375276fc29850c9994ee7e98236bac682569d3b45a003c9125f1929b934ca33f6fb097ab6987b5e2fdc01691d1b1461ca1225c1025da4458e4fcf704b61f5425046d54fd9f87b4cf34a3ef54426be7e592f282fed4a9d71c5d709fefa3880f35c44375640e59a7798f41ecc211043d1fd96d6c5888e184a956492a71c67eca455aa9af6cdb4f50c133ee2f294ef136ee2c5f68f670250bc4f4cfe7457d318e581cf9cab64b46f8eb95d1a93e7634fd10dcdfab96ec0e31de0e7c4feac4d5b96a968889dab92e1cc61c31d23dcc2995f99dc13498cfff4cf20d0564556e378fdc77bd9765bcf7b5df1067c45394d19d35a7f32d19c7f446edbb4079c5cae6a79257412e5cc60c7bce0034a4cfe10c00dc43528cff66ca9a82bede79a4fbc0c4db47e8f94025765e574019b54d45b8446528a79e98f6f2641f98ee87a7c2e8f112f9ca8be4eef158b4e78491a4cc9b79a06af100bd86e55ae191e80563da8b39f5f7026d204efa9d744f79e992341eda2a4fa18a5fd60c3a90d16d3837698a496432e6fdd7404b60ff67f4aca4b60f4ac535687796ae0e9a46ce9da70224a356c4772593f65678418175f42ce686235fb6b747a087077f445bb962eb07fe7c2a79cb89f019db56814c4385f05bb05a95f0a1bba4268e2a7c0a67345a7b10c6ec99688fe346fd77d89d4d50e4ac20619bd33ca902e22f07cbc749c331426113c6c92a69954a4496806b5627952370f17ec8a1cf4cfc8ea098afd97311af68ce621064d21fe36b244bb9f17a7e64d8c88b923d70a9f9f0c63c7d1df14d1efd555cb29d3a293d6bb977d25328cc397358eb08518ad59cfc2769ca839f37b7902c0c65d283988f32d18cbd485017da9dab206723ce1346c992a6e4b32e5f1e817a418dee4210dbd5105980681fb133338ee8c3edaca2930f9331c956eb9db004f799c86f0b02efeda05afc13938a5f63625e0b9cd2b7e6af516094385f51647dad2346d960a67ff74e45f9fbf155fb6bdcfd75370f8386e66d490fc546d033e9842df924bdf1d888b73a31684873dd572e136551e6a96b0eba4dd1ef740ec9fbb36a699dc3aa0fe069b1467a6bb6ca8bae87084c2f12748dcc74ab020ef8d0e5a91b519920a693afbd1ed1ddd0ecab5398a1fe3418d722ae088652a235aebe25ee888c830da465175f4740b2ec39cb076611c25032af094b626966545a4e9e01c0fa7e67bb9364d5299f67c57717a4d158106f046c3ad35a706c592f779bdfa7cf78eb49b7a07696fb197a55ebcfb0965ce07a2444fee9bb13d0f4d00ba69765cd4f1552ec833def976b7c1c2f1189beef22dec000b9ca9dae954280abe6b6fa64cd7fdddaa73b6ca5d0897e990379180ea832abcbbce4e3ebd7ab2e3188dcac93db6be983b103ecbf02cfe1f44ad1d378dddc5bd76badb1a1db824142c70ec776e0f3c2d58567932c39a0fb40479902e4a24bf8b28e9cb8d2676c7b87d443a216923bf9719d9e61d614ae60982db91fb3332b46882faead43dc97e8efc278357a52f34afe8eb0a4f745373bfb1f71c853e938066e729f35e5ef0fced7987341dbfce605d17fc0dfe95b28e5c2dd159323b38b3076af2b83cd6105a0d455ba97fc2bc98bd734db3f41f996824ca5d35b9336414c20a8a86470fc9bd5cdf94ffa59fb9aab38889dd8237c639d142cd2390224f0a5842528ca0f4ff15759337d4cce1ce3905c5a698961364f2d49e9b05645556e8cd8b23e47b9b9f5bfc3344684ee0cfc2fa609b89e0195a396722bef905c9c34b1ac09f992edcafa36807ff65b0b0cc1bfc58a7935150d49de45099210ce2dd813f0404264b3bff21d15c7ab006699428342d5bb53c0fd0d278230dd46ebf15f377c9578bb6b27488aece2ddc2d92f7e2607d65034499c047331d97ab043711a8c15ddce15c938a3f1681f0b96bc2ae48407898eb325047e8e923db87a7aa39529e0fb9b3b28f50fda534e1f11ea29edda687aa8af32879513267340a16c0eedb651ad3664c0481797e97718eccdf1114234324b14e0fc6c1a41927b65e9e2526c0fc6d46090b11a2682eb39c173a96df29f8bfbb2b748e5dd7f51079f263800c4c4187f942233a1e2bee5c1671823ba650f9ccbe2df8734a1e0927522847b5f5270b70fba8fbad992965c8f3451dd863f31cf47b7b1f458d0b6fba9f5e1f6c65a01040206b990286ddc21e265e1cc3853d5ad3e8774ad979bd963b5d1b960da4964d5ae9ea26433fe13b1663084e9cba1440cf8375d5aade2dee814e00788ef03b7b32a1786f6ebe42ac14664ab5c7db4186e01bce79c56de1ede1ad460d53f15f5778028e18300e076f5f731237726bffe932b00b693d1d652dd7289bd299958a3ffaba383beb1c740821eccf7063324f05445ad88945a58a5311fdf9f66d453ae86f7bb77c14c2571e23fd070663a592eaa26683afb28967d9ee40c285bce9d9aee94f467a26d12301fd660378672a26bb681ba71b7c2748973f3c3ca817340df5c9e662dde303fa4d22ee8ce9886f5ecb4929a949eab05d2eb6c9558dbbcc45b99b5dd6836ae3b0b86b1f98f0440834edb5752990dd9ad17662d0b68cee3a0c748449b153592fdc2185efab1e1092ab4b16e6f27c1fa40bcbeab42375566d7dd335a7d13c0ff8a86ac5bef599d10cf92d5b5ba458b445f6c43fe935472421e6cb47081f2aaecf357843f322c2bab4b807e40508d6a16bc9c416e68f9abe970b0b6bfa7768123fed6213bdfbd09624dddb8471c0e4c944175330de6c7503c3d1074347e8e06f0d2050e6cc1bb44cbac17dbcbd0ed0c4f05e1843190cd6b3a432595267f3482a1cd550673768db5111e03170d722db8b50d0caba76c0888eab962fa8116f57130f17676ffeef462dacb2eb1ad5784b5cfa68867f9288ec9b6c0ba69d03f8e1d9fe770b90818ef6f784dc9c1ec69d9d66590d10f6d9cb4224330e891c9a84319512919d651e78b0231a95fda54b4ce5651cd940e2540d062417dd47bf8f37ee2d56ba6ea52b65adccd93e5b77171a352b22ec2a336b862379b329f2b58c57f3ca3cc79d8bb16e13b6ce7eefa0490059b834457cb80a528a6a0f28587e3d76d9bca62a8cabc7940f0a9e2c001455138c674fe36fc08eda2f5f6e4bcfc24a780779d6f8da559965589e23d2f8a4903fb5ee43e8a43997d8df30b3d85e325a636edf67784ade81a284619ebaf190699d48ac492bfe136577fb7b00ca38f71b3b2843d9a1065b9b5d4c5cb8e61d15faf8642c03f3b0edfb0be23495d25b1c3a9bdd344c751574c09b1bf24b13ca1e994033b4fb2b01c4fd5962d9a55234d5310338e397db2223a0149a4283d53d50eb7e3dac9b840b40936abf386de33b92d715e67c00b0068a46ff6ab70a8ea5b2cb7e085d22f0be9781373cdb9dde6849d5624a6c13d7b8144e38bced7f608af5d9fc9466523ffc9b4b6f24452a80920e266ad895028ef933565b04265396bf798e4e962fb9f9d4a6ad6dc07638f598e4245d507e7b21936d7d4afca4fe9efe06d621c3a9d6f5b6ca71d27d95e1610601a9a49ad7d1a3524269d8cbf3180f1a11c6b86af8d9f13f0a94c64f9336589c18a65a06182cabc63699c7a41a7c9fd9922e99f6a8b5270a439c4b5ea1a6fd38e5fb772ad2924e3a933f293cbaf32159fb4a10ab60d0f04990d6564ce20d62c6c5e17bad43083d3fdbff13ee8392197862e0863a78ff3df8736b99a2267f92e469804eb2f34c1a6e25c8cee438d68c5836e7c21cbe963f124a96e70b2f69d2186087ba66535cf75f8190960a798284ee5e8476359276cbb081c0b6af3025916630b34723c6ab11df4694290d65e910aab4775648e88e623040fca327b615474ccdf4808826c354f848007c00550ee394da0a7f41e83bc25ec77da3c9f522f81ac9395ceca950536c651b265504f96e06460a0a58fab33aede980ff00d2ac0d82e84c00d3603d870b9b3adb39fe90257a851676de1e4a92c0f4c4272bae5bba3b4eb4760aeb241c365fbc3a0b95a99dff0617d2b75cde31500222bea7335b159a8ad661e734160a7ed85809906b198cb7b3d4827eee80e0c4071f379469afa7f8fb8926455c1e93ee450e3595be417ba8bc8b930a678802ec62d89f3d4fca80a912a9eb75023bb5fb6d75d027ac4375413619269d631c762ad4da7a910826d66b91e2ffd41a195d06bb49332681542f2852ec00e58b9b0374666732c765cf9b3852f671a39b0582dce4d913358efacdd8d93ce4a4486a8c16931e9d295250fc0332f270f814513c9af6ac96d25b75f5206295891da4ab06accb52dbcc86e382d89abac6618f51db01cebe3a18a32ef5f84b85aa3bf26977fe0ee163e0fe1563d0fbe1e6812ea4d8e519fb7eba085ca828ffde21d75ca2d5245b3a26a9ad90fc3403c66ea6aeb200c04c2b33ec407941fff9a0121165561cf06c10e0dafd4be54b219c9144d57c8246dd706623c0353666037331a14e034c1b6474a7e13948f02040cd5fa8cbf899f5272eecce0594254a50e8c1a6280283ce1d9b44b14e95d209480b96b69b032893703dead6dbc5f9e33828f2ef66acc6eb7838290b2f79f0881d86eb56a95868e3d8b313e8451558ef96f8bd81029309b7eda0003e468900a1a86c64a7c12ca54ad4d14e1b74ea9bcb0c4c9d2e88ca21a0a539d69c513023be6b07bfbd7d17f140a6a783ee13a533e2ae2eb8e76372d69619a58045eecfca38278134828ba9332ec0ac56a7f96594f586773cb7e21dd2ffe0d86cbe4c4fd4c53db83da84ff686a9a081e8ae7b066a1b1f4563243bc7681920a6f3cc22bc1c64056d634c2de1078ef85e81c8ccc869da8550af3ae9a036b347098e536ef7695820af84199dc0ed942c167562f5147b39fb4d2f3174bffdf22d1042dd9ccc2413c1caf1bfd1384d5cab2b1e8939e233b917185819a907b63cec6496099c8089e0aa3f6eb26d87a564528addf15626899beb6d64f8e615bf54d4cc869d4a942ed8202f342fc2a1379cfaa7d2077b316b4849746fb4ed45a4d0f32f0ff6c077d5f409cae6a7d14f7157149fdaa26a5c8c2aa9e8899eb5721d9f68d90e53f5704c35673e324aa28a52dd7d79f39de4a6dd54ad250c22dd76073b2dbcc83f929a64b8f23466f0ff9db81326c2491e03d055d5776274d0a8c2c76c5ea961a146ee9776cb3bd43766402db3bf188f80e913636af9ff6fbbc3ff10483dbd714bc779de6fc0697491a9ad0a41243e91537516af66a4d0516ff5da3b4e67c7bb850dd35a787592f43088546950db49cf44b375e2d6c15300d8803279f6141afb782f6ec3155b463d05b9b296d96d3e1b60fd77f67b4a127e2fef23fd436b8b737faeef521a8b138ae7099b10ee8d208d7af73b5889ef8003cdab684ff80158d05ef89178ab2855d200d241e7a296b4a2407897b6d46478ca3b8d43717cde9ae2075b3f4b19345a65da4a67ee3380cf90bda1cc2c38b4d659479dcc84150999d09d65faf3119326ee002f6fc87409884a41b45e47f370fdb7f03b333a16ca581aa758174ef863a62a092d73bce3f42fec015d4fe2413e85aa2a580d315d894acd48c4b124dfb1b0f7d68c1cd8eafc5510e0c1350a4a16050432ab927e5db5c8bb5ee269d3c3d22119406b9058a3111988e1361a9a2c58ab743a8833fff1c1f5a37bf5995ab879c85fb461365da9c630f7b4fba5fc666915f279e43e0394e34bb23c30e1fd0e2e309d3222eebdd9f170af6cd449abcab5a0469f8cb7de0345906863aa286fca16d4e9965ee8f7e38ae2f043b69c54ed6fad1647d880facf833a8bb7bf8d02ea554f6d9592cc6d95c887a084e8baa2cc682745a1ed9e2398a55ed5bd1b8d976311bcee5abc276b93f573dcbf98c2aa582cfb8f0a33d1e5d5915d5e8034d4641e94b0f7962e4e5be8f1e31fa342b056a3afc6ff058c4aefff48da91aa70d1d83afe0476839842e2bf1b3105fa5977e9988e2d032f4544d405de390790481fade243493c6577d5fee4bfb9f40e78038fa84d7ea794db43342506fef0fbd569a414f1da2d7b635112bbd681a0e6679584aecd09c4707d7f50cb12e8a94974a6cf697a24ba4821572803146f9182a24f0813905b95cb964d11e67f858152df6952b9eb9d35948d5428f6b0d9157c6c5cc19a123d32d9ada56020b8afa22765d0d568e510317ea6438d37942049c78a2f6553735768e82b8f5dfecfc34dbcc0a727a88832f46f5318ef5395b06fafcba27ea7c699f6856579b0b623e0553b55618e1ec0db86731270ec06f8e440fe36ca5d8b748f43c22eb2f282726134447b585db8b1b079c313c50c57eadbb4b6d02ae4943f08289c9e2eff0f513aa7891c929faa3209ebe649cc121a11d098a16e190fee510a7d5c1d8bdbdf6dda1ce7dc8ce33e91d4391f85122b3582a075c0735fbbc95e3521f03b0183bb3b6c83c11c2d215bc437fa8e2514dd86d130330a7648fb51549906ac69caa8ddb3d28799dd474cd894fcadbe422c7cd80b6b516f89a14a7ffad5adbdc3130c5cb91e6214b677c436938bec56c3ca15090593a4bb5027422933c9674f71ef3f107800aa3c42e6d41e254598542de9ebbbef2ca015dd1d0726b8b7772b4deca7da1e07f1c35d1863d8b8d57301d4010dc37fac2c10a450791c219d13263a5df49afe32232032aefaf79cfe12a00bce023e4297e0847a45e78a4169de41cabbc3e0911137a0a7680e84e0e551d5f93a2476b58b1f3ee65392f91c437858a42c69f75cd67c3cbee35249933bbdb20fef11be3b04a3bddde721a1b9d5e037d082064b5a749936f20cd6e345d2464961fe966a394bb702655f300a05af4c59b80b56c8b570d41d98f607218cb8faaa87ac31b5139b46fa326979e36fee0206b1455846a67b0e6edb19a21b1869b615a1d73ca8686511a276ee0b47e2853195c6e599f7dda5c7504bc53c2d351a852b4ac7f67d32655cd5ddbbf8682900d3076d6e236619ede7671b29f90bfc30cf034d08112c63950846d507b1cd45b7111faf1752ce7242a3ef60f64e68c6c3f2eee07d692500bef481003a187aae66ec622846ed745af07e29b81c983c9f1390d28768dece1be24e901d27ab6903b3e4eae7a7c9bd68789049f64183a175c78e21712bf11d18d374680173996704df2ad01e324628ab33bff452366bfb02736177ecd92521d1b3380d2d0175ecc0d90e3895703bd621ac96f30acd2f536ac4e2d042a0933b4412525d8ba6bbd898d76f830b39dce5e8035d17feb49b5bdfb3e28041faa4202e565a801b23b26d6d4faa7fd4c6a6d1a3d8c86486f467e8deb56b62db8309bd3c6a068f2fc32573b6f08b85c345c39a163e7c924a8697ab5e19f6451146ce9008780405423e395b7a220e380c221131ef79ef09677e1c4cbfc25a5edb72853c24f4b5277af05e52d0b029e8d104435c2e41f438829214fb8c70ede7affd7510a3f34be4d590dd131367e48f13a5214e0364a5b4ce8cc090e8f5404d078a86bfaa000c97c1ede098e3035df80583cd6bcc4dd9ef53a3760d97cdc9bdcee3ce3241765a148d669678a2546c2bbd536b99eb320f42fb7b25cb041cae7bacbd30f65afccfb22c93bf1e827d03900b42c9311cf93ff5fffbae09be260c30d4e183911416e70fddd7da0b82aaf382f3a91182fa9f796fdbe22c6b8cdc88f4eb8b228ce475d5d1c8761a0eea98ffc845e7023f97b34994592ba17a28f0a74c195cc0e072b4c0eca67020905cb03a3275c6407624d69a06d0b6e580d4ea6989d172fb86d6097e43949b6f28c4f7a765b785acf02ae74fecada510069f6da8c6b2b67201373ea087def419975a4aeb01a5246d857ff0f728a5cb30683bb09c7fb4fb97755a793b2acb651564b0efcb677e8f4af7d7ad838d1d3c36cbdd24ecb99276aac5c3e404c090ea1c8b5a171bee67fd5b12126db075dc5224407800c5a31ef76df1ef2f45bb3739d85565001be7f39749c620fcb285eb0a85b927ad1bc211a86464cc1fddc61178afd7ef1805633c8820ed13350da28dca73684fc1241e45855e379d299de1a4c2c3daf80557870a374f971285ce3d203cdc3b26644c89c55ea64a7e5783b32ea53af4005bd1f90ac7e5020bf15322aa7ab1394d8b0953d98df22822e0dc461ccc15dde684cde0a918e48bc7727879476af9ced335abcb0d64446397d90a5fae2f59ba9bb7264e415e533ed839d557b1431a72706e8c64986b91530cd16f004b4b00650912180cbb9cb8f056eec00bab18f449620eb6f032061efaa00e8aa5fc7737e304feaa6e084d3fb6c369f7bafeb0d2a868afb341cda03fe3217ce0189da237facdf0a730ea3958eab7156eab724ab27186a1dc18058a96915c705945638e59b7674e49e300cd426802e9e0b02aa66c289ec729e3535387dd113b2aa9b6ce6833165ab3875fd14cd9d937d8a6d968247e1f87ed2f2bf3ccc0072ca917dd294f7a16ab2fe894e255df85cc12f2a0357bf58b5207c8bd5636257a991b85de5256baf35a6b3541bd8618775b9897bc3e82cb0270a7c32fc599a6d5d504e2475389c8697bb8b36cf927c774790deebcf825f644be4bbad53ec7ab3a8810cccef7a34ae84b6342a955bcecbe1ff73f33962a62da4ddf0700acabfda31970af87fe305f5da5e9efbd1be3b413e8fd6f7f1d9b7898cfea935bdb78092281d7b3cb20bd1244103a099c04f3c6b040cf6dcb504c3e705ffbe6de8031cf47d20226cbf4376b02943a1180a7836f9da57174559139097e42edd4545014ed12fdd63f529db096df5c3f07fbb4f2b5c70a9b4a3b0f039944fbb80ef2a80be8315b824110482ffc5dbcd9c8bef809a1615d409d65f4e424586d48c0f8235310b05352c9f4a7bb2a6c0313c7ac67225e27d12b306c60fcb7ddd625e9e49a93d2ba5bd989a1e75ac0dc07213ee8291135180a5a3468559bb8bb04c8aa565091b03a4e419a45d7aa12fa71f1fecc321999ae58e633ade97d6788e13291e2b59a0f8f0f253545344a180c18d22d2a7523aa10bb82271d74372595580af3de3150df205b2161345f17520938e36268a9cdcf3629282ba24a1d5aaf7bf2afa5a349a2b403b0a3e5b2665094e3fde7a5b68d0a4a8eb98385c5c7510d9ff6539d8b5bc2400c7ebae132673ab5d5d4e59e0fb5f32a9594f898420912d7d400479afe43cab73b2b9710d30113330a9e254bfbd8c23d4cbab7a538041b26f588d4c4aeec318a974872497cac68a843745922fed463f0eddc1ebcca77a6eff1b277d7c455a58542d286c3fd40bb120bbe9491d3e84fa41e64a7ebc85b497c8ed239b441054503bfaae6b14f2d60c3e18f0314d3e1ced4890a55902e465b94a6501524fbe8c78fd518dfa0ed8ed71a91d4b580276343fbfb9578625d580b4293c2cd9f886e517802e778148c74ab3bc8d6ad0ba15f564ffbc1910e5884ae6c69efe147a4cf91d5eb2b5584eb9bcfdaf232cfcd4cb707a2f28f5b7fdab15a974e00c50710f7c12655b8b4a21a10207798cba4baf28644df6c3e0b9699b559d54f5ce44d8886b6bd4a12c55241ec8b20fe777786eaf35c1c3c7d0d9aca77e51c24485c6b4f6a3a7c9311d461d0d91d4a09607e586112786a770223198f9eaa80c273c01bfe9afa04358da15e44fc74e4b127fdf36485cd8cc7cfb2345ae0fac0d30644b6bd5087127f71843218bf4a119272d7d7325fdb08875a36fa80604005c899309f5b41271223cf2903be4c9e501f9fb9acd47cf997ead1ba1571dcfb0e7d5475bc40c1d5fbf1ebdb30bcd0a9735fca01631ac02ee37374187dad3c983e797054b440db5479ffcea99411570b940d6deffdee28a05f2b5bdb2a1be830eb54672642e91edb1962becd86822e2f8604a12221b2d18d0d2e4299cc9e1e18ee7ebf75f1f18fd0fad1299f3b2f38f6854754474255184a8903b87008e400d3e826af23105069b0c542399526a0c568581d397a2491785838f47dc7478a037ec3e9447f2a249c44f230bc63ff0711997b9e00894867ce91071b3d2e25469f2a57502758d5c343e4fb8bafb3491afd4757368d2e6bd7daeda3458f062ca1c246dc3e52bb84e1c1b780838ebcc306af1b5ed2c0baac742979a6a08989c3f612d1e57ed1bffc63f4da1c204a2ec90999e179524d9cc9f3f4c25c0ebcce3d83125b2fcd11ce6aca52d47ef78679c6472329dbd4af98772c8346a48adb01fca97525402f8b9b92d80920ab719c957de95289237c055acf31334ef4310b66c04c7e3d390e84039ca86eb8ac9f06fcc696c2f5dafe74845727be0f3db980d51915140976349745aad4dcaecb45207f90a58cc5d45049f383ec1823d789fa1550618d2baf4d2005719cadc156efd9077b6ff3592bf31149654f02ddf461ccfd1eb9d686751dca21347f02e26166b1fc2bb60be27ec07fe9d81b7dcd12bb10e0ea53cd68e3c0415c1c5cdf88edcdcdaa8e3f20d7b5194a5354e54126b239d8cda2eeaf18c641c2490c3dd9d141db56e870ff2708c63d274fdf4807c95ac40f094bdc022caca32871ff3ff8cc0badf59dcf0fe0040dd69d248b9be85fb463112cbcfa8a3a02bf13d2a9de3ecfc099966787631a5f68a30654d94471af5b36802901ab582153ff493ae6834e1bcc3184ecf55d39bb73bdbd7cf76b039bf41ef2fcc7cf7811cb6bb2cc93c58f9dea8e5f4fddf43f973497a0c966de3bc0389c3cf9d1e7b08a26736a699fb120fbe12c1be3b80db42e1ffa67e5161972efb968742092cbccdb69b8cc20dfc1824a5628652f506a921a2b177decaa154ca16b1af42f0e0a4b6dcfe0bcdcead61de742a5a0d9964ba29f7a5446e3f2c8e51f293de7f3f690471b20f95ea733998850ae4ac75ccfc0bc0456c4d59747b62f2066b10f52b7af8eb522093f333d6639a14a4d5ed7a80ced69850f90506237b568e4e07a13cd0aaf7f924e933bf089dfcc53220c1708f2fd53168a7a1ba92204a1aec2db07e21edc8af67cb461ceeb2ebed37f473ba59388fc17f1e8080064d8bafad69b2b96076431d681e9cd1187de3c0109d378bb8f2b280ff69203a97ed32a515b094865466fae01c4ffd35a240cd4ee6c60ece8af2f5c0504dbe15d79e94731333126a9811b596f7b0ef36f00c1b634bb28ac7e439d54ca99ccbdb9029a27ddaf81d2636a33d54902e638b098c4b04bdee8d2060bae1b4849ff8758f2a280b5865db841a37656609dcf562f03d2606dd58735cc3d44a1bf9f82fabc10c64668a5c879b6c289eb60a395a16d632e887d249e761c0373c1ee7d3cf55a26532f7a174b20c16578c23167773a611d5e2691ca97e096770d8869bfc16c4c8f7d1eb0daea3e6a331555e36103151e7c6dcfb28e30ccb7a4e12c65e50f833ea967bb0a71d4feb260136afa7a85ba90756941a872ffcadf6a753ab063bec6fe697517f7c44601b0bd1f4e5977d786c83bd9c49bc1b4f2def42eb946eded165100942ccddc549104aecc9696593412913483ec8f83112846eaafdb0ce7e15bcf07fa9c2998d91dd54ec8ba790a152dcb8b154f0567b19f72974a9f6c1f2a8408eddea62022c7e3d5a124f32e8de2da26d50ae9d4bb024238567db9446e7bbb3b1a386207a12295d1096de10aba865bcfe179b2371a816fe19e6654f3440ef343965b141092ff53e351929813ac400772d5685e2be63f45a6a7eccbc852ea8b6b7a2edd44d6970a7b96bd7f6be9d20069606f063e9d07025915c388d2b994d42e340e1d37653408380f3ea7a31d162b8cddedcc4f58c0ecb759bb27c85c72f944b7eb1361aa210116fc152b457e45f0365d85afa883b9eac01b86a816e5e3c69d50de4896aa6badca9f5f3fd0adfca4546bf2de54d4e61292696a08451029ecaa430b40ae68f23579a09d48d0753e6f5af71ac746790d5b50d6bc8fec013a9466b6acd732ab695ad853a69aba81911fbb99568049a421d0829d54634bc1be99b3f50c844d90a6ee43e636337e55de120fb4adff6b2a27ea8dde29ea2a6ceddd04146bf8a33e2fac616e4091ca8c85864840da23f6ae146f8d319a6575d174d328310f754ca4df97f7277d1942616692ebce3e1528c8d3b34a67d8a8b22eb53f3ddccfc1f2edb45b60dc666f8e7dfd436895ca3eafb4bf5cab23cee86610369fffcd2356af75e5a0469fc95967136c9be805de432c671681ef7556dd0d37777763d9b34fb265507047f61879666f4461e55467584007dcade09145b62c7694e23382464f750efd599e43f3a99c2c587faebec3377d772877bc231ae84fd6eec686129544176f1f6afa87b9ef1c9a6dbb549ad1fd5c866ad61f642a5cf852dcaa5d86e82d2f08770bbaccca48a8defb746c07fdac6561032bd5d804703b91d897e383175ff6ac565868435681e64b36132e68ec6a3431141167fda81aced0b1938d43b1b71c75b113573fa42e20a82d774291c569e6b7bf7616b91c0842618322b84161145a410dd18aac7838c0ddcf81ceeb76b14e27b383615b8e606815d5d7c0aa11de89e489bcb88deaa799fd5d486e378050217782c1a32401182e13dcab97c50ad1841f69f4507c3625802fba8e6da11590aeccf8a3c064584f7cc4b6d8e949c09d1e51a9ea11c2873b1b6354018a29f0bdd4a065c938113456dc87191bfb1259213eb61136aacbde4ce6215df6df723ece9c9f6c887fcb8188a8b4826947471eb849aff7e0e7a98b4936b813ab970242157083774be653adae93c12ca86755ef5c9d649fa33f96956a61975f75e0ef7a5bac37c979e02b95345a9a6ce88b6fc9e7d0b406461a27327a9277472908c314ed9ed6849ab5e6beca289d711d1d9a89579a220219a6022e250e661604562d518f8b7bc19e711de55067fb8439d8ca129da7ebccee827191c8b70c5c483bceb84be59ef4dbdc96c6497efdb1e856b6fd23fb0b364425dd0e5ea8f66eff49c88c6642dc469a63d3c13b5c82bb55a5c4900a253850472e11a20af457e45c032c4cddb14d96f6fe7713d86d27fb869d673e2e9062194adc938cf8bd6e7c497ff0673e7f5f18be8db80935c320839173e064e266d162cabb057a8bcce9cc0b73ac62283846c792afa084d8d5ff6aa2c1e7ab7095af0bd008457d9de3127e924a61aa2a38bea1b7903b6f6c07aa1e54e63b43f96ae18da0a8eac1082de50204824018b7603fdd1cf1e2ebdb8a5410137eb14d14c436e96885d70b2bb0d56b80c6626e09731361c3d37599f8ee08098c6a8d2530d54c1dd9e824631d67f54e5c4f16c4dd8fa6f37b722391722f9e915381618f868440a9d6e4352c98ad5b3838dd7f3351584e0f239d03e42569eddc4a889903da53debf11b8e3df385e9b8924980d15fb7d7c7d3f9b73a58afea7a87eefe55ce21e466453bf2dc5a5fe82bd13e0030ba7bb5d4013b6358ff3bb9c2c0ee0317afb945602be4ecfdc1b56df97004356a298ff26afbea9b60ab2bd73db95b43e6034116a1a18f9c79c35433bb0896c08eba2c10827860531ae6a22bea2c1d615b5617babd9890f96bb5d4dc04d1595f54b5706e03c89b8a35d78b4a37bdb491f7d716414b015b2e09a2af9037e8e3d17f8a2d7f3cdd31ccc2e19a06307b0aa6e0432b7c3c61f088b04706cf30e9ea395aac9fc9c8c7c43894bd8a7fc0cb808f0c210ec5cb3431ab0aacc0c3cd529f87cfb49fc1c13b2ed5b3dd641b7d856c60280222f83ae7670f02d9aef3601450f0430e9fc222be85e0b561379708005ffb26016ff873bec8d58c33f17b8ef21623894848ca6cb455296ad09472817f12fa7c38246d743810d0974403b1c34838685a24431c00058081b004f1ef3307f88ba7683556c516060a04b483ab66e99ed1fc6fe9872dc848379a4aa5ee626e8b972b60419b590871d120ff8c97f9d24e43765d30c9f306b0802d34828c1ba9bccb946a51050857ed9a21105942428914708455592e1518ca775b286e98602e02f8831b1053cc73250c983b882efd4c4c4755c8741e866a16dbb049511af2ab49e2a72c9242c63d3c54c5a8269075dda502cd65fe39c0b07029ea6f8d9bff01114e51bb9f05fcafda706c95ae006c1dbe0841de26f239794d899600fd115592e94cba5649e05850bce52356b95e9e661bb5c288c61be5ef25fbefeb013053a3d6ce5f52e920d25b8b4a920e11a9c9d4203fa87cf2004970f18d5eaba40765ec8c1568ed14b3fa8a3a920179c501eda12d8c5307ca83d59c7f8a49da72543b4c660ca50576ff3e8d039aa8578e79edacab8dfca2d252e7a05440bd4e51d41f19a78fe2b3b566f73595a01992fb42e6fc100a26ad95f5e69a609986528a5547760d94e25821445522f959a5ed741f9a577127c395ab54e9b2f4ae4d639503c2919ea5d4aef46148b3ccc4647197a4329edd0204b1d54306f2b6985d640081d945fd44e6dca59e428a0b76ab642da16c4dcaaf5d0dc754e5abea6e140d823aab017afddb057a1ac13e42ecb31ca53817eef6e89b923bb29abe7483de51f57e7c170d59635497338ee1c34f6d7fab945f1e9c30d66d81282352d91b181993147adc9ed04bf44af8a1780ceba7d6ad2ff64df3b506249e9d4c408a69bca4942780eff43a459fca25b88ce89da57d82ba527fe594efedb6c6d8e9f8e977888309467c7400a395e520f9b2dfdc140999f40ca9e49ab7b30c1a2a51e97c8a8d8f6d232d4ae70852b6b4806959377da60c9b2dc43d75be1dd88a362c55aa042da74d0c67ac82605540809da519558fde8c2a264fa93ce1b2263e43d17181ac8b8aac348f520160fe2307afaff78a922a899013f0842fb4d4ba14470c3d59bd0cbfa58fb250e30afeca0d77d85305e63049b245e67cc8ffa62e3022215fd8af9f8c1232c2e5d2e4540ed64b5cf02efbd5ef72b2231df1333d848ae5d7d67c9dc3175c37b827c29a76714bc19e5be7a5efb85647218c1281fa343c7d52de9feaa561368d87d8216b52f23b3258fc4efc179b630117a62cb953073d31ae97e1b96c850f029fa2604a318de62a940765c423627fc1a995277e75a4df8ba23b011f30be22654a2518e7514c8c6160f797baa5b00724bea965d0e25b524927ccc58c4f573ce93a4bbbfdd5fb077e2f2a53192b6766f0c8ff8590fac3c37f4e88fccd31a1a425eddef63ff372fd57931030bc9e42b026b36c194c557ce1d561743de963f2ed38587999c4e9e2c40c4673e2f45280f27994adb76caef7a4d009b1bca6eea78bf9bb476215e2babb42bbe2b6a7835a7aaefed1203d2dd0ace009735ad23d8cb249654d04d453959538eafe8692acc50bbb1fa11b411b15880552fa9dfb2a1444e1f0061f34003c6440e758ae7d32a674c17745f2cef46fcd547ea64e0364336b60db8ff9bb8cd443a5a62f182ce27b2e749a933a706698ec116f2ab2da798ab6a3a797932623c464d203554397e634cf21c4fdddfe228a6bdf75ed28b8fc75d985be624e39fac2969aebea88344f8a1382bc9d5fa7211638a8a275567289365063aaf52093baf1611c5adf207b0040b6b40d84856262736d75b252762b439872e449850052415a65a6e1058db30de7e5dff473629bb9cf1832133341ab1cc03c24e2c0e591659c8997932cf766dcb27a987abd027be849e477e728a0a8d7b4c718316b72a04466a6557b6c03071c8811747409fbab1cb9bf72c07f435270ada4928b4f1cb9080e2189424479a5c2894350bbfa36e2190e009db80c8f4019814cba081d2a011e4ff85228d373c8178f6bd5a50beb1168fa4a832e79787487a07ecc045401782bb792934a99d7011f6885f7a65f8ff70bebc23a2edcf748bc98de47c82a6fcc526adb97ec136a2b5ba28b7680c07fe66e167942a34d41889c828a19368f8bc47bb7e101c0d67ba4d0a1956b51ba3d52080c96861383173ee723593d499c2ad04f5e56c4be1bb734da979db32a6c910a37d391a2cadeb62f4faf9c2f9cc981b42bcbf9d1f2f8dd00b1f07e691e5c3c497824c45985c2751f85194b0e2d33e5b18f46de8efcabe58a33901ca549aa0eb0323071bac3f863e22ca0744f783291bcf51c30685bd8c4a4207839cd7ca0ce3e4b3ac3ad8b1541ef5f8f062e63605a0f1f282fb1a4572abc3b414df99fbbe0b078e8218e36190356a77be0765361d39cbc38d7b6fa493367e880922f429b10c0115e0bb893d19094010c8a695c54751067cbee990759f62053fcc857b39e7ef8dcf8adb4d054094ec69306a1efa0f19453c48256f0378d05f2ff323ec231a61dad4bf97cb27b2cb9039169be7403af1d3ef07e4356d06032c8abd4b8dca351b01acba4d03ed57d90063b75536bb610cd4856af41d78eec72146ef1adad00e294ea00e37721ef44b6808bb92f879ab9a3d23a622a3ee7c9a4504f2b3144af7d46f8d1cbbb3278b7d8d7caf0d8e8505377673f73ff5c9aa6684ba6101708fbcac3fb3912dbb6651f510ecf31e2aa7eb3f455f19d746638e343729ba85d57bf9007b9a8a139d95f66d1f9a2d230fb8971ee5f6dd38db6055e2e6b27a2afbbf862b69ec9e96a25a678433a22b243940ea4d1948f990de1c2bc80bc8f38b01f9cec86b2ea720679707311a68dca08a7959c4e64e7c3f89fa3b286988172d46718af6eace16b03fb2fcf61e88513bdcea9fde1af8688a25abe9290c1ab548f438c5b285f48b0ad545ba3ceb5adde5e990ddbe9c60b5b5a0ba86f92ff8eb99d3bb33c68797d7c8f1c21bf72aa54cfd831cd5352037680b8d38bf5b6e946d2f75ea9ae6a37e15ab5b15f46abb7a63bc6e23d1cd4b20240dcc9f4fb3f1982380da760402220cdc0389d91a349a12e42be1b6dd93071fe0cdc318c5eb73fb65aa4ce1299d03efb37feaf67adb9909a6c0fecf6c5c286d7215dd827b96b5eb949334b68009682694133d7a114f22ead1327beafc302c62af6cfed8d4621f67c0dd7c087e12e9b8816d551a23764a1af2c32c2b95b349737871f7fff15b478a88fbc5d0b8839dc91c54cf7fcd87739bee1d85f3a81143d2bbecba589092a97f37fe5bcceff9d079977af74141a60488d0102d396e5ed745e0f6a64cca3fe675d9f56e6821fe255f3c204a6e0349f36e614b9f6ad925cdea4206d706d25874584c6a5cf4dd313db3b3f649c294e1b23eecc3ee2708639ce117a69a6ed6eced5f22ee0c67d19c943dd132b265d4df1dda2b28ee2bc0f064cd10c2e4c99610a4f14cbcd94ee596e661e58cf1b064c5b205b1fa99fc2361490b4de7e9e105fb044a2849e34b08d87736b6a6257c5f4a048d19eb29781840e281828bc4d7b0e7dbb7d5552a4d778be4ccb161d25b0585f07b67c3917d4dd4264a22bb33e2a93c109af78a46fd26e63236370952db45f5e6bf31f65fbc3dedb9621fde984dd77698eb192979afebcd23e3c4cae95b5d4cde08aab1dd12b2b3d50974640c8a7356824f8bf5b5431d5229993e0f23d981aab2383cb3acdaf361095f64e454a8ad9121d9157d0ab5bef4ebd6db0177af93ce6d59525e5edf05d5d6cbf5b5dd94f1aa1d0b2a62c2f354ccbd9c2371dbbb50473ee7e4ca1c478a14647f9647ea45829aff4b99b727e39c82e945342fee0e9b36953817c1906616e2add88c7f2a05da876f32160e9e487753aac051acc9394d27f56291d73d120a9b05a0a0b7d07a39f9c9ea44d599645cc719decf519fc34309d28fc09ec9d0c1a28cb165f00b794c0e3ae12e076d28b88215cfc5fda6f2dcd7141a5b4a339d7990f43ddbfbe2e16424b16f6ce8ced98c6060dccf3fcdf390ee1a13c31a655a6f3e9ee63319e11a256206b8a00886464ce97c7ed3ddd03b275f03157da901ab60d32d22a300026ad2d3bd0fd86664f138d5b01df7b2f9d90e5100bab38260d94b5b51ecfc4854595de606179ca57ccd5732590c6a0e7230a212f2a55a540220f51759831a793c8cbf80066b46ea6c5575d17376fa3d8f6a0ad856169e0090fd18b9326ef0e2ea103f0d1774d2fae3cfe3eb81b1e92a1e73e960ce394ebe96aa6796d9b8e63613f4058987f29f6ca9886bcb274eeb2cc370aabb914d53e99d96674d4d4463bca7177fb1a0ea1ed961a794def25694fdd72686666837dc82218747511a7104a497a62ed264802d78ba53efe2aa719f919c26fad44e3b956654606fd9c17d41805b27319c8fddaa724a0288b6f14778976886ee92b67e6a2c39264650f0ef1918c0c7be0d47cab9f9abffa1d76e1e4d77ce1f720d26cc7665d0aa86df49582ea8f7a179d9d64b78afa96ae37565026345f2766fcb33195f8f13e07fe9961b80671401283d880425011079616ab02e8a50588b013aec292370dfeb41af3d9dea2589692d0d759b9c63f5e9fcd733596d47b3305f72323fe235615c2803d0b974ff7efdc5369b4da70ba4ac85677872d172fa312e4b0a989036b99c2c6502d43cb81864a162fd2d0bc4fd38d476de4c1ad85d57cc945dcc2b17d394fd79726e03a91571daec07681d2575a6c70db9ce3ab9ec69034123ce993275465e80aa9bb14a867856f65990f1ef30ee0cad1b9b8b1701832993db2aea02d1171b28d91735449e3a62de1d2b69027f3eaa875394d31ea950a6ab9202bc1596f53f2bb71103b534b77b9f103b282280f2fd931a50ea6f6c3cf331262b2b3e4ee197e0aaf655e2d459bc78b6ef19d3da0718597a4ec328b303dd6ac2980cf396bf1cf707ff505751b42f9082d4465f96c80d627bf28988ae716d7c61feaee53767cef6ae62933d60e1d0418e28a5b12fac02c5231e320c0205c44d7f17a9b1dcb0b789f2b1cd5e03fef7e8f6887a98d29981747a1986ce93ae78457d38349d622d5b52c03fa151e037f28098004123fd6ad3d00144a4a2236c3c0217e23f49efb276edd4ce1501cf0f432d7ba8cefa87ecd463f8fb7b7c16d0cb602c953f22dd25e0baf36cf4e6a59e027b8413ea663cfe208ebb6afeb271b2344741493a089f55eb88f8fe9dd2dc7d03e9efc3173c13a9ec3c549ae0e33440e9fdfcdfd9530e4d50202f0b0a580fc6b1a2d54822baf867c48915f7373852c7125aa74479df5397704679fc9823f4a49d3b80f69d3397571b61d70763173c7c0bd3a1587e7cd7957f291d90321a7513e0dee93c1493fc7cfedb0f372e3beeeef4a3cd75ae3ff22df807a234aa0e5ee5abc55e1946b5a28ee61a87a0bd8e08e2f6bda12fc6d35475a236ce9a8f0c21796ba3c09529d67ea21d45859fa5ed7069a6730e7afdb3efe44ba0dd916963109c14ddcda7a2766167fdf59decdd92fe75803a96cc45af09a831b3e7dea9324142abf6f3e8b766a008e09602d382e64c82cb961076420cc07c717d0065dfa6f16fb3d8358b95d91048133981364ec83be3021ef98a23885b2ab1df7e9422c4caf1e189e1a57186a4fcf09e5bd0a5172348d8c5341bb5409df727883c83db5c934a773cc04a403e2f783337a8da63e56759661fd86af291099f359bbcc4d3eb592a338a5b8360cb4de916668baade457d237f78bbea86b1cfa5edd71c5318a59cc22df442898a62e2ace98394a39c344714eb1bf2495fc7208a73f664be82e50e69e11c6b6854c613c65c9736025ba2819bec0ba10fca7206246fc624046518df0e7c45b6efc04fa78b3dd81e228856a23f7f81d979735dd9fb5e4b586949c16136e9ed51125fd56580a2d78b8d75b489fdc089b5e4af207873247574eb41eabc4af2fe973aa08e3ec16683b9ea6f3378e696bd5c49b6dc402f8e3cd84e1c7c4086a5ac70c93c55fb5bf01cff6d53725fe718ff0a1ab4205445502061db9fa8002559d110756a7a5ce57a60c6c661256e8cd8bcc46bd35ec7caa260247d3de3dfb2c7b029faf7a2239cde1314b4d14502ca7bd011fdf2fa03b9141e50ba44f64ea2216b0318b38320d84700f2c27dd0e6d8bfe7126956773838086b25b9582a55b18326b737c5408550a76caed8808c1b6ab3a20a8d743692897572da44efcc727a2590a4db7a3fd913626b40fa51ced8c72ec5bdd9f6cb6a4a58f6ede8fab6c33d60e15f7d6243b2e031245e20875727e0f268be9a4038a3e675333fd55f0afe44fbeb96499955470d6ead4cc7ae01f7ab8ade333130d40900061194b4c2334e91b687c36a07086432c18f18dc9c1d03e11fe1c687743a437833c239295734b74f08c001e0613fc88ef9302a78091f5cd78f86e6ea59e7f33e91827b79f841607b4618bd7c899aac118db66a8b3dcde2b2a1bf2e372ba89a7bde969dd6fd8eef115b35eb9a99b34394e2788248cf5b93521ec1b5845979221c2f7bd5b3dec45d315ab1024dd153aaaaf7dfb7db22a9ee3e412ec268fd24ef249f65d3f52be84a5d5aeca16f97961d38b4cc9579f1da156a9ad689fa1b3e469b9505f276f81648aeea92b3c8f34f8ee8555d6e7a0fbed2a34fe06497b5df13fae661b89c4d26f0e95065c565c03ca255fb59516631257daed8e9a908e99d6ec3211a2afc47752dcc3b18aa2aafbb6b919a43425a9784bd8672259677f2bfd1f9d42814be37dfd0f0dba0cee83812f213cd1d47da68d6a62c08dc32b2b82edfcc3d7a90ea3be23af4f3f48c47e88e44cd790446a44439c0408c7efa3b54676f214ebc826748c573f361282306938909cbc5ab49689227558f22caf03f2ee6ee7171f9fe04557fdd0700f83dfad221fc93b05957e7b173b1fdfc88795224f038c053cabc98b6bc710dda5373d324a4acf1703d82d37f89ae37346c7c082677ebc349b9cd73b04121605f0e1d1482b4014709841953c1a9b309e4f3e3bf7dd3fe4f6e0d20364060cb0497e9d95ad37b7f1809b319270a2b8d3e480938c3d5ea8271ae42ac31f6b24bb8b4ee0d1040570875f95d5b6a1cf9b87de97abba9287d490bf18a2e4af12e49b2b497e4bc71a999d08fb9e6a4aa0589539bab8510d6b98d39fb415c48fb57c775233ed2b85e6427347d4e917a03840c66134451f5f8c7b47b2661cba7ec8a6ffba9ef872b29b27977e06a46bf071f6394f47e7ac9692ffd332117dac2f6ccee7314a4b4a99929bfba24234b9b05786a98d80fb66481773df7a2f800f30a56598aa34bdc68a6e17996e7c51fff9415354ca58923645aa30e3f7e7880962ae799f30a345a3636b045fbfea890571d185111968e72c85c11eea295d3271a399e8e099924e21839c655a15d47687f5ba16eafd7ad5b51a2c7e8a943fc2213c8521a4c034d578b98e5267f9ff18b6771dbd88d6545bd719f38c3f2ba202a2574eb4b31c7c259cd972321cc6093b5a3274d911f3b0d1dc8ab8c226727af6c3079c206cdaf0ca1f0982d939f8d798e2e63e2b8d40bb8f11b3912d4b90a8a10042c565434798cac9863402bb39ecb12c597e060d01446887cd9a919e96b3a22dbd4a8b6100972841278e26fcf54c833ac50c2d5f11d16b0e864463223b9a8ab77c4eb321f8b5394337298cae78c544d4e3d622903d92cb7b60d5c90411d64696922e784ee8a70883a5a91c44d83693c82b99ed132a96f6d1bafa444e228be166fc652bf6816ee09fb1f4cdef28ce2f3d45d9790b98740d0297a807addf86b3059f9128e1e2842c843cfa4290cb0cea9a2d46e968f20fde5128b3f3234aba8f166a8c1df5f389c422b0377142bfda38ba0c440508d4d79c3cdef407e97817c531f6dc80647ae685888731ba634eb101b912877009ba7a57faa7eb0221dab9abeab495ee8790cf9da3a1285440b44bcf795cacf3cb77a1e3423ffbc388e986f66c45329fa5c3334f23869fa803e127c7b1e7183076cfbf506b0d6571a07a40f7e3c4991e4d95ce0a787e48207413aae9280993aeb19941b03db04477cd5f79011c53aa7b87e42d74e4fae3bed7f70d949aff6e0be1167db34b48ace826e9d8ef1b7ba106f929a6a207ad2cd8c4ae55adf478aee7ab8be7fc59d5d43425d579eafb1546f2366650bf7bcd559cc92d1ae89cdee74b9d18ab6a80a0f1ed4c8bf872084187348cf894560f111f80bd8ff15bc24caeae9d3402fd6bd823dffd3d01f50b20c95d9e12163cf28e7a0583131acbe28109f04e14f14ab5b382d9c85fd8736713877e57efce21c0b41feae44dd0fba7bcbb5e1282f948b4991a7629d20141706a87dc587e5fa48451cff013e9c16fac9e185f48ce491142e5c66f849aab3f662da8dbddf8c7fac18464ab5e122e54cc90c40eaceba6cd1fc33a842a45738ecef63c6416ddc1b014556acd5669bbd86a9cb862875e7fc4f38cc5ecd4ab4e310135bb24ddd15bea7413489be3d4246afaac6488806210bb00bee8fbeffaca5df6524198dbcea320c90000000000000000000000000000000000000000000000000000000000000030b9c707087d8f9e217fa6104c930bf503694139f7327f593777ffdfc514fdd62bacf4d0b33d931005c3aff3d904c1665e000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000606000000000000000000000000000000000000000000000000000000000000060009756394ca8fe653b5e53afac220a4c1439cceb2c315e4d0a82fa93154f7318c96b9e62af2f694782c5f32f2ca00fd5eca4e546e5111dca7bbdf577f17c5a033e76a45f5f7337038755cc94b69108661a1fe9e88b0abea763fa7a54cfcfc160858640f070fe915b0d42ba03a8d956c35e54f90f00c774acece09b42139081c4d4d6ae2cf97c6d7ed4d14f68f766743730b3eda3d33b0ad25929c48f124daa5911a98f4a1acb181ca65f867c595b42c3853ff8c30c7910436711b6ad75204ca608aae1cc65df01015c44d52ec3c35592dbf5d52ae6ccdaa8c70a92373079bb04d14c254ea870d3cda09f456e57744eca46840eea1a0087cb1eb53e6053497cc546fb539c0936ed7cf8ee4b4a722b23f5ad9c66a5d8a109ad6c90854564e5732b6eb821123c5f3602183ebbe3ecca589fa428ed3def577ef1af1f945797f11aefd89236ffc9450a6cf216d27bf0612fa86eb15a9ea23ffcbacf0a3a30f429d12a789456537c59aea2b123f6c4b7778b4bc22a4bd6edaa04502db87540fa439b6e78ad7e985140a102898a877e86ae024d65bbdf88330a4aedb4029eecd083e174a6c416f316847a9e3c06a62ea8b1c61387a456e46bad2e48ce9322aab5a0890cc4618c19c4ca1b9c42a7a843aa0cc2055d381a1a5837f7a481e5f90bdc286a6652b0f22c144882b1f3b34cac4822a03cf367b2ecf36a45fa7a613730ca22783fe2ad02b7a0a2d22fcdef5efaab41a761268652c946f41ed005d08d9f5c34535d008e693462e81908d4baf3ee8e3e096eb449fdeb40a3b0850c583eb3ca445ebb68b4b340d88bccd23bdb03829500f471d3d122ff4e10726174756823dc9cb06a5454d0a52e2308258cae6effbfe658fd98a43266c9f1455f64ef6542083709f9785306d89294a25ca2c633fd854cd20d574997a07a5932a87f3f7f0c96ae7df65a93bc85d5478e577b984fbb5b84076d552f4f0d58f2f5bfcf3a5ba4803e661f045b178063ebb2cdc1e9fd6180be127102ad967c06edf97c399592f2a55c431685f1c64436e1866d03f39cd4aed7b71f140d876e060cee8dabc7d8ebc8a12796d2a2a04df767933c3b98ec521c4adb986a8016b5e0f47e34327b01aa61c8b4e84c8c1cd62531c35e322d4c3b43d17c97d2895fa808e01464148fac10c71feeadca5a00e30b99829769c8e16f8ee114e0b034b8742eabfd311afce62fe65e4e97bbaf0319c9bbfab04cf7f67ded8776beac9fc31d95cdd8632890f1e487a4ba99a243a3346a3b26421126301994f06765adb5df07430f5b5a0a44f7ef4de6fc0fd2f3ac7df283afd7f36804d5d2791097b3cb666aa465532aeeacbd361f010b66618f0641fd9524841dad9b490ec3c09e4c77a1b1ee1993480b19b9976d32bfa3075998a0570406c33be6f347305e1a99798044755f0e10f47f1585801ac808afb55fe9312940ab5f6ec4f125f1dfd4ca4983440cc11822e9507778d940bb89131eb0aec8506ca2c15895594f07311b37edd91c8777ed5d01be44db28598f8d93e03772c153bb48118227d89c5634e655dc8c1a152461695ae2401f9b07553855462f2369055b4618da723554e10f025bcbbc0e837dd8c58c7bdfdf8748fd82a6dba83e71bb6c34385eed2cf98b5a8feef1ee54cfae8567664c3ac41744cf4afe5f23aa3afca3f73f4c24774d98394a28cda4278f506b19129b48502d0baaca159c0d6bfc58229b117fc61236405eae17b3a52b3f6e54b01abfadd459b42f083cbab145bea846f647495d6caeeb02410e89d3170ef4357ac305782cd3aaab51ce47864eb3e7e102a65624467341d0a0ad55aa3356bf107e9789cad41813bdb2e0f33d018958464bf4c7cae563dcb2bf1a52cb5a3915886d538a084bbfae86bf58b18b8794d0bf387a7ac5766f5cc82ff8c2c2c52724523c0f684f67efb7f4f97160930e0d1f10558e2e95667ffa77b894f1b2742a9431bc62408d25e5ce6080065ae5e43269c103bbf0ffac9be71159227f73d960adb34134b4599a41a88aeb716ab47f334faa7394cc1931bc74f9f5785444211e865ffff496e2946735c3b75f95cb0e648f2c15e0896ac69f63dff39fc893bad54da3c230adb02c29ff3b93398d1c20ab2f725c9e0eb6d6b882219eab1a0ad1f84d7924256b5f99c21c2e7440ab938bcde7ff5ed122a6f4e9040e5e4a4177ed37c42473015a46a1fc1f2bc9001963637374213ad85084ca75e1be7dfdfb91f25e626d02ec6fa70b4e2c3fa01a101efeca11a94b79d2d2bf2690718ed01cb77f8bbcd14d6a19adfb1954272aa12b81712a4eb798f844bb7b8bc8cf4efc72272b6282f71f98b9685807a076b945ebb7aefb9151431a2a815771c7939342a9b39c264e0faa586628d439421d514667d324f3416c878bde98a8b43c72abbd48e7950c0efd117b2f1fa90139043d6878a0a733542b4700d9c3d4e704f54d95f416a76ec597a568f7a0ee27e357df9b81d24b45ec1458cdf70b133931897788879941652bef331eadd4f33e6cefe918ccdc492eb41b46ac069ff7f6a65943cbb79673c1e922192744be65d26f78d9e5109368400a53526e2a9225d1b8d6d50b8d39dab9aae522740eea7e6c927273477d321f7656a2c94edb0946f2db57f1f42980fd7e9fdb41641b1ba093a54b9f245355824c8bd591e99882a8e39f4986491dd8c28fb5dd256dd717b02f68dcba3e1095f5ac540e8e87386fa2a482b6ed401602b26c77dece52bc7024c1aa8e74189b21b4377a13a2e3be681460a4d683079ca9b980ed6d0b4e3e372989454b5f570a3307e00a7dafa6ad3c3ff489cad14ba494c27797238dd4b938bfa144e3543e37834a781ac3b7db4db39d94a088a28fe2809f139e5bf98dd47c1ab41fe567909309428268a62b528f6c2c5a8f1fcd0a8aa7fb78f59589e329aea5bf5c35eac6c449cbfb3be9105fea6f614ff29aff6adb07d2b344b60d6044cb848da94263044485fd54ca059d53ff97f9a5406644b38bb8d45d3a97778e77f39bf52d5d5320f8d1b68865660d6f581d84efe1705c0c83b16b621ef343778486d5f065d5069e769e0e475dc28f16c54dca3af6dab2ce9247100f383bdd599f038d2a9a78499a6c99439fda09da8df25530b1026ec0947b0f02d92e5232a947b897b33980b7b95219228bf7f07e7daa3a27434003a3d3f581e1d35e0d06a38692cf65e2fdcb5f0bdbd7436db15d1d876008c33081a1dc5dacfba48a395b600d3072d07401c96ba188157c25a5de2d3defeaff67d021bf127fdc582ccb8d9e33a5c38f0582c1ff82c832a5899ed709951bc8a9edfbbb4b438adfddea24d7c5ce46097e2f9a20bda74ea47c4895be39152777b064dd686bd0d68670e841f2b8e86ae8b1cf57200b26b62a73cd9a55631cb8963f2ab9a580236214d1ac9f9316a210f68e3bd82451dd2a3fe01c30a8d9381e7ee7692ad6bc7ca0c1992d091f4397fc47a835e25c6355488cbf4e2a06984410a8df4fb8bf596927531d59bb74f72e147453de54b4b82c96131af413e21c442a8f919b00746fa4f46d0f199ae3a453e455c83ae703a7e950f20a2e4a847f0d11c876c5ea81525a800e4b8fa424ff35feef522592f3e711a46b426320a7dc40044fb02537e0faf25566e47c72172a3020d0c6bc1648ecc8b6129ce594f600af8204ed47ac63591df553b6b6838854d132471cce5b58b69e3a7421dc18eb9fca63d701d181fca246ae4f3fad03acf6c81e8529df0f76717f485361546e473903fda2c96b6efb18bf1f9b2444c218f9d8859424f40ddf7a1ca9bb636e2dce17ab312979fad635b8a721bf6ac588b5cb71eb072589fcb76a4ed2bca29471ec9b5c7c216bc6bdd264b28b4b226f6e4d6fc521cc7bb16483bf92d0b7ac85d4a6908326970c8658d8030efe2796b1d96fc339a29e0588f0f19532afad6c23dd27ad8869006311a13510a1790c1e1c6479c045dd82c82766cc5554299f38e5fc22572cf227ccd5004258b3916c8e19e154ee228afb2b50871db8765a06e64eaf2c9b5417124af6b4e92ecbda0bfed0a373c4e2d74c9f6ba03d3735970c5d7c3cdb5b907932fe98c69cb58e786f7bc53196b5d0d9b534e1230112cbba86483c495de5f26f68eaaf5b3df1edadd01c0551dee01cc91534d2831278f50e6d317365374806bd69f8ed9fdfb533e62fdc9209d370d5b6591ff92f505fa1a1165c546d6d735395f15a86d2e8eaf15c57d67543e6b006c53d3b103a4f9b6ee3bd4b75667d4a44822a4a847c67dd9da45dd6b7d9b6fc051cffeddf1c12a810624e783665940b897dd963f6b6f76885d13fedf4a7cdd2e9678d330637ecd72f832a8f80457421ea99301e39c74aebc78bedf7e29ddefe7963846bf6a561ea87b285699ffd4d324a8387b8a8d5a820e7b5adb0e05de49822b0e31e3b50cb03e3a93179adef0b241a4eaa3df6e8c3d045ffb9d32fe767435324a009cf96ba280e8ab6eee666073124d2100eda6f7d9df91c22d564c6f25d5ae4b5f799360ccf365af76a0a21a6291ab3a78db73413a19d99bb2a4fe041befcb26921336201fb5069ff946f1d93872be2c270aab4f332364d3f76a7fc7b8b20e03fe48be86e144c918bac043f850740bc59caecb094f99b0168e8109def2c04ccd9c4004141be219d7de924a2358ce4581316d4a4e3750fb0ba6888773e0a2b077bea1bc0ca33f382a88106589b55040d8cb95d2ae2345b08024dd6a3d30a8c70bd396fa94ee1a6b9d11c2646de4b17cbf2c1cc6d651d3d479014258da22d75789291c510d28f2289b947309a42a0d8ae0f44a173c4c9ea91890254d59562e03af53b98a9f8ab21b8877fd9beed239d98737070cbc555ed033d51aeaf57a6889a28335f1da501f6a195caf95f17e0fe2b75b5ddaaf91510c1f357e86d024f299dfeac1ea74651c8741f9181fe8e7867ad96b4c6240639a7a242dc12868750c176ad79a9b790b4184847be9380461ce0bb29c9d27bbf792ec3471480d02493d2ae4c382c2cd9cc1baee2ddb363c8e5dbd6f6a9d3d856f8da21a2ff7e9ed261eebb6f5b3c7efea7d7392626a5ec9fcbe780dc2e8d9e300231b0fa367890c80aa3d19fa3b22ec2a9c48d22109bd0aa256502978150e887e0f9da3bd082c601fe937cc6d3460ec849a39130f4e31dc4cc2fd17cd48e6084618b0ab33fffd2611a15a570590d6f5385dd17a0f1a256ef087b4e0bfacbc37ada6caa1e58b0da38edd284c435c4dfc71b3b0e805cfd94196ff3c7dde905a587821dfb8816f3fd09da0b2c720dd46053d6de877b4aacdca0e79fadd34d04dfd9758dd2eeb2c8cb1d61ad0d02ddbdad9ef96f7c43db563a60b8fe769048a888ae5696b3942fc5c2c97ad0ce6dfca02590de5f6e708b7c82024632194e454bed8b20dec4548ef2ceecb1754682ab57cf798b7293c09e5c12db2f888657a518498b5b916b9e1b8042b960aa5a5b414dcee8319f8097b4c59403aa65e0edef387015be20b990c0df43207275483b8b9e87d51daf8d34d0da3f94f66f00899f00e82ba0bb96d0f6c0bd6fddf371666d37ff078eedb622d7c371acd32a2ff1ac5626b63520db86052e0e37a803506373ee42c299ceb44023301bcdd2b62e60025d0ce57c8695d9aebe4278302bbdcc827a0c18b4c1dc6539588b62f62d6a6e6bca3ad0a8d1d2d3630f41c1d4afe0f51e8f245f1c0c8d4501a3ad6f6bf252a4c334b98e2ddf6cd6bf4b99c0d07b327269ea143b403b048a0c6cdec3eabc3d8be70555a9a35c957319428e0eed7db8f55629b6999680cc9f0b3d72045431b246eb14950888a7274c7062a3a5e6ee8eb17d5a01456f109192d15325dd276933ab6b9dfef50d422a6dab8d8919bf796497f69c1bf79a507de447790e2f7bbdd0c5cfc88970afb2b9f4476d3090c5cf0eec7da7f2f473becea7a699c08608ccec36f12d54eaf462af92db0ccde36780294eccc09cd2e7aba55502230863950e01f43731fa9bcfa793edd98d22a8df3ba314347a09ba297697d6e6cae46ab94cf59858ec3c1f8b6aa57615c261affff60fdff7eb25d420d44ec8e5fc97c4b51b5bcc511942edd2952874e818b0747ef1a0a1a13c0f6ae22a948ee554fd9994cfdc407debeee97f691c1f88b8d397aae2c6be23bfa8728f0eb44761f4d4ae7100ea7cff1be9e3752c84042073bfee5effaab26f90ef5511f1446a538982ad19bb9997404b88b001bde4a29c37330cf0e43f0a7b43e355595e8753ece99f7d4da3bc84a942af7285bb168f2f7ca7a68df70cee2655f781e55c11e03cf066f3df10ae396dfa8a83627a886a5589ef2f3e4b191a004d834f5fbede609b526883a7b112054f2226598793b237eb6ebc97f513d0503c72873c76128218c133ebdcad527a2d5d1188f518a1554aea659509b399720404eda3b800f9d83c8859fd924da3f2700406fe54a4d58bb883b75c86da03d994343c1af2fe6b9da538c49dc5500dee96ee3e6b39fb9dafd6de1dc598f4a9ada5a42ff8f5e57db3ff5344d4426f4d17ae5fd6cc09b7abf976bd58a67bdfdc3aa2fce97fcb6cc438fd4f25b3747daa3c5408abb182cd229436937d4a78b48fde5367a4da4d5d5ac8f39e0d4817a68d126f90ae49dd91d1b403e1fdbeb44ca329c4554b678be183b3cadf0dcd699bc903e4c87efc66025a61d3fc0dc5cbed339c09494aaf9688d22fae725bca1e3828b64d080fad3cf0b5b70383ce455440fc758a7d043470a0da8a95e7bee777e05f3ba35d3c87fc52150ec74e6d8002d6ea22becbd41308c46c97bc018922a1658de863c7db4f21a3db8eeab0e7a502fdae514ea98e5ee9f938ae2a88cb14bcfe2d35fb7abce80797d4a4861b5cb4e096da7adf5fb09d6aae8010b318f457dd1007939240bde512be1d7514111529614a0e72cdb11059c17bee9886526a9a9574128b8981448a63634f59df5b699de582e0ea8bf3b7ac798bcd4502efa899e7b1cc35a43a046d142fdba71892fad9200212a3b324d04f4bec69ec63c72b0c0982e54223a76698cb86b89f8b8025fa2407fb1b871ec3d35129979191bdcd26d7000ba77c4170448be098c57a78ab40caa3dc57eb7fdd0eb523fda7c72e74a27d71739a4b216147fade07995f581d592afa92d8c5199558b81f327b9b175f4f2d2896bff03b383ca599771c5026ae49d654b65a06f60be8bf15c59179d3de550bc5772edc935f0e9e32f3d80efc1ff7e0dc3ab92082abb7f8430bdd1fbc068827de0cf566bd34fa496b0fa1a00c7424c2233c57612fdcac26ba6b5156aedc1030a68569fb1d8042319b154f040e1b175457aac316fd0b7f8ee039a72fbe031753ef1c99f83f715375f9e2c53b155122db5f2016275408cde5a5c0c6a3d0dda80e5cfb0a08ad2e940d8a7faa0118de944edf44314caa51b2115d0f1c6a99873b47f75ce32643e5c37241d85a08495b07c37b2cde3730478424b45f8bbd076549660030ff782eb0b3596682fd2fdbd88ff2ebbb300ddf2181be385959013392595d3923dd38ddb227db186eb385162dd19641ab904643f16e47d7949a5c5178f8da794037ce15c2be4372c5163069294c4b98404bef413e29fc549093ef4bb96b22f870c95a7ac48f12bfd267e0b4921e8553a9283412e966dcb2a06d6efacc80f7dab5bb6798a881a90feab93a713e51fcc4994628c35dd809edf4777db57afa49f7c2573bf47a1236faab428dca1987753509de7d4ce18a2ee776990e778b4bbbf90e2fcfc079133d74faa3b492b96feae8ccdd62e5d4a5b0897f6bc9e42a51ab970161fcfcc8141072a5b546172e6edcc9636ba625add49d11632a9bd883e032b28e1e8166b9942962c104a38d07683ca1025810381b303c392e805198f032c0730c0ec61e885ea3d11d6a093ac830354afe7b2a7cc78489dfde046becc4ba74efc01942ba260e385897927e44bca1d2fb881d1c4a930214bfcee6303663c704abd06632444d11391a571c3af128cb92ec31cbfe4b3c825cf1cb8b646e650c93ff5093e825f6116160741e072704324551da2329f455c8dcad7e02d7109623897d1b00aee06468f12716b80811a7b02e5e530af0f82d914880ddb18d8a0ce70bcfbb811d4eeef6f87eb31f9afb5e981c67c763637cd376083c938fdea48bb30a4c4e1873fdbb84ee6d0cc1798c7b804b1107dea9684d0823fd53ad36cd73672da3cffa9d3b4bb582df10a9ed4636daf05333e627ec83b0cd1d8ac49916fda42c6d7408946508ddcdf35f8a400734c8b5dc702c39a00a304c4edcab95f32ae2ef56908acec289236889664b96da268f99160410804eda6f85d8db8e6c44bc5033f1d0c06a3ab8ce59dbfa80ac575784c9051a63cbd823aac721a47b936d5d0034c0f30d3fda6958f2f074f129a10de20073483d5c55b7542136dba69e9aa6264c1679e65f2f3335eb5e32b6d3a4aa655f57c5056f8401cff4d11474f50d99c1df52e171c300e5bde1dbc9a44475ab5c63ac957a9eaa9ea21b3302970041ed93bdfc2817a0cebeb4563a88fec1ca3eae7b46ca83b830a34f65d0da972de3639ccfc05ceb95beacbaca6809e56e6253d7a7a11cb46dec47d2136d655a9bbed57d777e14c9d87ffecd0eb34e8c6cbca0305403e7a4f181ac9ee6a11aa5cca0d2900bb72a13f704ecaee6c5a01c2b8143619636b846223cf4957cb83f986d2b33056fa3bf64a811a18c7f4ca32f992d4ecd802f9778b2cedd62c86d745871a4669a407fd1dda2739cdb30aed182556e862a72994b7226c4dad27b32aaa62c6dd24264ff7d9f15396947ec0d2a543b280ca4afa55370551ef82b51ddd2aa7ec557e33cb515a7d2cc7ef1ea23d7e8d889113cab7642820964dd965f536f04bb2238d8e97cc6cb82203cb7c93864943b27d2b1eb07becaa5a9c8799dbf7bff750e79e3999ca30620c647ecd720859444e206287de80206eee4d69c322564b8d93e2e76f5b186ff4269cf06870ff224bc63dc579f20e42cdad5e9e97ac8237c56d0b8e21b9087ab482d9bbca85d49a2e3733b439e2a3e50f859ef2c5d9b4fe1a915644133985f71cd41c7ce1da293290f8eb73ac74298dce7c01249b8daf483290d2fc43250655a76d5a3b6b4748e45fbe3ca9b2969c60c3577fe413abcb3856556d97356dcf9a3771051d42a735fa2a80343bed7c07cc69051d28103f0dbd376461238470ae8358bd36bb98c370cc366ecd4fd5c1e9ab284211f503081f8abf99d10b42576f8b13c866d23d6acac445dd3e7b9943ab17a45a81f0c896ddab0ff9ea61aa2817ff301d8a30fdefdf78699738e3e0edba4dca0e1ee06c0d8baef6fb01f16551b01fc6ea474fff437653a034f2b352ede2c60ef237212f72b58b78ad78dedd97aececd831547357d07265096411c9086cf4b3f704564c52ff596de374123b4dd9ea3523845706b07d4fab80907c6219080ced12ebc1b859c419587393921f0adce15655fdc02a291d01d174e40ad6e096fbea786904f508790ca6dfce3f90138368b3ed2cbacac7cbfb78db2eb27ebbcb3d1bdc44adfde5f928585c4981361ba8cbb03b350ddea48b43a58b770fd3ca882fe63e76d28ba156bb075946d1f228ce3c5813feaea5d933b8abc85005369d43a3344dd7c7bddc4a228586dd57bf7097cbbfa05e89cf8ef2f8e0546c1f1aa23fefbfe55539725e4be0a088232053f8a5f45143981b4eb40e0e8256a8d31b8a29bf9aaca20f59e0a0a9bcef9a76747db0336e6a878ace237d80f98221e8cd21b942896f6e01cbf487c5b50a014d310767a5813c66680f6f3a561912254f100d482d36bb7256b5950050031a5dcbd5bb536151a772da98c61298b72ea5b26efa239e92637f4125c94e62b700865645708145de7ac4d5ac5d54244dc631eeceb3143632bdd06fd342ace1b95404af7b128618bf6f886a47d085bdfa2d8d4eaccc6bc5228e77751061e4f512b132234b0f6ba5d52ff74c88c9d9b78c32acf5d6da50cc5c8b4481d4ee507d4e244bf2745fd48bbc4419d0bf97ef475563d1b8a8d604ef516d05f0f8a2f2b6a6b3fee518eea4c1736a08a1e7f1e46a1a7fc7e510ba8d1702134fb187de56e09f7a92444125bcec9568c64957941681a1f8688b1aa18226add955499e790a35a9ec7e6a0f87efbfc45f125e0fedf10f3adf73d04e797d5987555325b7504370abd6c9d5474fdb01748aa2f60ef4d309bb5bfe5a2a7b884352305b828e7b3f4e90a88c9432f67ee5f2e1cbfeb0dcf5cca0605c6e2c9e1502c6dc25f7f5da6b35fd4caf190be7c9607c4adc1c13e2acd21860af8382c8e1fdc2592f574a64f0008311bdf799be7006552721a7b67967e68b73998104931cdaff3baec95008f31ccb522181b5aadcc7523a8fefeb6b86a5967143ec7f26355d4175fec48f7b5bab3a6de4d553fa17af7449451c7ba1568b48b8ab4c3c59f88ae2420dc32645f5e58f2e06bfc6859d570dfdc1d763a37fd71f9987d1d59f0678af84386a98af22d667bb9c04642101dd82bf346979e4bd3ea80d6028b6cc9fe1deb4cdc6a4d47bdcc9acb6508ddba8ea11acfa7c5a5e9b208086839178f5f883a1e7188b8fd9ebb3b3f5b7bceea0bd7841203c2a365ec62d24e97b7a9780ec1db60b97e526b3568921d9f40291b4bb2d36134df740b6b3608979e1eb35a7dce74ba095412f2c13d42d2e55e6b0f6355bbe26d8cda4d06043b4aede7fab60605a22f2b6b99a2f8aad813439b31b180b5e84b5401ea223fae8b0332f0c096777f4b3d18d1a6bc86cf954f691bb01501993501891ec10dc5ac6982433cefb70d1c3ccd20ff597b551b6e822b3ffca9ed3437e513aa3d51e524fbacca04c074dfca101379f071bb4fc2fb30eb8e8553f8cd6bf27fac9091b870927477d544d729b0a859e8b03fce478a618b5dd750cfa1527b510dd930551b86ea1ff5a59398287a56d6b8c4e5592eedf38a0d7503eb4876fc76b96cc8507ca73152e05f45c9f0e7a84829783997b84718bd6c5cb6274244606642b987a91aa8f83d142ef34f94fc5a091ecb373d704127e99d2e44d9134773d43fde765455d00874f8ea2f1d3667c9f884045d14d848c7c6804da59e255fa0550fd8bbd94077620e311fc473b4c2de457fe821befd094a8ba545e48346eb27fc29fd1cde739b4500f132a2d5e1b4934adb3842eb063bb872457ef17a31660a05f29b998b65695906f08367634ca396b7040bfaafeecffa73541c327cbf9fcaf0ef40edf14d42dc5532a5cd0838cd5e0474a8bc11b0e19b3d6610b97538943213c4a0dfbcf8937d418b6703b0a80f9db71d11b9317de161077a6631bc62d01481afdd030d3c83886bfe1414406fc52bc8efac82da9a16a040526a15c29236c53200ab0562984d43d292bef6848f2a0d38f7acf40db748ab9a5d790ee417f34a0d5c02bf27033389b64c29c8ed342b9f03d22b2e5ee82c390652f9577b9d6798d4fa94fa2e43ee9b4a034fb32ba16ae4c8ac87bf68fe8aaacd630d39e88953e93648a4fd2db8584a6ed198077638ae0e5728db89d8db651a6c2b0e6195f3a16b8ffac4647f7ac0c8f18b2d9903bd6ea20b79c0ac1bf798cbd329a77620bbe335c6b3dadcd206cf7a5be02731a919e01541b57959fb961d4ebe061e4797911deb87174e29a5cea912d9a8d0db554d2bda386fb2d97f11e35b15d1e4cdc1b09c9fa212683bf0ff6886483b1531221e6fbed307c3436fb5d6b390443097e10b4e7f32f879936c5cfaba18da081a776e3e0a8b9c207eaf09ef3d4f3cb455d8115f5f336c06e22817dd555f1f5f42c8e5c2276a153bda5cda455b01301e35c11229a4171a1487979f1de5b38dc7fd4087b0e7b8409b0bbf0a6dd455c7f80b06ca5c5f45180c8695ec44c810385055437190f5c9f7f23c6182d91090f9d0d9dc368f77adb412ec4253610cb3055549e3b7a634f6a5236cf3ce3418476fe78e63c4f0fefc1d57bdee76e376e981e0ac95b93954b65dc1a3ed649e3ab7e2465904ff4103e0ed01ac024966096a9f3cc804b67efd0e45ae433d44437aa483d6d46dac4f1e427128358eabd52a814455a5cf58ca2499da5d824d99a469303c282178a92ca0d936783bd13fd66329c7f756febe943596ade762adc4c419f8331890b9d26a9dd19c398b0cf8858869b8854bb37085ae9f12f26e95830325f623dd7ac7e6c545951da485e41aed893beb235fea3d2c4fe7f7ed747eadb179750db4dd619510ec8340db953d79d3e4fd3fc9ffc492f15ecfab112d3c68b72e6c88b8471612501e14f895a8af0a9248875b0cdb3e9a4c5a60c1e7521e6b5bf2d49d5f41bbfeb3f2d0f9f8651ebe97ad5d40db410d6263c13fa1591edacef1ca13398d8b8349b5332f099a7a375dfa2062404231bb2a42518a8ef07ced0e52c3f53189fed0bbe407787230f855e0f8a96dd111fbac97320d2f681926ba64f4e0a4a01990f9d6a7f1f0ce20a4bc612f0437f5c34cd30a804838f815f99cb5acaa6f224fc35bd37d6d3cb7f03b103f25ce06ec3bc7ee5efead64c880775ba540144cae04a3d62f816036db19bb55e002aa605104b37c6f012e15ae664495186ee6dbe34bbebcc50f3729af77468fb05ddbcea04cf4dcb436ce14db3c2dfeecc2692287be0f96f9a75a460cf27e20b4a2cac2f3fb90518257980fa7139be910020d15d7234913fb5128f8e2e4c9059c5c897b49124bfa5831ab06c1ae0e0f27b6d0f13aa9742237b5cad6e1274f2f7a42e1d92e301ce81a25fed3dfc100fe64cd5b2c7e3402e641d449a8f1616053a19e1d8981adbee449063fd43fd5667bb02a97544ad4c55bc9ca77f4cd777b4a52f4191201d80f389b535eb0a6ed5192141b3e7a9e8d208dcdeef19970236040ed8b579cc1fd2cf04744b4bc31b76343a4a3d89fa88fc92144008a196fa6e0cea34ae215b178ec4df5c76f239bc5acdc2957de2910848589ac1578e77f54aadcc5f0987ae8f2bbb38489790226cbce6010d1d3f44cdf2903e3cc6ce6ec8ffab6cf7894bf4383eeac8af81e4b9c29eeee6917ba40852dad6ac2b26f30c2e322ee48051d5986265b6cc1e4d9cf8d61a33b30cdc85018b109826618b08dcf38b9b97d8b5acc8e5158bc8826ea9a85e1da593cde6dd749f81ba18b402760843647b8aa26277cbfc039cc51ec11ff6041d8a5545adae5b1dbbbad190ed90fa03498475504e558b0e87f2e855375a212f9cdab4b6892cc16249eaaeeaca72337fff507a509299d4e13d5062eda832b2fc4f945f8c5bb112bc31ae75fc301ae48074e5f0568107ba525b9f022c80795baeac9f3cd458b41bf5271c47fff8de143221ca47a031209f1119f3a425707774937b3cbb333a979b0b43a3aeea192c15c39cf8e23343864515bd0fce75dd9c5ecb74356636b8e3488a627ab14ad932b2f0e0a9f5612950ec62f29aa0304bd56b6ed7c4f1e1aa8ea6672ce2738c49251d4398cc2cde20fbf77062ff01dc1edee6ca54ea9bf2b3135d6abd200c8b6ce63aff282820c36da85ab8b71bbeffcec2825bf6cbd1a91f392ab9b337ca8f7c510cf9e545f4ac115ac487ae3f3f9bd34442790b2cdb819285ba4c894594a1bc89da8fcf9593e9b13abf7ddd732934ac4bfa4b606eac5198825e1ffe59508cf8cb1291f3935be5788ed29975237cb216ac6ca73298db3a75b9c0db91720a02894e772f8d6d1bd268cac5ea9398b19bfaa0c37c09ed6bdafb8d5ecc4724743812a4b0a0867543ad482dbfb68f1db3921b667c703e7de5dffa2761562cc44577d4ef36f2087f23c0cea559571e1011d1b7bbf2aa38ce642b7525cd7a143b1c8c7669cb92034246439e912fce919e37d8f2376800c1a30577a49101777e74c1794f4b8802e4ccd0255a12762a79d6448f859f5542379b61c75d4e461dafdcfc177343bf75c543852da1a133a3da3788ce19501f95f118d63ad220ba58d84c7b2509b0aa3d524def00ca6bc3fc712bd513d83d397df06734bda9957ddc464b24488a9a3b79e7a155499f0b7e949dbfc18ab21f596a41d649a050f4af28fa6f66402e94061ff5b933634f92590ba5bafed7775a0e03d5a70a517bb66a6eaf69b96a2ca4518a6c7b25fa67e057042e994133f5afa2fbd84be547f980b1fb3b07e7aa92e2807d596053288f1d4db4aae919418cd6a2a4d5fb0c40733c58b07585dd20aa056c4948acd6da8d92f9b5cb4ea0d15a83604f8aa86e052a186849d55be50f7b33cd4060393bc0c98c46ed42d15360f2453c8dde541ec0e394d2be523a1c83ace215041fafc13c9d26cb6d24f5caa04b7576f4438d52921f28d3e6e5713a20f574c169e9e5c68971b37cc0abf1e9758d6224564caa5f75ab5695fb16dbaff7338b1a3089e0e543dbd69a6adbb6b1c3625db51ded8df969f7a06738ab35c515350608a12fa3566045ce2ad4bbac24554179362ec882d2ddd394eddb90a7cd4a878c79edb1517ea35ab04b396b6bae9b8183cc2b2d46ba031133fdcf5166e2e38fb75d921e0356fa709f08f7db971b8b47cea046eb3c0c8a6a8c42e03adccc7f770f226ab7ccc9f553920772a80e32ccdc8043a75bc5ad02bf56ed377e7662e63ac51ea50f924b0264b4f1e2156512c8e3c6d3b9d985e958f0790449e91ac3a1625f70c2d749f9f7e86fa394fd8da25c3f7a04f16c86008fdb898b961a1cb67b066621c67469ec50daa0e16923ee004a6c7f0047862a6470b531906e5965105dde02ac878bddd23492915fb8720b0522d2a383f2ee3fbf2b976d2099e7e6b1583c696d3953c6cb3b52adaa28ebb654e2ef7315b2fcb2f4652d8e29d7c916d484e104be118c0ddb60c518fcf80d6e8d039e7fc9e0d328f4ff785ce7c8fa1a3be46ee6c53702efb9618d9073367d42741c217a1d3ef298a0df2e67e579e9a6872d855bcf44e0fd86654b76f4d9be10399706dd7cc753681e87c58cbf61ec9cddf7b71eefcdeaae7c1a4f6b67b0143cdde1f41a77c092b12c827b053527bce1fb2e529bfdafa56eafbdf4a54fff572581054acdd721a181f5edc08990ad1543d26fe925ed59c14ad8af0ca043d68b3dd69b7937eaca434bdb0aea8b5e5867214abb97b6911a4b95ee43d259c714f46cfc55a989d3a9fd68d732f3f3f0b600c1d6b413e87b46410961536689e1395962c23b7af95e9f5ceabc076a32d1bffa9a9779ca6260f5e81d3edf48bba9c8c3ceb978f315a3cab0c06afd6a88e8ea53144905e6161a46a344537b9561d27c000b2eb045a2f0d0cef1ddca4ddc4da15499156ccd5b9e4ff20f7f08b5b5d4566a06d03e7c57dc3c60d0ee8fb0a18589a664b40a1fc51ccf224ce61898cffa2605052210ee67c52b7a990f9e5e80ba811787d6361be2c0a85fddca737802633628d85a8b21e5f2d4cd16f823b79ae094bfea202281801c7cc4c22a4edbaa98259fa327f1b294eaa4da0a0d49a994d1d8c61ffadd292007ab0b4e8d3caf300156d0b97f5784bbaa1b55dee25575980df69d2e7c09b853b296a095da7e20fab89c64c73ab57bc6a4d26e96f14cc415f2c5fdac65704d692daaa68d4d4edda3109ac7fb8540da5d930377f9ddeadc52f4dfc7e7612a027100533f312fae47710d1710dd7899a12202f6cab29d466f914fdaeecd31684f531eb4d8fc3364c4f6a5e443c26222e4b1035fda94ebff2b6ea5da6e0e47aacc87dbcfd8916a40c186622791b7aa943f45deb34a54c34c0aa3c0f7ab1a71c36d5acfb6e508642a7a0e97b9cbdf59eb523aa0490e72fc68109458bd35012682ea30fabeb30d57044ef29f5a552752ac8c30d82c4823afdc859d776c6401eafaa900eed823016d0b72999771a687c0a4c765f6b3e9a9e80927f39a41cd4a3d8b159dac9e76432c5b04ff37687ac069533207db1061b3925380a2d131c4e1afe7f2d983926cb3e668792c06f83eaf8b7ab777430c0906ca85933d66f0f647ec57df74cf397b661378e08a997d4d9b90470987106bb2473b4b7184d89c293bdd0108a939ee9632927032397a463efebcf6f6f79445e6237b4e3994d4b5a48104857b6640f15b44eaa9ca9d6fa60fd0ad1b01c3b94fbc708d76a8cf29c3727ed281d5c29b205fcaf23b88a1d98f798087de5687a14513212cb70fd6f7838e9a52f333628cc868f997f50aaca878bafcabbb7824822bed4d286e15a8c4035bb7cc4fee061602c04b9cca1c1b1aa5cd0e63c14ef4346d2a30f0c09076f82f7a174eb9da4e602743f625853893faff4e27f096a8178ca7613d64ed87af8d4ca87e42f0e1ade4eb01c3b97234399a5c56ac1805529980fa1336b0b31480bb0ca4a5c3db02fddbbd49f2362407e03fb4847fa9ed817f67e9a95d4494beae00cd18279e9a8c80c2529c66a129db836f756c1769a17cd91f63af5aa970c594e76b0ba3d2740b153143ac9cf1eac31a5c312b8957d264b40d7325caa48fb883ace268ca5beca87d0893850873613f4dcd05dfb88f7faa6a3fd10242bd2a990b82cc223a7fc31ee20fc13c9616f5098e70d2c657cc48d4489cdbfbffdc74e32d5270fcc4958022d139c6bb1e0ba8a697ba3d4cd6b84106b7f7eaa8af9946f190359726c731a8a5b3a95d3015545ef675487c5780ddf652c0b0ee28a11cb4261f5fc2ff4457cce9c73f99c7c72873c2a0298c5ceb22468880b7536d90c730c3d6de74ffd4f1945448a350eef805b567fc4c17559626de068f5ce99e1e0e1c59933afa31f60f421f00beb67ced8dc5a62744b5f5475bde3150b602ac9b622d7ada2253afa161f1b87b27cd2c9239d28ab83eaf7c1d8f4369b4d0dea31211d0da02de148e77146150391a2afcbcc76ef6d31cb4e203b6cfe4adbfe39d2a7e68758e015eef7199b4c1c036e630b741eca6564ac42a75718f5de88e50b8b50bda5b5ba828bdc7d1ecd9d961393c44ff3d3e86e20d3231c1ba728864121bcd3db8cb942e85f91387dde20e9b13b19e2333491a825fa2160eecbf1ebb469500e2b173b07d49fe4aa067327e2a6c4969e5a541d7894aadeb58042553e4c4b2c6c42798b2ec43566969507329ba740a77aea6c9af0627b925a7a58a6b8875ed5aea0eada19f1d169012a6ca1185aaa178c594963e1c0797455019509bd84768813a48daae5efa503545101f0d6825c4994a3206a9fa0d34d79cc2e54de5d892f9e8b37f8e3c871239ecad60d258f9370cf9da0188f440c0c1588d77e3ea9f692f995fe97590ef6599fd24433b868e23647c417d96de6bd2fec96931ae10fa08c1b4a178990a652a2b8743065df85ebe77c212263f97774f1f0bc8bca02c65474777be7e69731b53a4c89073f1ee29bfd805b59aa2173b8818c17f086640ed74d7536c32da3ef0770a3a43b299d3e44bda1dfa7b7332964e83ee4a954b2b9cd7d355d57aadf75b818a556e96119b58f6e09504c0d2b5a15eb5bfc0f1fe0d40d97cba7c4a30f7c4ca2b0e1e9b4e1b30a02e17bf0bb8dd65ab73c342d37b40758c7d9ca5e8bc51e5a7d60f34b2e329e8824d537d442df187075969ed5845988f10e5685dd389d56d31b043affc3b58a750619f41c7ebe0949c0b62279e28ae6d1cc9ab9d05cde4cb94bff098a808ca3a9ff18c172c921a5ca28b08f916a2dffa297570f742c96bd74dcdc17abd939fbb920846ea2333c9e091d42a4c25e59e2daab83bbe6b9600cc47189acb65ccd159f9fb32aa92fb67915e8fe9f82c92c05cb63ca369b5f420c000edb95c900386daea1f434dc195eb55e04decef4c3e5bee9db1cc12f385183a2823eb8ed15429ae8a8c4abc3e1781ded8e6bd18fd4120e41b9799f2afb7b78c848bfc8c9cffdd70b236361379457a64ac9d32e2db3e4e9faf98fcaa57843a741c7b4f651ff6150976a10051b6b0ded89ecf3f8eab766cc004299dbd4fb93bf7d6e5ef0dddce85c9a196ea0889b5631fd6641cbeabb7be2a8433fb2355ac5a233884256135a2ecae5234dd1a2e56d69d7baffe4704ad86936ad2900834852d7dd28556fa929f65716326f5973fb6a544b86223a5abd78d8bf8320c0c02d8b8423158cd6eff3f0d90dad0a9019a86f17ce787036c38789d89b4e26856f7a26612aa53ac935a87315d7caa04602f2166147a7b3a997705f7387b18694d8ff4627043d9e8e015d56645cb7e1e435ba2ef9716646f5345236105c15c9150f01595103d037d73c5df877746bb6207c2eee64333c5a0e95f9abdca79579451feb01d44624f5aa0f1bf9201ae7ca805339d1d974eb948668fe55671ec10fdef38beb5ad60d3e65645962d36d2d17dd151ad319ee7163d0c80601289b6bfa12def748ced09b2f2f2d8288c5ba5d0301adfc6b1c380f75009798461f5e7502eef679883325e95a238519770c7d8ae58107bde37d988ea2cc36fcb066eae6c04b4503b89b0642eb63f26101edf063398dbdeab83ec893d6901abffb3f6bbabfdb5d7a280fb810405cdcec511bbc17dfb0964d00b4604270e1b32db551bd7a62341ed851d59604553f51a31be8bf8ceed9533eacda5b88e95995117cccb7d37ed8f4d62a71bff8645f30621a00c4435823467a1f91fe9fd3f6db4154b1c269c072ac71a28978711eca697ebd72e45728d34c207063d7c77ed3f8c601c5126b4b8de15afbf1041e676039d9438024c648cfda10527360e71420c252d634321e429186435095324d16c8460255d11c5d764b98f89d3617e6f5df6ae9fc21d153821d5a536529a808070beb74559cce14953142c7ea002bc6418c5ac4a86106f3691f37f99a2560e62a9bebd04d5e9a6b421054739262d536dab703c3d91a47253fc594d0b8ef0fc255e46f2145a657bbb3c2a91b8687e8f733e3cf52e69703dc46bd80f1be915767d6d9d6ff5ce267a7cd341e30f7ee0877d96baf4eaeb98692599e650979d6685ea5589a19cb9d4ee18bbc6cf2aa135619d1b61955a4b5cf3122c97f9dea899490c571e635248a75702e2c0a6840643c832c1e062fea735da2f8a4c040d436610ed4cb390ca2e5c41791d603be696ab6ab319bdc04a158bf40ec4b89480e920780c568c249d61bc0a48b653a9f4a32b7c6b52506e21a4655ad1a0379f2b7389fd622996f8e4c149061b4bf48694b3b8a3f933db53e703750e40b6338ec297eaa651152669f70a977985ed3575eb9c451f9e9a87f69792c397b8aa96878d6313d6c597a3c70c577595d44913e24a2b41cb3a619372fad77bc887a1008589f958b2f4418b03850fca48a2d316c7b5c382c9b5cd2dbfd51d0a4d6febe1597b7d074510661a21e2b4d9ced49b411771daec14affd6f41c4724863991fec98a6b51136707b1b74e1465c5103c89001f3eb6cc4a7494b161c7b330022aa324b48bdbec8ff275b86274c5fc5416df541ab51c4e09cac128755dd29c73c3051ba5eaf0cc7ba1a608ced6a55420a659c6ba6133260ed387442ae0787170277800cabec3bc9a72d14670f9e1183b603fe14449b7dcef83172aa9787c289d8a66a4926fb74c5d33b494f9079f89b0e4a5089882911912e2c742c291f48e79df5683a66cff64a16d7bc7226f67957bdd635b01c6f5d1d51b514b45fc63b2ab356fe40a61ebaa2526ad6919f087c59589e020afd96edeb39a08a454804745e59f315208af711b1bb560e0dd6091712c554b1a9a4d6a3888eba25976f82ed1d118645b14bbb17cbd38abbcb2e316348d1c4f0ab573a2303f3e18a29ddfdfb66c80b6ede931b57d75023186a32835902d52477e6879c2fd68f6371f1bb02e17e7dc3fa9828d567946f4156731c6412f94b5e78a58cf756c0e17c2c06685c1fb9d9db150e1b3e8ead95f581c8568c00d64770ba81b296ffe4537dbdd3660097106ab148c099edc6a5d91085e5cabdc93ab8793013081c5ef50902b4950939e51f4139f88f5f14e9b96d9923e868136cafd4fa51abacf2c00ad8f54f2d3a9efdc937e7e53f3448ceb9c3556663833f6d4ca39be411282fbb37a9c1f34f9b9beb847075b17590b5d9756296674d1578b0ef17a1c1665d5130cfeb8459a09a562a7fcb623c8d97838fb8bacff83c813ec929ab485932a2eebf0777960435fcb3379f5946a77282176c3eaa3c04d04ac8992d83ec7180fa55801225061ea2a7d1d7d1a768168b86132250cb731750c0dce6b7c60249d33cff6e7a96545148f9e1f25216fb3b6a5b4f0891a6828334d1136ac3db9a1d8c99e923c4542a882b32e36546aa259afaf141ee0363e45bd4c780d2620da283ffccee977c44905b3118ca38c9ef2b3442b0dc0aefa8a9aa2a04fb01ccaec2a5a32a26c2d0c580b3a88b630f592aae4f4fd277478ee56e2f79ae5eed1d8b8d8e8f9536276d41087adc92f96aaf592ba0aaff9235267353fb87e18b6155dbd1aed76eebd35eb9d6be9f8afdd1c7ca7a37ccb354fea80f0db3edda6d32514ebafec180e3c3bcfaecd745c19ff94796e8e1cdb25b80d4cb2e9a8a50b65ad1345d0ef4809047f90001839c8e3bc8cfa8aab7cd377f9cd9b0e40c5c730daffb00e053b241280b6404c091d6df24b6585a05ff7aa6640e97eec3b5c826480d93d05484038768349ca5d18ccdc8be246e648ee677f18dff05dc3890cc3e95120e60766edea9bfc675048482f4150635f85fe676ef980a84a0444945a8b67eb64730a01f44f6ddff992351aec711a411ad592afe45a486f8fb9b1a0a2a6d8d81dc618239027a0b408990f4441544eaaaf3c8e5c88cafc3560c6edff90bd9cf1f3ae9c2c5fd869a71043799c10615ccdd531cf3099a0a2f6984f594477d4049e89ef9e691db33b2f90e4661f5c6d2a66b3a159576c093755a71976c471d6706d51f680f0d98e0daf209bfd4e5beb6dfa52a51d9f14ea19736e61398ebaef858bc1e13081347877ef09063d6b611f298b7260dee29651115136e3086d322da647c01e9141e5e234638001a7b651e43ecbd56d03fae84afad75e22a76043e046a1fa7546efce5f77edb31e5597914d4515052e041c3c8bd0cc6447fe24552e8ad91b7748a12ce8e34cbb20063a8aed864c5c9f137983d017e9d0ed81fa313bd0f8dedf71853bc4c0954d698f3ca46527d1724675692708f64a65be0f8bc58177ade05ba8c1d4aeb29bd6520b26772572463912be17dfc02418d6fdf131cfe28495ff5b18170408fccb893fde3cb3661ed9cb0bc98a75cafc395cac7fdb26029b39e717fa60c24c8538e55437d375486e6f6982af05e3294fc6aa225b04ec7f70f1a15d84e53ead1d2116e7ad1231de74a6f493a8d0e7bebf9a679cf124d409c26ebcb099e0d39a100cd0187b452fe0fa2f4f0bc6f23ebcef8a07c2029bfd3d5b7189981b92f6e7db03914cbbc2d61b8d891a5fd5439998e13dd40f1fbc8f6cb1b8e82d8d1f14df91b8ac109e306bee1edc28fb38d86753f03b75cf95a0489743cc64b2e681c2e8d7902faa423047fffa802da16c67df1bbf37c7537937a150a7009fc9f09a3a85313a5c0c1aa1b68dd3b574d4d0ee8ddb7d7813d66811103c88849198042e39256bf869e8655c14b98a7f051301733ec70ec4cf4d2229afd8ce548dc6b6a90bd85b64191695b14d44bc159d07ebfff31521a78164a3885ad2d995023c0733699a258b2ad4efd28129e330680a47db290aef193d69013a3ef6b0cb509f935e61439585063c67d8f66063528c722ecf6c4da2b4f224547c26be407c53cc2d81f03aa0ca489d3418bdba21f605137e1cf5d1fb5a4f8b3a8f9dd8915d3fa7a0c959d7d6a9e8d5a46fddc00fb7c3a3f7ef097a01c20c75063013eb10e573579579c5668db9accc51527a7aad99c6fb0ea8c1c49dc321fe4273dc604b0541fca46c11aa89fae89cba8245ad748c0512a3d55b55f29c51d34bfe562d7728d6c63fc6033f27a3d012cde0192871fd9424f91475ba91be2f9077fbb11a1362149e91c764ae960e11f6e0496bef2c2aecbdcbc7c592a39dbe62c6587ea56a39cb941dd4965a414bd6eb0e50a35e5e9a3272ab8d728c8248903fed6a01f4b0599baccff476c74c3824a60ba2f54b7be8ab92a4445648f9a4434834489f3cf8b5484257332cc4762fe5ea0158ca5720f08fcfe0df6d826f34b5e82ce561a7f5ff92309d563aeb7e72fe7406692551e40e061932d14ffd2d2d6f76efcf57a960399c3140a7c607127eeaf64e089ef47ba2133bce1c572a2921154a842944f6710a3fa4d716db8c3a9c83f5dfa0738842b1daa01c8d3c02cd1b2cda9ef2d01cb6b790ba3c24a778bfcb0b4210d5d31edc48e8bac71f811556ead8781f21f3e6d35a45b972b9aea08a063925c0f1b0303a7fa8a279b6da3a6a72d65ecacb8c19f9c87829aa17f57f74c6ad5bcce5b96f638ec33ee56d1c23a8567919e1d7e124418bab75bc8213a83741daaa641c084eb8a7d9ac765106055a9b40a2170e4efd5e70901be3c6f40064c84bce7cb61fb1e0019eee2be0cf093df60da5833eeeb00442541a3dd046aeb58769f450580ab627f54cec89ab234156eee3a654efb0598d7876886a38971b0ebf4625a5d6c98564b40a3fc42f1f6e18def5567659c76d480f3cf4f080071ea2649d2f6845c70dc3ace378ca723b6907cfde93019587e81bf739d9b01efab064ce5d10826325a3db46a231f4dc91c57771a7b1b00996c7513c615d43439d5820dbcb5acd17fc5ae521c304222ec193f7fe1801dbfc704611302df9d2433de79d048497bb53e124480e03996c9e2d7963153c0ba1b0a814b37cb800f9d2445d201c2dccdd83c4019ab7cb749bcea90e71e43a4a9fbc3845a5b1a2eaed694f29535146e7b2b4ff25a56372116c8fc741d775b7bc8506ab82791798243f084ebbc7889f7eb0ba8fb0f92d9947a5af365b106648f10350260b562877553fa2ece5c94ffea6aaa70e81346b5844ee293c66e1f790a9e0ddd61dce02784584724dea917cc78539f2ff5f09a8d03a8e7a1ac449a8976042aa51d0fd9b8b45cb1e9bca13f18ca676782d9ee47071ae9cbfc0ab025a038231e4564d4e4337dbdcc99bef314a704d43a1a42c82f16b6da311b0766c5d3f3edcd65f2abeba0a4e64f490fb0ed1b1b40d83199bae45a0e9bef9086774be14203a93b47d46c5501ec94b5b40480477e233220b1f19fcdece919748ca1b9e5d0e5da18b419a1896cc38f5c5ca52c341219ef6bcef2cc32cf9347dcf1c25254c430abe335ea22273e8b938843a9ad5bdf6b475073b05faf1a0a1955bd46d6baaeb5138eda7de4784119a3dfebb69982915880b876500c96a81ae4f747852bf859453ce2c3bdd54293a4c617cdca6210aa14be9802ee685ee1784dad28279c72e774a77468ec4882c3e65e9e10b38649e51209fe56330221388c7a0a35e94858063179e2df190e01cbf7b5dac1402f372d0499c3c51fa4f045819bf6ba83bf74823a328108d607abf0bb2c279b81cde1f64562be03e6a8463af0656847c1afc439612b22d0efd537a776900dfbab9f77300ddaed1f9714cc92300e103918eb4d63bf453268b9f5fb5d7592fd1c57e3ba408ac4d00b5c3bb892c43f631ba6b5c107e472f1847b220ea395a703f90eaf29674d506a3d4532b4dddeac6e35d9bce75a9c490f5f56df318e051105beb126d196f854d2beec8e5932c2ec1a2401c5b8ecad15c35e6c562f77eb73b98b50a2b38805276cbb3b6352cf7c07a9c4b4c05c6f22b68ce24b5f9ec390b6b6e8fbc828c40d208b24fda931d8ee7d29c4952097768686215c7573a8d8059adb288612df94fa05d36b6fa4df19c5bd8fd1d90fd6ecc7d381bab0377faee7b9fc1403a2eb20c1fc7fffd93be32e1b807a9ca790ee6eb1d27442b5d76009fc0bfe0ce3a74b39c64dbb0d57a46f7459950efc9f52139c5afe498a0004708033b6ac21b242b3afad164f17c964838298bf3d8411eaa8026c2d752fe7d39ddfe86a36648d1c89a5b14e446ac0f1fc4dd4f7d762a961899a53bfc8ca769c3e01554accde1525260d7acc2cba9785c8a014c8fd44ab7d5fd76a380c1f8129b068b6c5912082de2787324a92f196231822b93b2d46f16242db6a34d6f1c21748bcd16eb42120ba72b82f1e7eef5a8992422c0d5704a1a29f9912d91de83c93b7edd3f1f92a1c823f823410739f95c4d3b178871f48591dbbb24c59f452fda5a9422377b49793680ff7e09a1db72f8feb8910ba52586e09fbc42e1e17042ac42b451e6baf5371189ce547a45909d0b8ca8789e8b72ab558066ca6bad9346c666e1771a713e985cb2a1b7fa88c870a1bd32656995c4622b71b8ee025ffb30ced238390dc3619b57b9b935e4c54b9a23037ec4919c15efdfb48e7d36358fe4826dd8f35c1d0e1acbf6b008fa034fc2a2b20b366096a64ab912c462a583ce2ebd714d8183c61ec41d2466156912bb8f420c6a26449b9a18a025f96ece46195ab2ba3e37d50223b82ca5fff99f631d89d7927213a16984e8272a11775d3145a979bcaca6f63add98d82b8852f80e9c1133ac5be04e8169a9816ad22598b1487dacdf70cfa3e6e88cd3bfad9f33b82e2976ec03bace7b7f328f133adf18176d05722a5934a39f471b6c51252708541aad8630a181be0b6ddf0d027e07bc8635702ea916d540daed43d068616ccde36314a4c484087863520874c80653f026219592cc26eb61a0e3662ebe4defd428b272beb1625a5ded526575e7f49f946260ea76861b72d5902c2a63fd684505f9c70fe98bb7fa6f36206c09f738febd43dda686c392e7e3425a2c74325b96881f3aae7c1408202fd4e27809435a40e564247a2ac6a497e7aa3fc63750a8aa014f0eb11c304ef821d5957e85b0cc3ac49e0fe7db975d5e6fee589ab02ecfc14e84974eab2272589f5498aaa6331e620b87a15efe6331e862c710104747f7b5f4597bcca0a947f828ec921ab769333ae155a3c96898f2ec43d60b65eaffa00d4afaefebdcbdfbaeec8cd5b5c6d05425ec917f3c668182998b1ef04b1d2b3fb4a7596e8d3e50598cd4636f9073ec35d6512f4cce03bd7720a984f82d550d0ce74b1a64eeb624767bc70240980586fb28e54bf3065fef17cd790bc5492bdcdc845cf7bea3868487e229b265e62e529234b76723dbda025ba6efcfadc949d973771b2a91fd98f608c0eabb93d524d13b0dadf0a5eee284eb9f2105dde20d473fd06bb5c5a7c5a366908c42547b865e51b159ee17b173260d9309515842840537eedfc35755a096dd8046fc53cdd6fc8e03c02c7b7d79f40c20af9da9aabadeecd1ddc5025ae16da6b7e6967723263e464fa42b36b85b4025b17d5c19b972ffbb012cb8d26f111242211a3cf5496b33c6be4edc407dc81ac4b323eea026e9e84cf26c2dd3ce0a1d90852e957199364329d42e1e04852dbc7d460c11e29b8315bc896d884de38be9a34ba6ca10f7cb3b56c08fe15564c862903d694e0f566421c7b71c37ecf24aeb702174b1ac3ad2c65851db4a142f4d415fd61c1ad7638094b4b38ab84720ddf03d7c2845150d28aa22ba600cbefe32ed4bff0b7dcb4b2a99e3797f8695a1308522ff9c7d0193bbc2635b63aa1d75eae1141b4079e634948edbae39aa6b6a5f7179e830a58648ff3246421349de03e4d96c750bccf674a07a069ada3a71c662f4ae468a7715d422423e5083df976ed745f3ef48fe761afc27fdc5933dfdd1746bf807567f8cdcc3a7ae6d73c62ed7221ff71f8de785f77c5082318fbba751209fcc652aeec58a06f46256c4906af8bc3c66937b90b89b4b04da413b3afc1c83af254a3eeae1c6badd304f05f738ae5eb73fa9fcd0d5c822d6790da752220b469b861cf52b686ce2b5f70694c5e3b765df5b51bd1cc66ae746db4182e99b5c3b640dc2d13a61ea19fe7f8125dcf724e2fcbe9b85beed429b38ab4d992a14e5a8fbff008334c6a1579ff1c960c7cef9a2c677ab254b0fbb4d14e63d4a916e182686deae10e337e3044b6a1a0a1ddbda3616ed7edeb7bc7588b263b5f9cfa8f4376050dc3f6f942a1df64e8a6ec6ff0cb76ce0c884975a45d79e155e39770e83c3324e7a41ccc200f7ade701c35c7e440c1838f797e9adb98f0a214adcc84756b7415954d4e2719d61d9df30588fc9931366bbbe11c53cdc02029b5d62cebb62741c0a144c94acf999dfd143b32ffc8baa89c4d69ed5b8a19402694234fe692d9ce575919b1b8c9dd14290ee90da6fd53ea202b0b2b93a291945dca06367421add3a645ab33c62539b2e1600fff638749778a3737bd5dc4223a031d601d6c87c9bc2d0541278a2698d34141b482a7086fe35ad837c2460816f9486d9918ea0b8cf56413f333963bdc390036426dbb9d00d9030db82a71d9a5bdbc98b92c802c5fb4770fcc4fffbb278197f9a04780bcf09003a3c824da6eb3f4d1edf1154149b9f436d2fbb03fe8b640edaae62628f1d38f2fc4c538e1868747b5225f07122b7cc3433e2890d4a30880a6b710432c54937a033936fe741d96892af64fe774b3bc5f50840286d79c049e9a0cbd0ec9026280b0a4d6717754913bafd80ec96cb55cef65a8279087988e826770af740ba5f511d43869b5e9819ccee5d7cfd477b18d05b9be8b45a890f62b261a15e8b20490794825f03270cf89f2f31c107c219749ebae0f7a2d57f93e3a14cbc7b58884607064589645a2e4f66d69a7b511c8b661524450d1d3f0c58bb02cc5eac2607506ec4adc93df4d7e2bda47fca6334c2a1b71d485973083ea88900251fd685a2102e91c2d272a276d8853b67773a385a47247b3b4edadef3b11a47b12aabe2a6b307a91181fb9a01306c9a50660ec8f2ed426f2ef0feba8a8a1d984ba8c73d66f7202f4872c9fd2c280d18218d959608de4788f1f42aaced15d868f2d64325b9acc95bd1a960fced196e7fc8b26ec376e2840392a0d15c1c7a74ea4f4e87679552a0bea453feb6a1209ea01fcde51da90b407ee351b3951d380b87ca2da79ce9da0eb0da3ae3c97b588c1f657b8965cfd81af74cedc11f4b3f5293ff35cb8731a9868cf8267b12c769284a4a3320029bf57ad9bd976d70326bffdc3fcd798dd32ec54b21ffd32c83c0ef674fd063fc48f20675dca8e7e9c39b393d48c5145bf4ba6a3be828984658111e1d8e2d0abc39c294f95d9a7dc98cbe4b96ba4d8ed74d8cf27ccb5ed31332bb7b6665252b57345c7267c7e4eef6d24f72bb9cea93e04c438610632eb3241a7a7331d2eaf1e01046bbd9bf2ce75ed6596cb1b00a055d5b40e7198cc618246b1826e98899ba013ea000febbecf8f4a2c4ac4de8ea9d41bb5dc501da3323a821eb4506888f876caf54384b2e84356837731cb9ae7352d565e964576c28f4859becd3e441dcde476085b4078253a00c62a87fc7b9457b93a13ac5b1d0e52c434656237e335241f11d5461f58e13adbaaee4a826059a6b9a7576ba0c2919dc9735caba48f18e010f3faf2d7a0c2d557a7551dcb7bc6a5d4e2a7db0ed323f8c3e9dbc2ac52dd25bf2f99f98a1393d55b67e9b0981cd28a4dafe40e897e0fdf290c46dd87c8ee625d135fbbd09f6915e56585e8e739ae3e6a96ddabbe76a0f676cdab90ed199bfff021bbf3303dee1dfe148c578028fa058708c55a99c94a3ce1ff3d35ff65492ea74a7f28a7bd201d1bc3f2b51dd606c6f50f608ca0047f2946d8b595592568ba92def25a6436eecb76ae3f7a344dc7d67d6f4a9742bd8d378b3aa799db22317ffd5bfe06652998c7181d224350858bd80a145eee071722298b81f160aaf2dcea3235e9648871c58817edab86a6ac01f2e1ded4020553a1b08e047aef3690360d426f3ae04e89f9b7028acdb512f9b3e2bdfdd88d722e63e1eb5b3d95d5d98da7c7e80301be82d2cea8439a2d4b00a960d4ca1214bf550cb4f884021ad18648019cb5426991e92199736e6ba88ba1dd56da9d32aea29051bdf75ad8811ac7b18ba5fe57d30c682b4ddf75cc32259e6861e573a03d094fa6c74fe774a34a8aaa693686b6f44fd449d223f6f32232394fb97bdadf0a580229ffb6fd41aedaef787dfb323efe32fa2935c6d09698dd289c9769f49526283ff587f0c81af8b2dc6899728e992c85c5a39ad3993d885665b82c10ba725ce3526b5e5c4622b2864a385e4bd8901556911bea34516e7f355ce359f40216cffe37819543df4247acf5b29c3dc2b6e60ca1150fc40eef8e1c76ad4eacfc5828b6e08335347b077888fdb3ccc54d0d63ae4fb2ede4d1889d84ddcf835ae0438915ae6b84a3387da57d459ccfe96b33b3cfcda3fb0be42ec2714a286361defb053250de3a1cfee6647e31d6b80927101238a2b46ffed385914b48f77606c7d7f9637082e1b81375a96270566dfbb3264ac9925deb101c81741190243d55a02731c80329225281b994a8c376827970766077bfd77c0d7d303f2de822976b6edadc9c56a5b799a140b20c0ddc131d1ed8693a74c89533d0e8b88594c8b78782d1c00fc34f44c0d49b5858fbd0700bacbf5b29637366d4a75efe2f7b02b576a50d025957ecdeb18b1c8c4426aca7464ca9407b2f056e64154878b94412492dda4d9afe1e03e9352c9a645662a3ffa20a33d933640e99055c1c88f97a77b25ab2b03d504513c7bc22c892ce434b0d6793a5121c1f2d1ac2d38a49f15a994f9a97ac4407fe4df77f269f889c7c82545e00913e69661d1b662099a9ccdcc3d7d9c1ad3621399707d5f51d36be8dc5f520f3efceb205d430d74d6d92e13761522c8628427b1cde16b1bdd138accbabb52d5679148c4409fa8ed308b015a1f652280158c37cc1b0ecb6d7b0b6284a40aeff75519f167a61749f82d5078f210a358e33e3dbd8fe3ca58752c7d8518823a55c0a1a93bb5f2a3cf987bab41485739648c3a27bdecea393f3cc6537657702646b12c3bec542f70a4d2da3356d45124a88017a6a4416c867cce8d38d20a30afc23d84dd5271e3f52f8f733450f9c64ce84fb2d957f5461e194ed3b59c205026a8f1385f6f442f447efc0229717a0a11758887fa384d4bf116acc54bb5b992aad445e262203abc4f3589b5fc1e6f81a4ebae4f296d7ffd5b9f60bb6ada4b45382bc8c1320170f930f34f60b7b04e7331ca7501ba51899d8122cbcc70b74eda024a5b57cddd40b5616df40d7b7779054b7101de4162b520b2c523fb8505f6074309d26b7e3e1db77cc1e849e8711900397074e7ff6adfe73ab0619acb7cf282a1bb9a52793463c17a3d822c646acf990f7f87076adb0e0f2c50f211d54802fab317c67795173bba3a241f7a981cfcb8904a15faf8193b2418be1ea330436257a509082f0f4fe2587493b8b8b8a21dbadb89ee9c2da540a89c1654d53a1b2a3fb92ada56a5d9661026a532b780ca25e0526829c1a023537e968e9d1aacee12d0639c9e560c3dfd2efab2d2553abfd849639a1f2e8d2b1d435567ee1fc08200cc362e5f01731c7916715cdf0545c0e0394e68b1168202e7e95905f5f71a15b7af3b727f02c731e41e28fdcde978238915c9e03321a495bbd69c7d75438e09a5bc5d28ca0dbdf863cd373127466a9911456feb9b5f7163c26a77e1bcb82d6dcb9768886da98fe2d1eef487623eedcae1670ac90e401e1b55489fa0cf3d482d2875498817d3edc60f4a7a6e4962848ec9c7ae18ed84d9b25dfb3c5e958cd0315f2192acc6dd4528b8d1d502cd19c877fd3bb3e876fd94ff34bf643c5c3c2dcb911328250acffddc953480d9fd86980788332f616aeccb3a4439085eeb2e9832edb490d8e25466cce87bd80f655eed83de680a178f71a8cddc3479509be91a44cee5751a3f5270cd63cd1d2209e108d8b9b12b68a46aba06b220de98d37c010a986987a7c7077c25b68ae7a7d6b03333a8d6d4751c2458a3b43f67d942c6291651dc32c1b412f058497354eb08ecbddc3e9a1a2d165977f199beb9bc05d4093a73d2610c1c00259337f411519201fb89dbb4a36a51965eb2f689430b91bbcb62d533196d03afed3f40d604f7f3e11c3509292a41b9f6bd45f58dd780ef3ffae66c80a50537278ae625ad8ea9dba1e9eda0784d08305b6f92ccbf942d8483e3bc4f03e199f3d55e1abd2dd7c6d27ac87ad2699ca4943f11a9aab1b8d5f08cda2acf28320ad1e5a0c42204fae338be6e55169e0eaadd6e4ff7a4dd99cb120c5b87478e1a64166812c996ef6778f94c5f95f62a4e5d71fd984e56908f4f0f53cbac6e37ec81c3d3f7e1352d1558e109995c2e07902d035c23a1b60a5b5c19523f29f811419c79ca5bb67b9feea46561e800b94d9b7abafaec295f04aa3ead07798353efdd9083ab621e8aca0b22ecf883a8ddb8fdb17e69fc2d73e2a8717fc538dd8641b7e684a4cdb8cbd81819a226bb500b467e0bb86171a95b4cdce660d3cadcd2b828d95508dacaab0359b44ff1d83c903dabf1378fa363b40920aac12d191afa9c4ae44e431d13c60c446964e2f2457895da919c0be72d1d806b2ee577682eb5d39c54be5e8556d1ec11167ae7a828ed70036f52bd594b209b97ae5d85c4bdab4d5442cc2ebc78bdae1790938b70bd45f8c3967c10c9fa9b3b2e0d07b68a7da4ac72fdc78ea8ffde89e27cb8b06b0156fc7d70e461806e9e9247926dd48f68f99942a94636104d5c116232b0a5eafe55562416ede53ac775f308c40e8ffc5dfef7c52d2a75206ae4e7add6665d74b34acc9f47e6ccfed4ff35c6ace64ec9094a5a2a960247d5201109d6f950bcfd4a721cd9ebbbe6728b5d93a071f7c0e124db8682d173c06e2ceb982cc545542b335318e4b7354aab5a0bb65df0d14c125aa1b9385a68b94abdb189cc81353366f11ed9210dcb2ea93d5015b6dd109f2ae2e7516b1c15da1608ff61bab013467f6f2e098d237d3c6ce80b2c4bc00fd8be1f8cdf6c4a874b08d3f14f00c5494cfb61f781521854c39b71af11aa6a412c955966d40fb0bbb997e12e0be6415f79dbbe4b9ca6650113d3cd42cc913a5965580cc9665205ca59a04c827a5c0b45d8c672c9bb3cd6abee00045138611c3f7dda66149541bdfb0a50373d14d852b066988bff73bc75d9dfd6c518cbf68fc3adb30b66f5939d67245af16b35f22605835eecd0222104dc19377c3ad904eda2e5ba5121f77586fdd56870441772b073588591c546b54c0d819027be6263828efa57d48eb7618a49a380f92902dcd3cb18b95c6fcf1943383d06dfeb8e85d3fe19ba9d296a60fe0df464f09b82bae016a2ea5e4b8d55ecb0e18e6b4585ac1f81e718472eb896af6de1e3d614e9b8fead626120291d5067edf15961c3e7385a0299d09f7f94eb6bbf96e904e7caf390ffd2fb316bf30978d08de06bb2cb2746ada3d2fa825f47b62334b944067a5866e9b1d02bb69d3ac5efa7cc9a8660f7b6ae5ffb05db837c305890e7737479d0041fad20d7dbad9d2ad35037cdf1c5628e49dc76093c27aa736a20879c4217595fd567aa0d4270932d74d12971f29b7954996e4b2c829e1b721eea400e61fdeb3e8d14583da217a96666bc2a27af4512987c1508c43f18e5c6e6ee3159f4b6b0eb64fcb1807b44bc1199ffe38e1f501115dd8508508e68dbbc4185130f95cb6db0b5110b1d4fa78c311cb994a56603f7870ba9dce262d45626e0fef8e4729ed7308fd780a6bdb728dc54b060f3a2697ae9d00ea840ddceac0e101a64c4db5efed5b755f5cb38c9ee232a648f9599c3b3b1bc376b1455d5fa2199cad2023b8820a207975a49fc0c479ff94b34b426ddd2a90ecce823d0f383a8318d8a9b2bca8adaa591d1d52b4fae5773e8e3095fd5e0bc7a14cb8f46e060b7eeac4f3f08017b391556e6e066b11d9dff9fd94a71a4bf5335f259faa0e3339a6bdcddb08a930ef7a7b1c9789e0c5da7edfcdf18920652cea7328b538a0766a04c55154531368a62363dba9977f92039e940825696a35bfad5d0c990650913fb4b15c3f8e86a013963bd8fc8ff0e74e774c2aacb9075b18c04e542879c92f4de29a9479599c3b8dab035a895296319b4fc2fc21f01584d79a003979dcc97dcbbe1267af775f3ec256296d3413429c99d15c1f634be514e8ad3031932bd286414ce46f18b4e39d67ff829fc61490b96a85cd9f4a4dddfc51faec4943e2c55feaf30e0763d0893d48abf9dd8c5bd649b77bb302a58de92f50b04c06afee8099eed405a8e2ff34c45b018750318c9a99d0f19f696db6acd1d7b25d259636852a56ea9eb45f353519ebc0221fefaa59c6cb07864a6b6098bbcba2f86e09083a611d66389f7ee89ecb272cf089a2ad5bce1e03b9f3291afa5b257082324e69dd1162e92858a25a2100be899a99a5bfc25e9bdd0ac13d86071ee059db158847b1b4af1c5da679bdc260a6f7df9cd639ac99634f935905cb2f43d3020cdc05e2a34c10731d2054ca461a6769eb15a2f6ff371bab924404fe216c07210a20dcc637ff004549a3a9955ed1f918be7e32127f38b9bfade3f65089ae459f2131a3db5d5b4357c195c3619a8d32e86a35e92a54d97c21467bd733866fced1c1859ce7af7c257daba567c9e3aa10dee87f92f8b758402eda7c1a96de066cc84cedf6b3462c1cc4befd1907bfd1aa2095a165d1083f2456c53ec29ad1c918041c1f89a81c1781063baa16868442ba074410123469ba7bef553dd13611ed216d2409b29cfd45ca32a7e38a6a92e1782e0b6e866d9c14d44821d63ac7e29dd65fdfb4526e17678e5516a935339f9eccc450e73f095ab2c8e2aea6ddc042fbbdd54563b5ec47c7417f26cbfd0fbe65a722a3ab0a0b5903cd5132bc7d783f33c9dafffd1c4f6243a6c215f470ce918ed7ee2742840c33a828656604ce4539827904e20abe55fe455f1e880141f4b4368ebd669b2e682924181fb06d76d6f26e86d7c7bbb0d9b7c3334d2e0d584ab3f51dfbcbf662967d9d58613d3d5d29c0172ea0368d24ec5307ea646950f0380f179439052594ab87134a6667c3ee03b2a2979a48694d8b73f7bb7c5cb66bd073fbf6a2204c7b3a05ca084b36f37c217bda64af7db498c58c5f3040ab8546790cdffcd13ec7a4420b790dda6dde29461e3558c58eac3b0e866221d4e42f585b0ffe93fb55978b82efff72a77999651e4a9a3dd30cd7d6387b89fbcbf78abb6bc8e3d1fba4d8489c57ecf5aa3b7c6efc740519a4e67bb84bedafecf452860ea66ae4122c1492e09d0e3b44c2244ab91216585a87c1ec287793d50ae97d0c006d9e5d42f9dccf98f8bf7789c9ec0f3fec380fc2ab38925f521eea4fb70f009b4e1f8661f77a8b8bd1b45af305788d35a0454a04b50b5093935285bb431c279c790152e692b496a69456663768b454dea99af39cda6dff903941a2a83e4beaa944867f1000a3658d051abb8c5f1d2082d7f807ce5ae99f8dc1e919ee0de298b13986ad650ff79d18ec4d0cf8c0a95f5be0e1ea99620805ae4d3e6a8cc6c3b566380acc6924c738a24bfc81ef809a396562c8654675bd0e37f687b0831808742f86a8a68e431938868dcdfef624076ef959e825309a76832a5369894859805d6c907fbc09e9f6ea4441b09a74f2fe529a2927d32ea4e1568ab257af3852fd22b06929a3c3952aca810b2c7ad238322829247db16a064483f3700bafea872bd3b68d370c3e08b1fea767cb87e402868d4bca7561b6ec36a9c8dacdea328db99e381f1af5a28b821f3172cbb5c5bcb7af4d06f9bec1bce1c085061a5a91a7069a7beb309da8aad3e510b1fd695dde7311d7d74f73e6007592271fdcb5feaebdae7e6b964a2acaa6e8a76a3f38c2db2bea23d4bafe211bb1deb88811e6dd3fd3e1d2d9e09fb948752783843fb9519c05ce561127048c6bf75c98ea864c4b7841b8719cdcd85692f942ba8de0ed5e23f49f94941f0f7382f6e2ed92125e3a6f5c4759bbb8831a4e0547b1719cc9796a1daed78fa625c8a6d849a240530030b14ec0151ab304a23b3ff1030b6dd1f8976d5161f431cf4822e018ac2a251bbcfd2ee43e4682bb0b2c8833a6eef224de6ec371063e2a8c6c5db5e357bf1837ccaf0184d89420f5bcca9b818de0c028ec12c7d803042bdba05e12136dc317bbc8411d61c9ad490fe3263d87c49ea567c976acf05c3e29b1eb3dc10db7b10a2f61d7ae2cea2be63686ad469f9a81ff8b117fef09d53d9f2b4a21ceba6c3fff9873554339eddb9cf6686bf333a67fae05da46c3a74ad43a92dd5f5c16c700e5bc71ddb9f28e2fde9eaf085ad0ff61e03b4da872d4addc4af771d49fd691aab9337a118de7a09439d93007420291155acb1c21980c26c81b5641b0299996de5748b8bf7eef08ae3626f0b57a95b80fbcd1044f87834e19fa26e2204a5cef54fbbcfd45919be3609b22682c9cdf6ab79d89d3363129c3f92edaecb19624a1296d2f366bc052d71288971781007be4cf2689977bd66de050e76bacaca23c49f899f2108354664fb6c5ddbe7cf9ea85c6f50b53a6728c4978b4165718cce67ff8692659ce6ccae6d538a3608dcdbeae6d4fb340770c365334aca29f0de2eb8c879ae61d03d7a33705fe513edf3339920663f3447658b591ba855f00b02d10757b97ed2ea6c2624d48b1fd9b1ce650a5000bafe364b919c2436a6a43bacf4a600810f90475cdb09295e4c3e798fea87124b5481db75cf7a28e9d1512c6102489e7a6f65e6e64f626e0e4689b16900ba11a7f1c06a6646ed02674d04214e6dcd7bace9051e0f6aa94960b5f8c79a657ac7f222667bb3c2d465f22ed2dc8606a5d7b5af7ba75cdae5278f58f4fe0da6c69cb930977f26bb33aa25b010e1ccbfc9233f166b4eb4eaf6f170c85941f73ac28c803dff00f7baaa5e2e6b88ace3a78913896516dd1a14d3d2764aa24f7126e0000000000000000000000000000000000000000000000000000000000000030919dbf434004df87298401de1d131cd9306a7803b5b11d49bf932128e8ffb29666d7151a2d4f1b55104c39322b8fb30200000000000000000000000000000000")
 	assert.Nil(t, err)
@@ -2,10 +2,37 @@ package eth2
 
 import "fmt"
 
+// ForkSchedule maps the activation epoch of each scheduled fork to its fork version.
+type ForkSchedule struct {
+	GenesisVersion ForkVersion
+	Forks          map[uint64]ForkVersion
+}
+
+// ForkVersionAtEpoch returns the version of the latest fork activated at or before epoch, or the
+// genesis version before the first scheduled fork.
+func (fs *ForkSchedule) ForkVersionAtEpoch(epoch uint64) [4]byte {
+	version, activation := fs.GenesisVersion, uint64(0)
+	for forkEpoch, forkVersion := range fs.Forks {
+		if forkEpoch <= epoch && forkEpoch >= activation {
+			version, activation = forkVersion, forkEpoch
+		}
+	}
+	return version
+}
+
+// ForkVersionAtSlot returns the fork version active at slot under preset, nil meaning
+// MainnetPreset.
+func (fs *ForkSchedule) ForkVersionAtSlot(slot uint64, preset *Preset) [4]byte {
+	return fs.ForkVersionAtEpoch(orMainnet(preset).computeEpochAtSlot(slot))
+}
+
 type NetworkConfig struct {
 	GenesisValidatorsRoot [32]byte
-	BellatrixForkVersion  ForkVersion
-	BellatrixForkEpoch    uint64
+	ForkSchedule          ForkSchedule
+	Preset                Preset
+	// BellatrixForkEpoch is the first epoch light client updates are accepted for, as they
+	// carry execution payload proofs.
+	BellatrixForkEpoch uint64
 }
 
 func newNetworkConfig(chainID uint64) (*NetworkConfig, error) {
@@ -17,8 +44,15 @@ func newNetworkConfig(chainID uint64) (*NetworkConfig, error) {
 				0x0f, 0xdd, 0x4e, 0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a,
 				0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
 			},
-			BellatrixForkVersion: [4]byte{0x02, 0x00, 0x00, 0x00},
-			BellatrixForkEpoch:   144896,
+			ForkSchedule: ForkSchedule{
+				GenesisVersion: [4]byte{0x00, 0x00, 0x00, 0x00},
+				Forks: map[uint64]ForkVersion{
+					74240:  {0x01, 0x00, 0x00, 0x00}, // Altair
+					144896: {0x02, 0x00, 0x00, 0x00}, // Bellatrix
+				},
+			},
+			BellatrixForkEpoch: 144896,
+			Preset:             MainnetPreset,
 		}, nil
 	case 5: // Goerli
 		return &NetworkConfig{
@@ -27,24 +61,51 @@ func newNetworkConfig(chainID uint64) (*NetworkConfig, error) {
 				0xd2, 0x37, 0x97, 0x75, 0x7d, 0x43, 0x09, 0x11, 0xa9, 0x32, 0x05, 0x30, 0xad,
 				0x8a, 0x0e, 0xab, 0xc4, 0x3e, 0xfb,
 			},
-			BellatrixForkVersion: [4]byte{0x02, 0x00, 0x10, 0x20},
-			BellatrixForkEpoch:   112260,
+			ForkSchedule: ForkSchedule{
+				GenesisVersion: [4]byte{0x00, 0x00, 0x10, 0x20},
+				Forks: map[uint64]ForkVersion{
+					36660:  {0x01, 0x00, 0x10, 0x20}, // Altair
+					112260: {0x02, 0x00, 0x10, 0x20}, // Bellatrix
+				},
+			},
+			BellatrixForkEpoch: 112260,
+			Preset:             MainnetPreset,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported network chain ID %d", chainID)
 	}
 }
 
-// Return the fork version at the given epoch
+// supportedChainIDs lists the chain IDs newNetworkConfig has a configuration for.
+var supportedChainIDs = []uint64{1, 5}
+
+// networkConfigByGenesisValidatorsRoot returns the configuration of the supported network with
+// the given genesis validators root.
+func networkConfigByGenesisValidatorsRoot(root [32]byte) (*NetworkConfig, error) {
+	for _, chainID := range supportedChainIDs {
+		config, err := newNetworkConfig(chainID)
+		if err != nil {
+			return nil, err
+		}
+		if config.GenesisValidatorsRoot == root {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported network genesis validators root %#x", root)
+}
+
+// Return the fork version at the given epoch, or nil if light client updates are not supported
+// at that epoch
 func (nc *NetworkConfig) computeForkVersion(epoch uint64) *ForkVersion {
-	if epoch >= nc.BellatrixForkEpoch {
-		return &nc.BellatrixForkVersion
+	if epoch < nc.BellatrixForkEpoch {
+		return nil
 	}
 
-	return nil
+	version := ForkVersion(nc.ForkSchedule.ForkVersionAtEpoch(epoch))
+	return &version
 }
 
 // Return the fork version at the given epoch
 func (nc *NetworkConfig) computeForkVersionBySlot(slot uint64) *ForkVersion {
-	return nc.computeForkVersion(computeEpochAtSlot(slot))
+	return nc.computeForkVersion(nc.Preset.computeEpochAtSlot(slot))
 }
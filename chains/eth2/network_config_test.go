@@ -0,0 +1,83 @@
+package eth2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkSchedule_ForkVersionAtSlot(t *testing.T) {
+	config, err := newNetworkConfig(1)
+	require.NoError(t, err)
+	schedule := config.ForkSchedule
+
+	altairSlot := 74240 * SlotsPerEpoch
+	bellatrixSlot := 144896 * SlotsPerEpoch
+	tests := []struct {
+		name string
+		slot uint64
+		want [4]byte
+	}{
+		{name: "Genesis", slot: 0, want: [4]byte{0x00, 0x00, 0x00, 0x00}},
+		{name: "LastPhase0Slot", slot: altairSlot - 1, want: [4]byte{0x00, 0x00, 0x00, 0x00}},
+		{name: "FirstAltairSlot", slot: altairSlot, want: [4]byte{0x01, 0x00, 0x00, 0x00}},
+		{name: "LastAltairSlot", slot: bellatrixSlot - 1, want: [4]byte{0x01, 0x00, 0x00, 0x00}},
+		{name: "FirstBellatrixSlot", slot: bellatrixSlot, want: [4]byte{0x02, 0x00, 0x00, 0x00}},
+		{name: "FixtureSignatureSlot", slot: update.signatureSlot, want: [4]byte{0x02, 0x00, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schedule.ForkVersionAtSlot(tt.slot, nil))
+		})
+	}
+}
+
+func TestForkSchedule_DomainChangesAtForkBoundary(t *testing.T) {
+	config, err := newNetworkConfig(1)
+	require.NoError(t, err)
+	bellatrixSlot := 144896 * SlotsPerEpoch
+
+	before, err := ComputeDomain(DomainSyncCommittee, config.ForkSchedule.ForkVersionAtSlot(bellatrixSlot-1, nil), config.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	after, err := ComputeDomain(DomainSyncCommittee, config.ForkSchedule.ForkVersionAtSlot(bellatrixSlot, nil), config.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.NotEqual(t, before, after)
+	assert.Equal(t, DomainSyncCommittee[:], after[:4])
+
+	// Light client updates are only accepted from bellatrix on.
+	assert.Nil(t, config.computeForkVersionBySlot(bellatrixSlot-1))
+	require.NotNil(t, config.computeForkVersionBySlot(bellatrixSlot))
+	assert.Equal(t, ForkVersion{0x02, 0x00, 0x00, 0x00}, *config.computeForkVersionBySlot(bellatrixSlot))
+}
+
+func TestForkSchedule_NoForks(t *testing.T) {
+	schedule := ForkSchedule{GenesisVersion: [4]byte{0x00, 0x00, 0x10, 0x20}}
+	assert.Equal(t, [4]byte{0x00, 0x00, 0x10, 0x20}, schedule.ForkVersionAtSlot(0, nil))
+	assert.Equal(t, [4]byte{0x00, 0x00, 0x10, 0x20}, schedule.ForkVersionAtSlot(1<<40, nil))
+}
+
+func TestForkSchedule_ForkAtGenesis(t *testing.T) {
+	schedule := ForkSchedule{
+		GenesisVersion: [4]byte{0x00, 0x00, 0x00, 0x01},
+		Forks: map[uint64]ForkVersion{
+			0:  {0x01, 0x00, 0x00, 0x01},
+			10: {0x02, 0x00, 0x00, 0x01},
+		},
+	}
+	assert.Equal(t, [4]byte{0x01, 0x00, 0x00, 0x01}, schedule.ForkVersionAtEpoch(0))
+	assert.Equal(t, [4]byte{0x01, 0x00, 0x00, 0x01}, schedule.ForkVersionAtEpoch(9))
+	assert.Equal(t, [4]byte{0x02, 0x00, 0x00, 0x01}, schedule.ForkVersionAtEpoch(10))
+}
+
+func TestForkSchedule_ForkVersionAtSlot_MinimalPreset(t *testing.T) {
+	schedule := ForkSchedule{
+		GenesisVersion: [4]byte{0x00, 0x00, 0x00, 0x01},
+		Forks:          map[uint64]ForkVersion{10: {0x01, 0x00, 0x00, 0x01}},
+	}
+	// Epoch 10 starts at slot 80 with 8 slots per epoch and at slot 320 with 32.
+	slot := 10 * MinimalPreset.SlotsPerEpoch
+	assert.Equal(t, [4]byte{0x01, 0x00, 0x00, 0x01}, schedule.ForkVersionAtSlot(slot, &MinimalPreset))
+	assert.Equal(t, [4]byte{0x00, 0x00, 0x00, 0x01}, schedule.ForkVersionAtSlot(slot, nil))
+	assert.Equal(t, [4]byte{0x00, 0x00, 0x00, 0x01}, schedule.ForkVersionAtSlot(slot-1, &MinimalPreset))
+}
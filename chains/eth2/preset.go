@@ -0,0 +1,68 @@
+package eth2
+
+import "math/bits"
+
+// Preset holds the values of a consensus spec preset that the light client depends on. Mainnet
+// and the public testnets use MainnetPreset, local devnets often use MinimalPreset.
+type Preset struct {
+	SyncCommitteeSize            uint64
+	EpochsPerSyncCommitteePeriod uint64
+	SlotsPerEpoch                uint64
+}
+
+// MainnetPreset is the mainnet preset, matching the SyncCommitteeSize,
+// EpochsPerSyncCommitteePeriod and SlotsPerEpoch constants.
+var MainnetPreset = Preset{
+	SyncCommitteeSize:            SyncCommitteeSize,
+	EpochsPerSyncCommitteePeriod: EpochsPerSyncCommitteePeriod,
+	SlotsPerEpoch:                SlotsPerEpoch,
+}
+
+// MinimalPreset is the minimal preset of the consensus spec tests and of minimal devnets.
+var MinimalPreset = Preset{
+	SyncCommitteeSize:            32,
+	EpochsPerSyncCommitteePeriod: 8,
+	SlotsPerEpoch:                8,
+}
+
+// SyncCommitteePeriodAtSlot returns the sync committee period that slot belongs to under preset,
+// nil meaning MainnetPreset. Period n covers the slots from n*EpochsPerSyncCommitteePeriod*SlotsPerEpoch
+// up to the first slot of period n+1, so slot 0 is in period 0.
+func SyncCommitteePeriodAtSlot(slot uint64, preset *Preset) uint64 {
+	return SyncCommitteePeriodAtEpoch(orMainnet(preset).computeEpochAtSlot(slot), preset)
+}
+
+// SyncCommitteePeriodAtEpoch returns the sync committee period that epoch belongs to under preset,
+// nil meaning MainnetPreset.
+func SyncCommitteePeriodAtEpoch(epoch uint64, preset *Preset) uint64 {
+	return epoch / orMainnet(preset).EpochsPerSyncCommitteePeriod
+}
+
+// SyncCommitteePubkeyDepth returns the depth of a member public key under the root of a sync
+// committee of preset, nil meaning MainnetPreset: the SyncCommitteeSize keys vector, padded to a
+// power of two, below the first of the committee's two fields. That is 10 under MainnetPreset and
+// 6 under MinimalPreset.
+func SyncCommitteePubkeyDepth(preset *Preset) uint64 {
+	return 1 + uint64(bits.Len64(orMainnet(preset).SyncCommitteeSize-1))
+}
+
+func orMainnet(preset *Preset) *Preset {
+	if preset == nil {
+		return &MainnetPreset
+	}
+	return preset
+}
+
+func (p *Preset) computeEpochAtSlot(slot uint64) uint64 {
+	return slot / p.SlotsPerEpoch
+}
+
+func (p *Preset) computeSyncCommitteePeriod(slot uint64) uint64 {
+	return SyncCommitteePeriodAtSlot(slot, p)
+}
+
+// syncCommitteeSSZSize is the size of an SSZ encoded sync committee of the preset, the member
+// public keys followed by the aggregate public key.
+func (p *Preset) syncCommitteeSSZSize() int {
+	return int(p.SyncCommitteeSize+1) * BLSPubkeyLength
+}
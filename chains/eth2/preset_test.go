@@ -0,0 +1,50 @@
+package eth2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCommitteePeriodAtSlot(t *testing.T) {
+	tests := []struct {
+		name   string
+		preset *Preset
+		slot   uint64
+		period uint64
+	}{
+		{name: "mainnet genesis", preset: &MainnetPreset, slot: 0, period: 0},
+		{name: "mainnet last slot of period 0", preset: &MainnetPreset, slot: 8191, period: 0},
+		{name: "mainnet first slot of period 1", preset: &MainnetPreset, slot: 8192, period: 1},
+		{name: "mainnet last slot of period 620", preset: &MainnetPreset, slot: 621*8192 - 1, period: 620},
+		{name: "mainnet first slot of period 621", preset: &MainnetPreset, slot: 621 * 8192, period: 621},
+		{name: "nil is mainnet", preset: nil, slot: 8192, period: 1},
+		{name: "minimal genesis", preset: &MinimalPreset, slot: 0, period: 0},
+		{name: "minimal last slot of period 0", preset: &MinimalPreset, slot: 63, period: 0},
+		{name: "minimal first slot of period 1", preset: &MinimalPreset, slot: 64, period: 1},
+		{name: "minimal last slot of period 620", preset: &MinimalPreset, slot: 621*64 - 1, period: 620},
+		{name: "minimal first slot of period 621", preset: &MinimalPreset, slot: 621 * 64, period: 621},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.period, SyncCommitteePeriodAtSlot(tt.slot, tt.preset))
+			assert.Equal(t, tt.period, orMainnet(tt.preset).computeSyncCommitteePeriod(tt.slot))
+		})
+	}
+}
+
+func TestSyncCommitteePeriodAtEpoch(t *testing.T) {
+	assert.Equal(t, uint64(0), SyncCommitteePeriodAtEpoch(0, &MainnetPreset))
+	assert.Equal(t, uint64(0), SyncCommitteePeriodAtEpoch(255, &MainnetPreset))
+	assert.Equal(t, uint64(1), SyncCommitteePeriodAtEpoch(256, &MainnetPreset))
+	assert.Equal(t, uint64(1), SyncCommitteePeriodAtEpoch(256, nil))
+	assert.Equal(t, uint64(0), SyncCommitteePeriodAtEpoch(7, &MinimalPreset))
+	assert.Equal(t, uint64(1), SyncCommitteePeriodAtEpoch(8, &MinimalPreset))
+
+	// The period of a slot is the period of its epoch.
+	for _, preset := range []*Preset{&MainnetPreset, &MinimalPreset} {
+		for _, slot := range []uint64{0, preset.SlotsPerEpoch - 1, preset.SlotsPerEpoch, preset.SlotsPerEpoch * preset.EpochsPerSyncCommitteePeriod} {
+			assert.Equal(t, SyncCommitteePeriodAtEpoch(preset.computeEpochAtSlot(slot), preset), SyncCommitteePeriodAtSlot(slot, preset))
+		}
+	}
+}
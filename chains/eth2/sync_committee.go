@@ -0,0 +1,210 @@
+package eth2
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bls "github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// SyncCommitteeSize is the number of members of a mainnet sync committee.
+const SyncCommitteeSize = 512
+
+// SizeSSZ returns the size of the SSZ encoded sync committee of the mainnet preset.
+func (c *SyncCommittee) SizeSSZ() int {
+	return MainnetPreset.syncCommitteeSSZSize()
+}
+
+// MarshalSSZ ssz marshals the SyncCommittee object of the mainnet preset
+func (c *SyncCommittee) MarshalSSZ() ([]byte, error) {
+	return c.MarshalSSZWithPreset(&MainnetPreset)
+}
+
+// MarshalSSZWithPreset ssz marshals the SyncCommittee object of the given preset
+func (c *SyncCommittee) MarshalSSZWithPreset(preset *Preset) ([]byte, error) {
+	return c.marshalSSZTo(make([]byte, 0, preset.syncCommitteeSSZSize()), preset)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommittee object of the mainnet preset to a target array
+func (c *SyncCommittee) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return c.marshalSSZTo(buf, &MainnetPreset)
+}
+
+func (c *SyncCommittee) marshalSSZTo(buf []byte, preset *Preset) ([]byte, error) {
+	// Field (0) 'Pubkeys'
+	if size := len(c.Pubkeys); size != int(preset.SyncCommitteeSize) {
+		return nil, ssz.ErrVectorLengthFn("--.Pubkeys", size, int(preset.SyncCommitteeSize))
+	}
+	for i, pubkey := range c.Pubkeys {
+		if size := len(pubkey); size != BLSPubkeyLength {
+			return nil, ssz.ErrBytesLengthFn(fmt.Sprintf("--.Pubkeys[%d]", i), size, BLSPubkeyLength)
+		}
+		buf = append(buf, pubkey...)
+	}
+
+	// Field (1) 'AggregatePubkey'
+	if size := len(c.AggregatePubkey); size != BLSPubkeyLength {
+		return nil, ssz.ErrBytesLengthFn("--.AggregatePubkey", size, BLSPubkeyLength)
+	}
+	return append(buf, c.AggregatePubkey...), nil
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommittee object of the mainnet preset. Every member
+// public key must be a valid key, and the aggregate public key must be the aggregate of the
+// members.
+func (c *SyncCommittee) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithPreset(buf, &MainnetPreset)
+}
+
+// UnmarshalSSZWithPreset ssz unmarshals the SyncCommittee object of the given preset, checking
+// it like UnmarshalSSZ.
+func (c *SyncCommittee) UnmarshalSSZWithPreset(buf []byte, preset *Preset) error {
+	if size := preset.syncCommitteeSSZSize(); len(buf) != size {
+		return fmt.Errorf("%w: sync committee must be %d bytes, got %d", ssz.ErrSize, size, len(buf))
+	}
+
+	pubkeys := make([][]byte, preset.SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = append([]byte(nil), buf[i*BLSPubkeyLength:(i+1)*BLSPubkeyLength]...)
+	}
+	aggregatePubkey := append([]byte(nil), buf[len(pubkeys)*BLSPubkeyLength:]...)
+
+	members, err := bls.PublicKeysFromBytes(pubkeys)
+	if err != nil {
+		return fmt.Errorf("invalid sync committee member: %v", err)
+	}
+	if err := verifySyncCommitteeAggregate(members, aggregatePubkey); err != nil {
+		return err
+	}
+
+	c.Pubkeys = pubkeys
+	c.AggregatePubkey = aggregatePubkey
+	return nil
+}
+
+// verifySyncCommitteeAggregate checks that aggregatePubkey is a valid key and the aggregate of
+// the decoded members of a committee.
+func verifySyncCommitteeAggregate(members []bls.PublicKey, aggregatePubkey []byte) error {
+	aggregate, err := bls.PublicKeyFromBytes(aggregatePubkey)
+	if err != nil {
+		return fmt.Errorf("invalid sync committee aggregate public key: %v", err)
+	}
+	expected, err := bls.AggregateMultiplePubkeys(members)
+	if err != nil {
+		return fmt.Errorf("aggregate sync committee public keys failed: %v", err)
+	}
+	if !aggregate.Equals(expected) {
+		return fmt.Errorf("sync committee aggregate public key %#x is not the aggregate of its members %#x",
+			aggregatePubkey, expected.Marshal())
+	}
+	return nil
+}
+
+// HashTreeRoot ssz hashes the SyncCommittee object
+func (c *SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	return SyncCommitteeRoot(c)
+}
+
+// MarshalCompact encodes the SyncCommittee object of the mainnet preset with each distinct key
+// stored once, as the same validator often holds several seats of a committee. The encoding is
+// the uint16 number of distinct keys, the distinct keys in the order of their first seat, a
+// uint16 index into them for each of the SyncCommitteeSize seats, and the aggregate public key,
+// integers little-endian like SSZ.
+func (c *SyncCommittee) MarshalCompact() ([]byte, error) {
+	if size := len(c.Pubkeys); size != SyncCommitteeSize {
+		return nil, ssz.ErrVectorLengthFn("--.Pubkeys", size, SyncCommitteeSize)
+	}
+	var unique [][]byte
+	seats := make([]uint16, len(c.Pubkeys))
+	index := make(map[string]uint16)
+	for i, pubkey := range c.Pubkeys {
+		if size := len(pubkey); size != BLSPubkeyLength {
+			return nil, ssz.ErrBytesLengthFn(fmt.Sprintf("--.Pubkeys[%d]", i), size, BLSPubkeyLength)
+		}
+		j, ok := index[string(pubkey)]
+		if !ok {
+			j = uint16(len(unique))
+			index[string(pubkey)] = j
+			unique = append(unique, pubkey)
+		}
+		seats[i] = j
+	}
+	if size := len(c.AggregatePubkey); size != BLSPubkeyLength {
+		return nil, ssz.ErrBytesLengthFn("--.AggregatePubkey", size, BLSPubkeyLength)
+	}
+
+	buf := make([]byte, 2, compactSyncCommitteeSize(len(unique)))
+	binary.LittleEndian.PutUint16(buf, uint16(len(unique)))
+	for _, pubkey := range unique {
+		buf = append(buf, pubkey...)
+	}
+	for _, j := range seats {
+		buf = append(buf, byte(j), byte(j>>8))
+	}
+	return append(buf, c.AggregatePubkey...), nil
+}
+
+// UnmarshalCompact decodes a SyncCommittee object of the mainnet preset encoded by
+// MarshalCompact, checking it like UnmarshalSSZ. Every distinct key must be a valid key used by
+// some seat, in the order of the first seat using it, so that an encoding is only accepted in the
+// form MarshalCompact writes it.
+func (c *SyncCommittee) UnmarshalCompact(buf []byte) error {
+	if len(buf) < 2 {
+		return fmt.Errorf("%w: compact sync committee must be at least 2 bytes, got %d", ssz.ErrSize, len(buf))
+	}
+	n := int(binary.LittleEndian.Uint16(buf))
+	if n == 0 || n > SyncCommitteeSize {
+		return fmt.Errorf("compact sync committee has %d distinct keys, want 1 to %d", n, SyncCommitteeSize)
+	}
+	if size := compactSyncCommitteeSize(n); len(buf) != size {
+		return fmt.Errorf("%w: compact sync committee of %d distinct keys must be %d bytes, got %d", ssz.ErrSize, n, size, len(buf))
+	}
+
+	unique := make([][]byte, n)
+	seen := make(map[string]struct{}, n)
+	for i := range unique {
+		unique[i] = buf[2+i*BLSPubkeyLength : 2+(i+1)*BLSPubkeyLength]
+		if _, ok := seen[string(unique[i])]; ok {
+			return fmt.Errorf("compact sync committee key %d is repeated", i)
+		}
+		seen[string(unique[i])] = struct{}{}
+	}
+	keys, err := bls.PublicKeysFromBytes(unique)
+	if err != nil {
+		return fmt.Errorf("invalid sync committee member: %v", err)
+	}
+
+	seats := buf[2+n*BLSPubkeyLength:]
+	pubkeys := make([][]byte, SyncCommitteeSize)
+	members := make([]bls.PublicKey, SyncCommitteeSize)
+	next := 0
+	for i := range pubkeys {
+		j := int(binary.LittleEndian.Uint16(seats[2*i:]))
+		if j > next || j >= n {
+			return fmt.Errorf("compact sync committee seat %d has key %d, want at most %d", i, j, next)
+		}
+		if j == next {
+			next++
+		}
+		pubkeys[i] = append([]byte(nil), unique[j]...)
+		members[i] = keys[j]
+	}
+	if next != n {
+		return fmt.Errorf("compact sync committee key %d is not used by any seat", next)
+	}
+	aggregatePubkey := append([]byte(nil), seats[2*SyncCommitteeSize:]...)
+	if err := verifySyncCommitteeAggregate(members, aggregatePubkey); err != nil {
+		return err
+	}
+
+	c.Pubkeys = pubkeys
+	c.AggregatePubkey = aggregatePubkey
+	return nil
+}
+
+// compactSyncCommitteeSize returns the size of the compact encoding of a mainnet sync committee
+// with n distinct keys.
+func compactSyncCommitteeSize(n int) int {
+	return 2 + n*BLSPubkeyLength + 2*SyncCommitteeSize + BLSPubkeyLength
+}
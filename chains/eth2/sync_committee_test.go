@@ -0,0 +1,179 @@
+package eth2
+
+import (
+	"errors"
+	"testing"
+
+	bls "github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommittee_SSZ(t *testing.T) {
+	// The mainnet committee of period 621, proven by the fixture update against the state root
+	// of its finalized header.
+	enc, err := update.nextSyncCommittee.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, enc, update.nextSyncCommittee.SizeSSZ())
+
+	var decoded SyncCommittee
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.Equal(t, update.nextSyncCommittee, decoded)
+
+	root, err := decoded.HashTreeRoot()
+	require.NoError(t, err)
+	var stateRoot [32]byte
+	copy(stateRoot[:], update.finalizedHeader.StateRoot)
+	assert.Equal(t, true, VerifyMerkleBranch(root, toBranch(update.nextSyncCommitteeBranch), NextSyncCommitteeDepth, NextSyncCommitteeSubtreeIndex, stateRoot))
+
+	reencoded, err := decoded.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, enc, reencoded)
+}
+
+func TestSyncCommittee_UnmarshalSSZ_Invalid(t *testing.T) {
+	enc, err := update.nextSyncCommittee.MarshalSSZ()
+	require.NoError(t, err)
+
+	var c SyncCommittee
+	err = c.UnmarshalSSZ(enc[:len(enc)-1])
+	assert.True(t, errors.Is(err, ssz.ErrSize))
+
+	// The aggregate public key of another committee.
+	wrongAggregate := append([]byte(nil), enc...)
+	copy(wrongAggregate[SyncCommitteeSize*BLSPubkeyLength:], state.currentSyncCommittee.AggregatePubkey)
+	err = c.UnmarshalSSZ(wrongAggregate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not the aggregate of its members")
+
+	// A member with the compression flag cleared.
+	badMember := append([]byte(nil), enc...)
+	badMember[3*BLSPubkeyLength] &^= 0x80
+	err = c.UnmarshalSSZ(badMember)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index 3")
+
+	assert.Equal(t, SyncCommittee{}, c, "Failed decoding modified the committee")
+}
+
+func TestSyncCommittee_MarshalSSZ_Invalid(t *testing.T) {
+	short := SyncCommittee{
+		Pubkeys:         update.nextSyncCommittee.Pubkeys[1:],
+		AggregatePubkey: update.nextSyncCommittee.AggregatePubkey,
+	}
+	_, err := short.MarshalSSZ()
+	assert.Error(t, err)
+
+	noAggregate := SyncCommittee{Pubkeys: update.nextSyncCommittee.Pubkeys}
+	_, err = noAggregate.MarshalSSZ()
+	assert.Error(t, err)
+}
+
+func TestSyncCommittee_SSZWithPreset(t *testing.T) {
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	enc, err := c.committee.MarshalSSZWithPreset(&MinimalPreset)
+	require.NoError(t, err)
+	require.Len(t, enc, 33*BLSPubkeyLength)
+
+	var decoded SyncCommittee
+	require.NoError(t, decoded.UnmarshalSSZWithPreset(enc, &MinimalPreset))
+	assert.Equal(t, c.committee, decoded)
+
+	// A minimal committee is not a mainnet one, either way round.
+	err = decoded.UnmarshalSSZ(enc)
+	assert.True(t, errors.Is(err, ssz.ErrSize))
+	_, err = c.committee.MarshalSSZ()
+	assert.Error(t, err)
+	mainnet, err := update.nextSyncCommittee.MarshalSSZ()
+	require.NoError(t, err)
+	err = decoded.UnmarshalSSZWithPreset(mainnet, &MinimalPreset)
+	assert.True(t, errors.Is(err, ssz.ErrSize))
+}
+
+func TestSyncCommittee_Compact(t *testing.T) {
+	// A committee of 16 validators holding 32 seats each.
+	distinct := newSyntheticCommitteeWithPreset(t, &MinimalPreset).committee.Pubkeys[:16]
+	committee := repeatedSyncCommittee(t, func(i int) []byte { return distinct[(i*7)%len(distinct)] })
+
+	enc, err := committee.MarshalCompact()
+	require.NoError(t, err)
+	assert.Equal(t, 2+17*BLSPubkeyLength+2*SyncCommitteeSize, len(enc))
+	assert.Less(t, len(enc), committee.SizeSSZ()/10)
+
+	var decoded SyncCommittee
+	require.NoError(t, decoded.UnmarshalCompact(enc))
+	assert.Equal(t, committee, decoded)
+	reencoded, err := decoded.MarshalCompact()
+	require.NoError(t, err)
+	assert.Equal(t, enc, reencoded)
+
+	// A committee without repeated keys still round-trips, at a little more than its SSZ size.
+	enc, err = update.nextSyncCommittee.MarshalCompact()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalCompact(enc))
+	assert.Equal(t, update.nextSyncCommittee, decoded)
+}
+
+func TestSyncCommittee_UnmarshalCompact_Invalid(t *testing.T) {
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	n := len(c.committee.Pubkeys)
+	committee := repeatedSyncCommittee(t, func(i int) []byte { return c.committee.Pubkeys[i%n] })
+	enc, err := committee.MarshalCompact()
+	require.NoError(t, err)
+	var valid SyncCommittee
+	require.NoError(t, valid.UnmarshalCompact(enc))
+	seat := func(i int) int { return 2 + n*BLSPubkeyLength + 2*i }
+	modify := func(f func(b []byte)) []byte {
+		b := append([]byte(nil), enc...)
+		f(b)
+		return b
+	}
+
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{name: "Short", input: enc[:len(enc)-1], err: "must be"},
+		{name: "NoKeys", input: modify(func(b []byte) { b[0], b[1] = 0, 0 }), err: "has 0 distinct keys"},
+		{name: "TooManyKeys", input: modify(func(b []byte) { b[0], b[1] = 0x01, 0x02 }), err: "has 513 distinct keys"},
+		{name: "SeatOutOfRange", input: modify(func(b []byte) { b[seat(SyncCommitteeSize-1)] = byte(n) }), err: "seat 511 has key 32"},
+		{name: "SeatOutOfOrder", input: modify(func(b []byte) { b[seat(0)] = 1 }), err: "seat 0 has key 1, want at most 0"},
+		{name: "UnusedKey", input: modify(func(b []byte) {
+			for i := n - 1; i < SyncCommitteeSize; i += n {
+				b[seat(i)] = 0
+			}
+		}), err: "key 31 is not used"},
+		{name: "RepeatedKey", input: modify(func(b []byte) { copy(b[2+BLSPubkeyLength:], b[2:2+BLSPubkeyLength]) }), err: "key 1 is repeated"},
+		{name: "BadKey", input: modify(func(b []byte) { b[2+3*BLSPubkeyLength] &^= 0x80 }), err: "index 3"},
+		{name: "WrongAggregate", input: modify(func(b []byte) { copy(b[len(b)-BLSPubkeyLength:], c.committee.Pubkeys[0]) }), err: "is not the aggregate of its members"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded SyncCommittee
+			err := decoded.UnmarshalCompact(tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+			assert.Equal(t, SyncCommittee{}, decoded, "Failed decoding modified the committee")
+		})
+	}
+	var decoded SyncCommittee
+	assert.True(t, errors.Is(decoded.UnmarshalCompact(enc[:1]), ssz.ErrSize))
+}
+
+// repeatedSyncCommittee returns the mainnet sized committee with the key pubkey(i) in seat i.
+func repeatedSyncCommittee(t *testing.T, pubkey func(i int) []byte) SyncCommittee {
+	var committee SyncCommittee
+	members := make([]bls.PublicKey, SyncCommitteeSize)
+	for i := range members {
+		committee.Pubkeys = append(committee.Pubkeys, pubkey(i))
+		var err error
+		members[i], err = bls.PublicKeyFromBytes(committee.Pubkeys[i])
+		require.NoError(t, err)
+	}
+	aggregate, err := bls.AggregateMultiplePubkeys(members)
+	require.NoError(t, err)
+	committee.AggregatePubkey = aggregate.Marshal()
+	return committee
+}
@@ -43,6 +43,19 @@ type LightClientState struct {
 	chainID              uint64
 }
 
+type LightClientBootstrap struct {
+	// Header of the trusted block root the light client starts from
+	header BeaconBlockHeader
+	// Current sync committee corresponding to `header.state_root`
+	currentSyncCommittee       SyncCommittee
+	currentSyncCommitteeBranch [][]byte
+	chainID                    uint64
+	// Sync aggregate of the current sync committee over `header`, if the bootstrap was served
+	// with one. A bootstrap without participants is not signature checked.
+	syncAggregate SyncAggregate
+	signatureSlot uint64
+}
+
 type LightClientVerify struct {
 	update *LightClientUpdate
 	state  *LightClientState
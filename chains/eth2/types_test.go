@@ -0,0 +1,51 @@
+package eth2
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlockHeader_HashTreeRoot(t *testing.T) {
+	// Mainnet genesis block header, whose root is the genesis block root.
+	genesis := BeaconBlockHeader{
+		Slot:          0,
+		ProposerIndex: 0,
+		ParentRoot:    make([]byte, 32),
+		StateRoot:     common.FromHex("0x7e76880eb67bbdc86250aa578958e9d0675e64e714337855204fb5abaaf82c2b"),
+		BodyRoot:      common.FromHex("0xccb62460692be0ec813b56be97f68a82cf57abc102e27bf49ebf4190ff22eedd"),
+	}
+	root, err := genesis.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, common.FromHex("0x4d611d5b93fdab69013a7f0a2f961caca0c853f87cfe9595fe50038163079360"), root[:])
+
+	// Swapping two roots or the slot and proposer index changes the root.
+	swapped := genesis
+	swapped.StateRoot, swapped.BodyRoot = genesis.BodyRoot, genesis.StateRoot
+	other, err := swapped.HashTreeRoot()
+	require.NoError(t, err)
+	assert.NotEqual(t, root, other)
+
+	header := update.attestedHeader
+	swapped = header
+	swapped.Slot, swapped.ProposerIndex = uint64(header.ProposerIndex), ValidatorIndex(header.Slot)
+	root, err = header.HashTreeRoot()
+	require.NoError(t, err)
+	other, err = swapped.HashTreeRoot()
+	require.NoError(t, err)
+	assert.NotEqual(t, root, other)
+}
+
+func TestBeaconBlockHeader_HashTreeRootInvalidRoot(t *testing.T) {
+	header := update.attestedHeader
+	header.BodyRoot = header.BodyRoot[:31]
+	_, err := header.HashTreeRoot()
+	assert.Error(t, err)
+
+	header = update.attestedHeader
+	header.ParentRoot = nil
+	_, err = header.HashTreeRoot()
+	assert.Error(t, err)
+}
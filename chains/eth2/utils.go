@@ -34,18 +34,20 @@ func decodeLightClientVerify(input []byte) (*LightClientVerify, error) {
 	return verify.toLightClientVerify(), nil
 }
 
-func computeEpochAtSlot(slot uint64) uint64 {
-	return slot / SlotsPerEpoch
-}
-
-func computeSyncCommitteePeriod(slot uint64) uint64 {
-	return computeEpochAtSlot(slot) / EpochsPerSyncCommitteePeriod
-}
-
-func getParticipantPubkeys(public_keys [][]byte, sync_committee_bits bitfield.Bitvector512) ([]bls2.PublicKey, error) {
+// getParticipantPubkeys deserializes the public keys of the members whose bit is set. The
+// committee must have the preset's size. The bits are read directly rather than through
+// Bitvector512, whose accessors only work on a mainnet sized bitfield.
+func getParticipantPubkeys(preset *Preset, public_keys [][]byte, sync_committee_bits bitfield.Bitvector512) ([]bls2.PublicKey, error) {
+	size := preset.SyncCommitteeSize
+	if uint64(len(public_keys)) != size {
+		return nil, fmt.Errorf("sync committee has %d members, preset requires %d", len(public_keys), size)
+	}
+	if uint64(len(sync_committee_bits))*8 < size {
+		return nil, fmt.Errorf("sync committee bits cover %d members, but committee has %d", len(sync_committee_bits)*8, size)
+	}
 	var pubkeys []bls2.PublicKey
-	for i := uint64(0); i < sync_committee_bits.Len(); i++ {
-		if sync_committee_bits.BitAt(i) {
+	for i := uint64(0); i < size; i++ {
+		if sync_committee_bits[i/8]&(1<<(i%8)) != 0 {
 
 			pubKey, err := bls2.PublicKeyFromBytes(public_keys[i])
 			if err != nil {
@@ -57,6 +59,47 @@ func getParticipantPubkeys(public_keys [][]byte, sync_committee_bits bitfield.Bi
 	return pubkeys, nil
 }
 
+// SelectParticipants returns the members of committee whose bit is set in bits, in committee
+// order. Bits follow the SSZ bitvector convention: member i is bit i%8 of byte i/8, counting
+// from the least significant bit. The committee may be of any preset's size.
+func SelectParticipants(committee []bls2.PublicKey, bits []byte) ([]bls2.PublicKey, error) {
+	if len(bits)*8 < len(committee) {
+		return nil, fmt.Errorf("sync committee bits cover %d members, but committee has %d", len(bits)*8, len(committee))
+	}
+
+	var participants []bls2.PublicKey
+	for i, pub := range committee {
+		if bits[i/8]&(1<<uint(i%8)) != 0 {
+			participants = append(participants, pub)
+		}
+	}
+	if len(participants) == 0 {
+		return nil, fmt.Errorf("no sync committee participants")
+	}
+	return participants, nil
+}
+
+// HasSupermajorityParticipation reports whether at least ceil(2*committeeSize/3) of the first
+// committeeSize bits are set. Bitfields longer than the committee are accepted as long as the
+// bits past the committee are zero.
+func HasSupermajorityParticipation(bits []byte, committeeSize int) bool {
+	if committeeSize <= 0 || len(bits)*8 < committeeSize {
+		return false
+	}
+
+	count := 0
+	for i := 0; i < len(bits)*8; i++ {
+		if bits[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= committeeSize {
+			return false
+		}
+		count++
+	}
+	return count*3 >= committeeSize*2
+}
+
 func merkelRootFromBranch(leaf common.Hash, branch [][]byte, depth uint64, index uint64) (common.Hash, error) {
 	if uint64(len(branch)) != depth {
 		return common.Hash{}, fmt.Errorf("expected proof length %d, but got %d", depth, len(branch))
@@ -79,6 +122,42 @@ func merkelRootFromBranch(leaf common.Hash, branch [][]byte, depth uint64, index
 	return common.BytesToHash(node), nil
 }
 
+// VerifyMerkleBranch reports whether branch proves that leaf sits at index in the subtree of the
+// given depth under root. For a generalized index g, depth is floorlog2(g) and index is
+// g mod 2**depth. It returns false when the branch length does not match depth.
+//
+// Spec pseudocode definition:
+//	def is_valid_merkle_branch(leaf: Bytes32, branch: Sequence[Bytes32], depth: uint64, index: uint64, root: Root) -> bool:
+//    """
+//    Check if ``leaf`` at ``index`` verifies against the Merkle ``root`` and ``branch``.
+//    """
+//    value = leaf
+//    for i in range(depth):
+//        if index // (2**i) % 2:
+//            value = hash(branch[i] + value)
+//        else:
+//            value = hash(value + branch[i])
+//    return value == root
+func VerifyMerkleBranch(leaf [32]byte, branch [][32]byte, depth, index uint64, root [32]byte) bool {
+	if uint64(len(branch)) != depth || depth >= 64 || index>>depth != 0 {
+		return false
+	}
+
+	node := leaf
+	tmp := make([]byte, 64)
+	for i, h := range branch {
+		if index&(1<<uint(i)) != 0 {
+			copy(tmp[:32], h[:])
+			copy(tmp[32:], node[:])
+		} else {
+			copy(tmp[:32], node[:])
+			copy(tmp[32:], h[:])
+		}
+		node = sha256.Sum256(tmp)
+	}
+	return node == root
+}
+
 // Returns the position (i.e. false for left, true for right)
 // of an index at a given level.
 // Level 0 is the actual index's level, Level 1 is the position
@@ -118,27 +197,20 @@ const DomainByteLength = 4
 //        genesis_validators_root = Root()  # all bytes zero by default
 //    fork_data_root = compute_fork_data_root(fork_version, genesis_validators_root)
 //    return Domain(domain_type + fork_data_root[:28])
-func ComputeDomain(domainType [DomainByteLength]byte, forkVersion, genesisValidatorsRoot []byte) ([]byte, error) {
-	if forkVersion == nil || genesisValidatorsRoot == nil {
-		return nil, fmt.Errorf("invalid input args")
-	}
-
-	forkBytes := [ForkVersionByteLength]byte{}
-	copy(forkBytes[:], forkVersion)
-
-	forkDataRoot, err := computeForkDataRoot(forkBytes[:], genesisValidatorsRoot)
+func ComputeDomain(domainType [DomainByteLength]byte, forkVersion [ForkVersionByteLength]byte, genesisValidatorsRoot [32]byte) ([32]byte, error) {
+	forkDataRoot, err := computeForkDataRoot(forkVersion[:], genesisValidatorsRoot[:])
 	if err != nil {
-		return nil, err
+		return [32]byte{}, err
 	}
 
-	return domain(domainType, forkDataRoot[:]), nil
+	return domain(domainType, forkDataRoot), nil
 }
 
 // This returns the bls domain given by the domain type and fork data root.
-func domain(domainType [DomainByteLength]byte, forkDataRoot []byte) []byte {
-	var b []byte
-	b = append(b, domainType[:4]...)
-	b = append(b, forkDataRoot[:28]...)
+func domain(domainType [DomainByteLength]byte, forkDataRoot [32]byte) [32]byte {
+	var b [32]byte
+	copy(b[:4], domainType[:])
+	copy(b[4:], forkDataRoot[:28])
 	return b
 }
 
@@ -200,6 +272,21 @@ func SyncCommitteeRoot(committee *SyncCommittee) ([32]byte, error) {
 	return ssz.BitwiseMerkleize(hasher, fieldRoots, uint64(len(fieldRoots)), uint64(len(fieldRoots)))
 }
 
+// VerifyPubkeyInCommittee checks that pubkey is the member at index of the sync committee of
+// preset, nil meaning MainnetPreset, with the given root, without the full list of members. The
+// leaf is the hash tree root of the public key, its 48 bytes padded to two chunks, and branch runs
+// from the leaf up to the root.
+func VerifyPubkeyInCommittee(pubkey bls2.PublicKey, index uint64, branch [][32]byte, committeeRoot [32]byte, preset *Preset) bool {
+	if pubkey == nil || index >= orMainnet(preset).SyncCommitteeSize {
+		return false
+	}
+	leaf, err := merkleizePubkey(hash.CustomSHA256Hasher(), pubkey.Marshal())
+	if err != nil {
+		return false
+	}
+	return VerifyMerkleBranch(leaf, branch, SyncCommitteePubkeyDepth(preset), index, committeeRoot)
+}
+
 func merkleizePubkey(hasher ssz.HashFn, pubkey []byte) ([32]byte, error) {
 	chunks, err := ssz.PackByChunk([][]byte{pubkey})
 	if err != nil {
@@ -230,8 +317,8 @@ func merkleizePubkey(hasher ssz.HashFn, pubkey []byte) ([32]byte, error) {
 //        object_root=hash_tree_root(ssz_object),
 //        domain=domain,
 //    ))
-func ComputeSigningRoot(object fssz.HashRoot, domain []byte) ([32]byte, error) {
-	return signingData(object.HashTreeRoot, domain)
+func ComputeSigningRoot(object fssz.HashRoot, domain [32]byte) ([32]byte, error) {
+	return signingData(object.HashTreeRoot, domain[:])
 }
 
 // Computes the signing data by utilising the provided root function and then
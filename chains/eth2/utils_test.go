@@ -0,0 +1,288 @@
+package eth2
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	bls2 "github.com/mapprotocol/atlas/chains/eth2/bls12381"
+	"github.com/mapprotocol/atlas/chains/eth2/hash"
+	"github.com/minio/sha256-simd"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeDomain(t *testing.T) {
+	var mainnetGenesisValidatorsRoot [32]byte
+	copy(mainnetGenesisValidatorsRoot[:], common.FromHex("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"))
+
+	tests := []struct {
+		name                  string
+		domainType            [4]byte
+		forkVersion           [4]byte
+		genesisValidatorsRoot [32]byte
+		want                  string
+	}{
+		{
+			// DOMAIN_DEPOSIT with GENESIS_FORK_VERSION and an empty root, as used for deposits.
+			name:       "genesis fork version",
+			domainType: [4]byte{0x03, 0x00, 0x00, 0x00},
+			want:       "0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+		},
+		{
+			name:                  "mainnet bellatrix sync committee",
+			domainType:            DomainSyncCommittee,
+			forkVersion:           [4]byte{0x02, 0x00, 0x00, 0x00},
+			genesisValidatorsRoot: mainnetGenesisValidatorsRoot,
+			want:                  "0x070000004a26c58b08add8089b75caa540848881a8d4f0af0be83417a85c0f45",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := ComputeDomain(test.domainType, test.forkVersion, test.genesisValidatorsRoot)
+			require.NoError(t, err)
+			assert.Equal(t, common.FromHex(test.want), d[:])
+		})
+	}
+}
+
+func TestComputeSigningRoot(t *testing.T) {
+	// Attested header of the period 620 mainnet update, signed under the bellatrix sync
+	// committee domain. Expected roots were computed with an independent SSZ implementation.
+	var domain [32]byte
+	copy(domain[:], common.FromHex("0x070000004a26c58b08add8089b75caa540848881a8d4f0af0be83417a85c0f45"))
+
+	headerRoot, err := update.attestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, common.FromHex("0x2296a67e90dbc0fa83e5667b014186d4e62c47a0e8eda427a85dd7e65474b35c"), headerRoot[:])
+
+	root, err := ComputeSigningRoot(&update.attestedHeader, domain)
+	require.NoError(t, err)
+	assert.Equal(t, common.FromHex("0x7019e18cc38398185be42dc7a6ee088c6df2a3f0fa64093e2dc0c0e1807fa9ef"), root[:])
+
+	other, err := ComputeSigningRoot(&update.attestedHeader, [32]byte{})
+	require.NoError(t, err)
+	assert.NotEqual(t, root, other)
+}
+
+func TestSelectParticipants(t *testing.T) {
+	committee := make([]bls2.PublicKey, 12)
+	for i := range committee {
+		priv, err := bls2.RandKey()
+		require.NoError(t, err)
+		committee[i] = priv.PublicKey()
+	}
+
+	t.Run("AllSet", func(t *testing.T) {
+		participants, err := SelectParticipants(committee, []byte{0xff, 0x0f})
+		require.NoError(t, err)
+		assert.Equal(t, committee, participants)
+	})
+
+	t.Run("NoneSet", func(t *testing.T) {
+		_, err := SelectParticipants(committee, []byte{0x00, 0x00})
+		assert.Error(t, err)
+	})
+
+	t.Run("Sparse", func(t *testing.T) {
+		// 0x05 sets members 0 and 2, 0x08 in the second byte sets member 11.
+		participants, err := SelectParticipants(committee, []byte{0x05, 0x08})
+		require.NoError(t, err)
+		assert.Equal(t, []bls2.PublicKey{committee[0], committee[2], committee[11]}, participants)
+	})
+
+	t.Run("BitsBeyondCommitteeIgnored", func(t *testing.T) {
+		_, err := SelectParticipants(committee, []byte{0x00, 0xf0})
+		assert.Error(t, err)
+	})
+
+	t.Run("ShortBitfield", func(t *testing.T) {
+		_, err := SelectParticipants(committee, []byte{0xff})
+		assert.Error(t, err)
+	})
+
+	t.Run("MatchesBitvector", func(t *testing.T) {
+		bits := bitfield.NewBitvector512()
+		bits.SetBitAt(3, true)
+		bits.SetBitAt(9, true)
+		participants, err := SelectParticipants(committee, bits.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, []bls2.PublicKey{committee[3], committee[9]}, participants)
+	})
+}
+
+func TestHasSupermajorityParticipation(t *testing.T) {
+	// bitsWithCount sets the first n bits of a bitfield of the given byte length.
+	bitsWithCount := func(n, length int) []byte {
+		b := make([]byte, length)
+		for i := 0; i < n; i++ {
+			b[i/8] |= 1 << uint(i%8)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name          string
+		bits          []byte
+		committeeSize int
+		want          bool
+	}{
+		{name: "MainnetAtThreshold", bits: bitsWithCount(342, 64), committeeSize: 512, want: true},
+		{name: "MainnetBelowThreshold", bits: bitsWithCount(341, 64), committeeSize: 512, want: false},
+		{name: "MainnetFull", bits: bitsWithCount(512, 64), committeeSize: 512, want: true},
+		// ceil(2*10/3) = 7
+		{name: "RoundsUp", bits: bitsWithCount(7, 2), committeeSize: 10, want: true},
+		{name: "RoundsUpBelow", bits: bitsWithCount(6, 2), committeeSize: 10, want: false},
+		{name: "ExactThirds", bits: bitsWithCount(6, 2), committeeSize: 9, want: true},
+		{name: "ExactThirdsBelow", bits: bitsWithCount(5, 2), committeeSize: 9, want: false},
+		{name: "ZeroCommittee", bits: []byte{0xff}, committeeSize: 0, want: false},
+		{name: "EmptyBits", bits: nil, committeeSize: 0, want: false},
+		{name: "ShortBits", bits: []byte{0xff}, committeeSize: 9, want: false},
+		{name: "LongerBitsZeroPadded", bits: bitsWithCount(7, 4), committeeSize: 10, want: true},
+		{name: "LongerBitsHighBitSet", bits: append(bitsWithCount(10, 2), 0x00, 0x80), committeeSize: 10, want: false},
+		{name: "HighBitInLastCommitteeByte", bits: []byte{0xff, 0x04}, committeeSize: 10, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, HasSupermajorityParticipation(tt.bits, tt.committeeSize))
+		})
+	}
+}
+
+func toBranch(b [][]byte) [][32]byte {
+	branch := make([][32]byte, len(b))
+	for i := range b {
+		copy(branch[i][:], b[i])
+	}
+	return branch
+}
+
+func TestVerifyMerkleBranch(t *testing.T) {
+	// FINALIZED_ROOT_INDEX = 105 and NEXT_SYNC_COMMITTEE_INDEX = 55 in altair, i.e. depth 6
+	// index 41 under the attested state root and depth 5 index 23 under the finalized one.
+	finalizedRoot, err := update.finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	var attestedStateRoot [32]byte
+	copy(attestedStateRoot[:], update.attestedHeader.StateRoot)
+	finalityBranch := toBranch(update.finalityBranch)
+
+	committeeRoot, err := SyncCommitteeRoot(&update.nextSyncCommittee)
+	require.NoError(t, err)
+	var finalizedStateRoot [32]byte
+	copy(finalizedStateRoot[:], update.finalizedHeader.StateRoot)
+	committeeBranch := toBranch(update.nextSyncCommitteeBranch)
+
+	assert.Equal(t, true, VerifyMerkleBranch(finalizedRoot, finalityBranch, 6, 105%64, attestedStateRoot))
+	assert.Equal(t, true, VerifyMerkleBranch(committeeRoot, committeeBranch, 5, 55%32, finalizedStateRoot))
+
+	t.Run("WrongIndex", func(t *testing.T) {
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, finalityBranch, 6, 40, attestedStateRoot))
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, finalityBranch, 6, 105, attestedStateRoot))
+	})
+
+	t.Run("WrongLeaf", func(t *testing.T) {
+		assert.Equal(t, false, VerifyMerkleBranch(committeeRoot, finalityBranch, 6, 41, attestedStateRoot))
+	})
+
+	t.Run("WrongRoot", func(t *testing.T) {
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, finalityBranch, 6, 41, finalizedStateRoot))
+	})
+
+	t.Run("TamperedBranch", func(t *testing.T) {
+		tampered := append([][32]byte(nil), finalityBranch...)
+		tampered[3][0] ^= 0x01
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, tampered, 6, 41, attestedStateRoot))
+	})
+
+	t.Run("LengthMismatch", func(t *testing.T) {
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, finalityBranch[:5], 6, 41, attestedStateRoot))
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, finalityBranch, 7, 41, attestedStateRoot))
+		assert.Equal(t, false, VerifyMerkleBranch(finalizedRoot, nil, 6, 41, attestedStateRoot))
+	})
+
+	t.Run("EmptyBranch", func(t *testing.T) {
+		assert.Equal(t, true, VerifyMerkleBranch(finalizedRoot, nil, 0, 0, finalizedRoot))
+	})
+}
+
+// pubkeyBranch returns the Merkle branch of the member at index under the root of committee.
+func pubkeyBranch(t *testing.T, committee *SyncCommittee, index int) [][32]byte {
+	hasher := hash.CustomSHA256Hasher()
+	layer := make([][32]byte, len(committee.Pubkeys))
+	for i, pubkey := range committee.Pubkeys {
+		r, err := merkleizePubkey(hasher, pubkey)
+		require.NoError(t, err)
+		layer[i] = r
+	}
+	var branch [][32]byte
+	for len(layer) > 1 {
+		branch = append(branch, layer[index^1])
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer, index = next, index/2
+	}
+	aggregateRoot, err := merkleizePubkey(hasher, committee.AggregatePubkey)
+	require.NoError(t, err)
+	return append(branch, aggregateRoot)
+}
+
+func TestVerifyPubkeyInCommittee(t *testing.T) {
+	c := newSyntheticCommittee(t)
+	root, err := SyncCommitteeRoot(&c.committee)
+	require.NoError(t, err)
+
+	for _, index := range []int{0, 1, 255, 256, 511} {
+		branch := pubkeyBranch(t, &c.committee, index)
+		require.Len(t, branch, int(SyncCommitteePubkeyDepth(nil)))
+		assert.Equal(t, true, VerifyPubkeyInCommittee(c.keys[index].PublicKey(), uint64(index), branch, root, nil), "index %d", index)
+	}
+
+	branch := pubkeyBranch(t, &c.committee, 7)
+	pubkey := c.keys[7].PublicKey()
+
+	t.Run("WrongIndex", func(t *testing.T) {
+		assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, 6, branch, root, nil))
+		assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, 7+SyncCommitteeSize, branch, root, nil))
+	})
+
+	t.Run("WrongPubkey", func(t *testing.T) {
+		assert.Equal(t, false, VerifyPubkeyInCommittee(c.keys[8].PublicKey(), 7, branch, root, nil))
+		assert.Equal(t, false, VerifyPubkeyInCommittee(nil, 7, branch, root, nil))
+	})
+
+	t.Run("TamperedBranch", func(t *testing.T) {
+		for i := range branch {
+			tampered := append([][32]byte(nil), branch...)
+			tampered[i][31] ^= 0x01
+			assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, 7, tampered, root, nil), "level %d", i)
+		}
+		assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, 7, branch[:len(branch)-1], root, nil))
+	})
+
+	t.Run("WrongRoot", func(t *testing.T) {
+		other, err := SyncCommitteeRoot(&newSyntheticCommittee(t).committee)
+		require.NoError(t, err)
+		assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, 7, branch, other, nil))
+	})
+}
+
+func TestVerifyPubkeyInCommittee_MinimalPreset(t *testing.T) {
+	assert.Equal(t, uint64(10), SyncCommitteePubkeyDepth(&MainnetPreset))
+	assert.Equal(t, uint64(6), SyncCommitteePubkeyDepth(&MinimalPreset))
+
+	c := newSyntheticCommitteeWithPreset(t, &MinimalPreset)
+	root, err := SyncCommitteeRoot(&c.committee)
+	require.NoError(t, err)
+	for _, index := range []int{0, 7, 31} {
+		branch := pubkeyBranch(t, &c.committee, index)
+		require.Len(t, branch, 6)
+		pubkey := c.keys[index].PublicKey()
+		assert.Equal(t, true, VerifyPubkeyInCommittee(pubkey, uint64(index), branch, root, &MinimalPreset), "index %d", index)
+		// The mainnet depth and index bound do not fit a minimal committee.
+		assert.Equal(t, false, VerifyPubkeyInCommittee(pubkey, uint64(index), branch, root, nil), "index %d", index)
+	}
+	branch := pubkeyBranch(t, &c.committee, 7)
+	assert.Equal(t, false, VerifyPubkeyInCommittee(c.keys[7].PublicKey(), 7+MinimalPreset.SyncCommitteeSize, branch, root, &MinimalPreset))
+}